@@ -7,7 +7,9 @@ import (
 	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	empty "github.com/golang/protobuf/ptypes/empty"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	math "math"
@@ -49,6 +51,111 @@ func (IntegrationKind) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_fc846aced8fe6ea6, []int{0}
 }
 
+type HTTPIntegrationTemplateEngine int32
+
+const (
+	// Go text/template.
+	HTTPIntegrationTemplateEngine_GO_TEMPLATE HTTPIntegrationTemplateEngine = 0
+	// JavaScript function Transform(event, payload).
+	HTTPIntegrationTemplateEngine_JS HTTPIntegrationTemplateEngine = 1
+)
+
+var HTTPIntegrationTemplateEngine_name = map[int32]string{
+	0: "GO_TEMPLATE",
+	1: "JS",
+}
+
+var HTTPIntegrationTemplateEngine_value = map[string]int32{
+	"GO_TEMPLATE": 0,
+	"JS":          1,
+}
+
+func (x HTTPIntegrationTemplateEngine) String() string {
+	return proto.EnumName(HTTPIntegrationTemplateEngine_name, int32(x))
+}
+
+func (HTTPIntegrationTemplateEngine) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{1}
+}
+
+type IntegrationEvent int32
+
+const (
+	// Uplink event.
+	IntegrationEvent_UPLINK IntegrationEvent = 0
+	// Join event.
+	IntegrationEvent_JOIN IntegrationEvent = 1
+	// ACK event.
+	IntegrationEvent_ACK IntegrationEvent = 2
+	// Error event.
+	IntegrationEvent_ERROR IntegrationEvent = 3
+	// Status event.
+	IntegrationEvent_STATUS IntegrationEvent = 4
+	// Location event.
+	IntegrationEvent_LOCATION IntegrationEvent = 5
+)
+
+var IntegrationEvent_name = map[int32]string{
+	0: "UPLINK",
+	1: "JOIN",
+	2: "ACK",
+	3: "ERROR",
+	4: "STATUS",
+	5: "LOCATION",
+}
+
+var IntegrationEvent_value = map[string]int32{
+	"UPLINK":   0,
+	"JOIN":     1,
+	"ACK":      2,
+	"ERROR":    3,
+	"STATUS":   4,
+	"LOCATION": 5,
+}
+
+func (x IntegrationEvent) String() string {
+	return proto.EnumName(IntegrationEvent_name, int32(x))
+}
+
+func (IntegrationEvent) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{2}
+}
+
+type IntegrationMarshaler int32
+
+const (
+	// JSON (default).
+	IntegrationMarshaler_JSON IntegrationMarshaler = 0
+	// Protobuf (see api/integration/integration.proto).
+	IntegrationMarshaler_PROTOBUF IntegrationMarshaler = 1
+	// CloudEvents 1.0 (structured content mode).
+	IntegrationMarshaler_CLOUDEVENTS IntegrationMarshaler = 2
+	// CloudEvents 1.0 (binary content mode).
+	IntegrationMarshaler_CLOUDEVENTS_BINARY IntegrationMarshaler = 3
+)
+
+var IntegrationMarshaler_name = map[int32]string{
+	0: "JSON",
+	1: "PROTOBUF",
+	2: "CLOUDEVENTS",
+	3: "CLOUDEVENTS_BINARY",
+}
+
+var IntegrationMarshaler_value = map[string]int32{
+	"JSON":               0,
+	"PROTOBUF":           1,
+	"CLOUDEVENTS":        2,
+	"CLOUDEVENTS_BINARY": 3,
+}
+
+func (x IntegrationMarshaler) String() string {
+	return proto.EnumName(IntegrationMarshaler_name, int32(x))
+}
+
+func (IntegrationMarshaler) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{3}
+}
+
 type InfluxDBPrecision int32
 
 const (
@@ -83,7 +190,34 @@ func (x InfluxDBPrecision) String() string {
 }
 
 func (InfluxDBPrecision) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{1}
+	return fileDescriptor_fc846aced8fe6ea6, []int{4}
+}
+
+type InfluxDBVersion int32
+
+const (
+	// InfluxDB 1.x (/write endpoint, db, username and password).
+	InfluxDBVersion_INFLUXDB_1 InfluxDBVersion = 0
+	// InfluxDB 2.x (/api/v2/write endpoint, organization, bucket and token).
+	InfluxDBVersion_INFLUXDB_2 InfluxDBVersion = 1
+)
+
+var InfluxDBVersion_name = map[int32]string{
+	0: "INFLUXDB_1",
+	1: "INFLUXDB_2",
+}
+
+var InfluxDBVersion_value = map[string]int32{
+	"INFLUXDB_1": 0,
+	"INFLUXDB_2": 1,
+}
+
+func (x InfluxDBVersion) String() string {
+	return proto.EnumName(InfluxDBVersion_name, int32(x))
+}
+
+func (InfluxDBVersion) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{5}
 }
 
 type Application struct {
@@ -99,11 +233,25 @@ type Application struct {
 	// ID of the service profile.
 	ServiceProfileId string `protobuf:"bytes,5,opt,name=service_profile_id,json=serviceProfileID,proto3" json:"service_profile_id,omitempty"`
 	// Payload codec.
+	// NOTE: These field have moved to the device-profile and will be removed
+	// in the next major release. When set, the device-profile payload_ fields
+	// have priority over the application payload_ fields.
 	PayloadCodec string `protobuf:"bytes,6,opt,name=payload_codec,json=payloadCodec,proto3" json:"payload_codec,omitempty"`
 	// Payload encoder script.
+	// NOTE: These field have moved to the device-profile and will be removed
+	// in the next major release. When set, the device-profile payload_ fields
+	// have priority over the application payload_ fields.
 	PayloadEncoderScript string `protobuf:"bytes,7,opt,name=payload_encoder_script,json=payloadEncoderScript,proto3" json:"payload_encoder_script,omitempty"`
 	// Payload decoder script.
-	PayloadDecoderScript string   `protobuf:"bytes,8,opt,name=payload_decoder_script,json=payloadDecoderScript,proto3" json:"payload_decoder_script,omitempty"`
+	// NOTE: These field have moved to the device-profile and will be removed
+	// in the next major release. When set, the device-profile payload_ fields
+	// have priority over the application payload_ fields.
+	PayloadDecoderScript string `protobuf:"bytes,8,opt,name=payload_decoder_script,json=payloadDecoderScript,proto3" json:"payload_decoder_script,omitempty"`
+	// Uplink history (in days).
+	// When set, the uplinks of the devices of this application are stored
+	// for the given number of days and can be retrieved using the
+	// ListUplinks method of the DeviceService. Set to 0 to disable.
+	UplinkHistoryDays    uint32   `protobuf:"varint,9,opt,name=uplink_history_days,json=uplinkHistoryDays,proto3" json:"uplink_history_days,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -190,6 +338,13 @@ func (m *Application) GetPayloadDecoderScript() string {
 	return ""
 }
 
+func (m *Application) GetUplinkHistoryDays() uint32 {
+	if m != nil {
+		return m.UplinkHistoryDays
+	}
+	return 0
+}
+
 type ApplicationListItem struct {
 	// Application ID.
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -686,6 +841,8 @@ type HTTPIntegration struct {
 	// The headers to use when making HTTP callbacks.
 	Headers []*HTTPIntegrationHeader `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty"`
 	// The URL to call for uplink data.
+	// All URLs are Go text/template templates, rendered using the event
+	// payload (e.g. https://example.com/devices/{{ .DevEUI }}).
 	UplinkDataUrl string `protobuf:"bytes,3,opt,name=uplink_data_url,json=uplinkDataURL,proto3" json:"uplink_data_url,omitempty"`
 	// The URL to call for join notifications.
 	JoinNotificationUrl string `protobuf:"bytes,4,opt,name=join_notification_url,json=joinNotificationURL,proto3" json:"join_notification_url,omitempty"`
@@ -696,10 +853,32 @@ type HTTPIntegration struct {
 	// The URL to call for device-status notifications.
 	StatusNotificationUrl string `protobuf:"bytes,7,opt,name=status_notification_url,json=statusNotificationURL,proto3" json:"status_notification_url,omitempty"`
 	// The URL to call for location notifications.
-	LocationNotificationUrl string   `protobuf:"bytes,8,opt,name=location_notification_url,json=locationNotificationURL,proto3" json:"location_notification_url,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_unrecognized        []byte   `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	LocationNotificationUrl string `protobuf:"bytes,8,opt,name=location_notification_url,json=locationNotificationURL,proto3" json:"location_notification_url,omitempty"`
+	// The marshaler to use for encoding the payloads.
+	Marshaler IntegrationMarshaler `protobuf:"varint,9,opt,name=marshaler,proto3,enum=api.IntegrationMarshaler" json:"marshaler,omitempty"`
+	// The HTTP method to use (default POST).
+	Method string `protobuf:"bytes,10,opt,name=method,proto3" json:"method,omitempty"`
+	// Request timeout (default no timeout).
+	Timeout *duration.Duration `protobuf:"bytes,11,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Content-Type header value. When not set, the content-type matching
+	// the marshaler or body template output (JSON) is used.
+	ContentType string `protobuf:"bytes,12,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// The engine to use for rendering the body templates.
+	TemplateEngine HTTPIntegrationTemplateEngine `protobuf:"varint,13,opt,name=template_engine,json=templateEngine,proto3,enum=api.HTTPIntegrationTemplateEngine" json:"template_engine,omitempty"`
+	// Body templates per event type. For events without body template,
+	// the payload is encoded using the configured marshaler.
+	BodyTemplates []*HTTPIntegrationBodyTemplate `protobuf:"bytes,14,rep,name=body_templates,json=bodyTemplates,proto3" json:"body_templates,omitempty"`
+	// Enable the downlink endpoint. When enabled, downlink payloads can be
+	// posted to /api/applications/{applicationID}/integrations/http/downlink
+	// using the downlink token.
+	DownlinkEnabled bool `protobuf:"varint,15,opt,name=downlink_enabled,json=downlinkEnabled,proto3" json:"downlink_enabled,omitempty"`
+	// Downlink token (read-only).
+	// This token is generated when enabling the downlink endpoint and must
+	// be used as Bearer token.
+	DownlinkToken        string   `protobuf:"bytes,16,opt,name=downlink_token,json=downlinkToken,proto3" json:"downlink_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *HTTPIntegration) Reset()         { *m = HTTPIntegration{} }
@@ -783,6 +962,111 @@ func (m *HTTPIntegration) GetLocationNotificationUrl() string {
 	return ""
 }
 
+func (m *HTTPIntegration) GetMarshaler() IntegrationMarshaler {
+	if m != nil {
+		return m.Marshaler
+	}
+	return IntegrationMarshaler_JSON
+}
+
+func (m *HTTPIntegration) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *HTTPIntegration) GetTimeout() *duration.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *HTTPIntegration) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *HTTPIntegration) GetTemplateEngine() HTTPIntegrationTemplateEngine {
+	if m != nil {
+		return m.TemplateEngine
+	}
+	return HTTPIntegrationTemplateEngine_GO_TEMPLATE
+}
+
+func (m *HTTPIntegration) GetBodyTemplates() []*HTTPIntegrationBodyTemplate {
+	if m != nil {
+		return m.BodyTemplates
+	}
+	return nil
+}
+
+func (m *HTTPIntegration) GetDownlinkEnabled() bool {
+	if m != nil {
+		return m.DownlinkEnabled
+	}
+	return false
+}
+
+func (m *HTTPIntegration) GetDownlinkToken() string {
+	if m != nil {
+		return m.DownlinkToken
+	}
+	return ""
+}
+
+type HTTPIntegrationBodyTemplate struct {
+	// Event type.
+	Event IntegrationEvent `protobuf:"varint,1,opt,name=event,proto3,enum=api.IntegrationEvent" json:"event,omitempty"`
+	// Body template.
+	Template             string   `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HTTPIntegrationBodyTemplate) Reset()         { *m = HTTPIntegrationBodyTemplate{} }
+func (m *HTTPIntegrationBodyTemplate) String() string { return proto.CompactTextString(m) }
+func (*HTTPIntegrationBodyTemplate) ProtoMessage()    {}
+func (*HTTPIntegrationBodyTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{12}
+}
+
+func (m *HTTPIntegrationBodyTemplate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HTTPIntegrationBodyTemplate.Unmarshal(m, b)
+}
+func (m *HTTPIntegrationBodyTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HTTPIntegrationBodyTemplate.Marshal(b, m, deterministic)
+}
+func (m *HTTPIntegrationBodyTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPIntegrationBodyTemplate.Merge(m, src)
+}
+func (m *HTTPIntegrationBodyTemplate) XXX_Size() int {
+	return xxx_messageInfo_HTTPIntegrationBodyTemplate.Size(m)
+}
+func (m *HTTPIntegrationBodyTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPIntegrationBodyTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPIntegrationBodyTemplate proto.InternalMessageInfo
+
+func (m *HTTPIntegrationBodyTemplate) GetEvent() IntegrationEvent {
+	if m != nil {
+		return m.Event
+	}
+	return IntegrationEvent_UPLINK
+}
+
+func (m *HTTPIntegrationBodyTemplate) GetTemplate() string {
+	if m != nil {
+		return m.Template
+	}
+	return ""
+}
+
 type CreateHTTPIntegrationRequest struct {
 	// Integration object to create.
 	Integration          *HTTPIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
@@ -795,7 +1079,7 @@ func (m *CreateHTTPIntegrationRequest) Reset()         { *m = CreateHTTPIntegrat
 func (m *CreateHTTPIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateHTTPIntegrationRequest) ProtoMessage()    {}
 func (*CreateHTTPIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{12}
+	return fileDescriptor_fc846aced8fe6ea6, []int{13}
 }
 
 func (m *CreateHTTPIntegrationRequest) XXX_Unmarshal(b []byte) error {
@@ -835,7 +1119,7 @@ func (m *GetHTTPIntegrationRequest) Reset()         { *m = GetHTTPIntegrationReq
 func (m *GetHTTPIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*GetHTTPIntegrationRequest) ProtoMessage()    {}
 func (*GetHTTPIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{13}
+	return fileDescriptor_fc846aced8fe6ea6, []int{14}
 }
 
 func (m *GetHTTPIntegrationRequest) XXX_Unmarshal(b []byte) error {
@@ -875,7 +1159,7 @@ func (m *GetHTTPIntegrationResponse) Reset()         { *m = GetHTTPIntegrationRe
 func (m *GetHTTPIntegrationResponse) String() string { return proto.CompactTextString(m) }
 func (*GetHTTPIntegrationResponse) ProtoMessage()    {}
 func (*GetHTTPIntegrationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{14}
+	return fileDescriptor_fc846aced8fe6ea6, []int{15}
 }
 
 func (m *GetHTTPIntegrationResponse) XXX_Unmarshal(b []byte) error {
@@ -915,7 +1199,7 @@ func (m *UpdateHTTPIntegrationRequest) Reset()         { *m = UpdateHTTPIntegrat
 func (m *UpdateHTTPIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateHTTPIntegrationRequest) ProtoMessage()    {}
 func (*UpdateHTTPIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{15}
+	return fileDescriptor_fc846aced8fe6ea6, []int{16}
 }
 
 func (m *UpdateHTTPIntegrationRequest) XXX_Unmarshal(b []byte) error {
@@ -955,7 +1239,7 @@ func (m *DeleteHTTPIntegrationRequest) Reset()         { *m = DeleteHTTPIntegrat
 func (m *DeleteHTTPIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteHTTPIntegrationRequest) ProtoMessage()    {}
 func (*DeleteHTTPIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{16}
+	return fileDescriptor_fc846aced8fe6ea6, []int{17}
 }
 
 func (m *DeleteHTTPIntegrationRequest) XXX_Unmarshal(b []byte) error {
@@ -995,7 +1279,7 @@ func (m *ListIntegrationRequest) Reset()         { *m = ListIntegrationRequest{}
 func (m *ListIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*ListIntegrationRequest) ProtoMessage()    {}
 func (*ListIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{17}
+	return fileDescriptor_fc846aced8fe6ea6, []int{18}
 }
 
 func (m *ListIntegrationRequest) XXX_Unmarshal(b []byte) error {
@@ -1025,17 +1309,19 @@ func (m *ListIntegrationRequest) GetApplicationId() int64 {
 
 type IntegrationListItem struct {
 	// Integration kind.
-	Kind                 IntegrationKind `protobuf:"varint,1,opt,name=kind,proto3,enum=api.IntegrationKind" json:"kind,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Kind IntegrationKind `protobuf:"varint,1,opt,name=kind,proto3,enum=api.IntegrationKind" json:"kind,omitempty"`
+	// Delivery statistics.
+	Stats                *IntegrationStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *IntegrationListItem) Reset()         { *m = IntegrationListItem{} }
 func (m *IntegrationListItem) String() string { return proto.CompactTextString(m) }
 func (*IntegrationListItem) ProtoMessage()    {}
 func (*IntegrationListItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{18}
+	return fileDescriptor_fc846aced8fe6ea6, []int{19}
 }
 
 func (m *IntegrationListItem) XXX_Unmarshal(b []byte) error {
@@ -1063,6 +1349,98 @@ func (m *IntegrationListItem) GetKind() IntegrationKind {
 	return IntegrationKind_HTTP
 }
 
+func (m *IntegrationListItem) GetStats() *IntegrationStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type IntegrationStats struct {
+	// Number of successful deliveries.
+	SuccessCount int64 `protobuf:"varint,1,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	// Number of failed deliveries.
+	ErrorCount int64 `protobuf:"varint,2,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
+	// Last successful delivery timestamp.
+	LastSuccessAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=last_success_at,json=lastSuccessAt,proto3" json:"last_success_at,omitempty"`
+	// Last failed delivery timestamp.
+	LastErrorAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=last_error_at,json=lastErrorAt,proto3" json:"last_error_at,omitempty"`
+	// Last delivery error.
+	LastError string `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// Average delivery latency.
+	AverageLatency       *duration.Duration `protobuf:"bytes,6,opt,name=average_latency,json=averageLatency,proto3" json:"average_latency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *IntegrationStats) Reset()         { *m = IntegrationStats{} }
+func (m *IntegrationStats) String() string { return proto.CompactTextString(m) }
+func (*IntegrationStats) ProtoMessage()    {}
+func (*IntegrationStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{20}
+}
+
+func (m *IntegrationStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IntegrationStats.Unmarshal(m, b)
+}
+func (m *IntegrationStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IntegrationStats.Marshal(b, m, deterministic)
+}
+func (m *IntegrationStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntegrationStats.Merge(m, src)
+}
+func (m *IntegrationStats) XXX_Size() int {
+	return xxx_messageInfo_IntegrationStats.Size(m)
+}
+func (m *IntegrationStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntegrationStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntegrationStats proto.InternalMessageInfo
+
+func (m *IntegrationStats) GetSuccessCount() int64 {
+	if m != nil {
+		return m.SuccessCount
+	}
+	return 0
+}
+
+func (m *IntegrationStats) GetErrorCount() int64 {
+	if m != nil {
+		return m.ErrorCount
+	}
+	return 0
+}
+
+func (m *IntegrationStats) GetLastSuccessAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastSuccessAt
+	}
+	return nil
+}
+
+func (m *IntegrationStats) GetLastErrorAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastErrorAt
+	}
+	return nil
+}
+
+func (m *IntegrationStats) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *IntegrationStats) GetAverageLatency() *duration.Duration {
+	if m != nil {
+		return m.AverageLatency
+	}
+	return nil
+}
+
 type ListIntegrationResponse struct {
 	// Total number of integrations available within the result-set.
 	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
@@ -1077,7 +1455,7 @@ func (m *ListIntegrationResponse) Reset()         { *m = ListIntegrationResponse
 func (m *ListIntegrationResponse) String() string { return proto.CompactTextString(m) }
 func (*ListIntegrationResponse) ProtoMessage()    {}
 func (*ListIntegrationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{19}
+	return fileDescriptor_fc846aced8fe6ea6, []int{21}
 }
 
 func (m *ListIntegrationResponse) XXX_Unmarshal(b []byte) error {
@@ -1112,89 +1490,247 @@ func (m *ListIntegrationResponse) GetResult() []*IntegrationListItem {
 	return nil
 }
 
-type InfluxDBIntegration struct {
-	// Application ID.
+type TestIntegrationRequest struct {
+	// The id of the application.
 	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
-	// InfluxDB API write endpoint (e.g. http://localhost:8086/write).
-	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	// InfluxDB database name.
-	Db string `protobuf:"bytes,3,opt,name=db,proto3" json:"db,omitempty"`
-	// InfluxDB username.
-	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
-	// InfluxDB password.
-	Password string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
-	// InfluxDB retention policy name.
-	RetentionPolicyName string `protobuf:"bytes,6,opt,name=retention_policy_name,json=retentionPolicyName,proto3" json:"retention_policy_name,omitempty"`
-	// InfluxDB timestamp precision.
-	Precision            InfluxDBPrecision `protobuf:"varint,7,opt,name=precision,proto3,enum=api.InfluxDBPrecision" json:"precision,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	// Integration kind to test.
+	Kind IntegrationKind `protobuf:"varint,2,opt,name=kind,proto3,enum=api.IntegrationKind" json:"kind,omitempty"`
+	// Event type to send.
+	Event                IntegrationEvent `protobuf:"varint,3,opt,name=event,proto3,enum=api.IntegrationEvent" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *InfluxDBIntegration) Reset()         { *m = InfluxDBIntegration{} }
-func (m *InfluxDBIntegration) String() string { return proto.CompactTextString(m) }
-func (*InfluxDBIntegration) ProtoMessage()    {}
-func (*InfluxDBIntegration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{20}
+func (m *TestIntegrationRequest) Reset()         { *m = TestIntegrationRequest{} }
+func (m *TestIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*TestIntegrationRequest) ProtoMessage()    {}
+func (*TestIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{22}
 }
 
-func (m *InfluxDBIntegration) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_InfluxDBIntegration.Unmarshal(m, b)
+func (m *TestIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TestIntegrationRequest.Unmarshal(m, b)
 }
-func (m *InfluxDBIntegration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_InfluxDBIntegration.Marshal(b, m, deterministic)
+func (m *TestIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TestIntegrationRequest.Marshal(b, m, deterministic)
 }
-func (m *InfluxDBIntegration) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InfluxDBIntegration.Merge(m, src)
+func (m *TestIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestIntegrationRequest.Merge(m, src)
 }
-func (m *InfluxDBIntegration) XXX_Size() int {
-	return xxx_messageInfo_InfluxDBIntegration.Size(m)
+func (m *TestIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_TestIntegrationRequest.Size(m)
 }
-func (m *InfluxDBIntegration) XXX_DiscardUnknown() {
-	xxx_messageInfo_InfluxDBIntegration.DiscardUnknown(m)
+func (m *TestIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestIntegrationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InfluxDBIntegration proto.InternalMessageInfo
+var xxx_messageInfo_TestIntegrationRequest proto.InternalMessageInfo
 
-func (m *InfluxDBIntegration) GetApplicationId() int64 {
+func (m *TestIntegrationRequest) GetApplicationId() int64 {
 	if m != nil {
 		return m.ApplicationId
 	}
 	return 0
 }
 
-func (m *InfluxDBIntegration) GetEndpoint() string {
+func (m *TestIntegrationRequest) GetKind() IntegrationKind {
 	if m != nil {
-		return m.Endpoint
+		return m.Kind
 	}
-	return ""
+	return IntegrationKind_HTTP
 }
 
-func (m *InfluxDBIntegration) GetDb() string {
+func (m *TestIntegrationRequest) GetEvent() IntegrationEvent {
 	if m != nil {
-		return m.Db
+		return m.Event
 	}
-	return ""
+	return IntegrationEvent_UPLINK
 }
 
-func (m *InfluxDBIntegration) GetUsername() string {
-	if m != nil {
-		return m.Username
-	}
-	return ""
+type TestIntegrationResponse struct {
+	// HTTP status code returned by the integration endpoint.
+	// This is 0 when no response was received.
+	StatusCode uint32 `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// Response body (truncated).
+	Body string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	// Time it took to make the request.
+	Latency *duration.Duration `protobuf:"bytes,3,opt,name=latency,proto3" json:"latency,omitempty"`
+	// Error (if any) returned by the integration.
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InfluxDBIntegration) GetPassword() string {
+func (m *TestIntegrationResponse) Reset()         { *m = TestIntegrationResponse{} }
+func (m *TestIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*TestIntegrationResponse) ProtoMessage()    {}
+func (*TestIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{23}
+}
+
+func (m *TestIntegrationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TestIntegrationResponse.Unmarshal(m, b)
+}
+func (m *TestIntegrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TestIntegrationResponse.Marshal(b, m, deterministic)
+}
+func (m *TestIntegrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestIntegrationResponse.Merge(m, src)
+}
+func (m *TestIntegrationResponse) XXX_Size() int {
+	return xxx_messageInfo_TestIntegrationResponse.Size(m)
+}
+func (m *TestIntegrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestIntegrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TestIntegrationResponse proto.InternalMessageInfo
+
+func (m *TestIntegrationResponse) GetStatusCode() uint32 {
 	if m != nil {
-		return m.Password
+		return m.StatusCode
 	}
-	return ""
+	return 0
 }
 
-func (m *InfluxDBIntegration) GetRetentionPolicyName() string {
+func (m *TestIntegrationResponse) GetBody() string {
 	if m != nil {
-		return m.RetentionPolicyName
+		return m.Body
+	}
+	return ""
+}
+
+func (m *TestIntegrationResponse) GetLatency() *duration.Duration {
+	if m != nil {
+		return m.Latency
+	}
+	return nil
+}
+
+func (m *TestIntegrationResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type InfluxDBIntegration struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// InfluxDB API write endpoint (e.g. http://localhost:8086/write or
+	// http://localhost:8086/api/v2/write).
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// InfluxDB database name.
+	Db string `protobuf:"bytes,3,opt,name=db,proto3" json:"db,omitempty"`
+	// InfluxDB username.
+	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	// InfluxDB password.
+	Password string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	// InfluxDB retention policy name.
+	RetentionPolicyName string `protobuf:"bytes,6,opt,name=retention_policy_name,json=retentionPolicyName,proto3" json:"retention_policy_name,omitempty"`
+	// InfluxDB timestamp precision.
+	// Note: InfluxDB 2.x does not support the M and H precision.
+	Precision InfluxDBPrecision `protobuf:"varint,7,opt,name=precision,proto3,enum=api.InfluxDBPrecision" json:"precision,omitempty"`
+	// InfluxDB version.
+	Version InfluxDBVersion `protobuf:"varint,8,opt,name=version,proto3,enum=api.InfluxDBVersion" json:"version,omitempty"`
+	// InfluxDB 2.x organization.
+	Organization string `protobuf:"bytes,9,opt,name=organization,proto3" json:"organization,omitempty"`
+	// InfluxDB 2.x bucket.
+	Bucket string `protobuf:"bytes,10,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// InfluxDB 2.x token.
+	Token string `protobuf:"bytes,11,opt,name=token,proto3" json:"token,omitempty"`
+	// Batch size.
+	// When set (> 1), the measurements are written in batches of the given
+	// size.
+	BatchSize uint32 `protobuf:"varint,12,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// Batch interval.
+	// When set, the pending measurements are written at the given interval.
+	BatchInterval *duration.Duration `protobuf:"bytes,13,opt,name=batch_interval,json=batchInterval,proto3" json:"batch_interval,omitempty"`
+	// Retry buffer size.
+	// Max. number of measurements kept for a retry after a failed batch
+	// write. When not set, 10000 measurements are kept (max. 100000).
+	RetryBufferSize uint32 `protobuf:"varint,14,opt,name=retry_buffer_size,json=retryBufferSize,proto3" json:"retry_buffer_size,omitempty"`
+	// Gzip compress the written measurements.
+	Gzip bool `protobuf:"varint,15,opt,name=gzip,proto3" json:"gzip,omitempty"`
+	// Additional tags added to each measurement.
+	// The values are Go templates, executed using the event payload
+	// (e.g. {{.ApplicationName}}).
+	Tags []*InfluxDBIntegrationTag `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Go template for the names of the decoded payload measurements.
+	// The template is executed using the uplink payload with .Key set to the
+	// flattened object key (e.g. {{.ApplicationName}}_{{.Key}}).
+	// When left blank, device_frmpayload_data_{{.Key}} is used.
+	MeasurementTemplate  string   `protobuf:"bytes,17,opt,name=measurement_template,json=measurementTemplate,proto3" json:"measurement_template,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InfluxDBIntegration) Reset()         { *m = InfluxDBIntegration{} }
+func (m *InfluxDBIntegration) String() string { return proto.CompactTextString(m) }
+func (*InfluxDBIntegration) ProtoMessage()    {}
+func (*InfluxDBIntegration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{24}
+}
+
+func (m *InfluxDBIntegration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InfluxDBIntegration.Unmarshal(m, b)
+}
+func (m *InfluxDBIntegration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InfluxDBIntegration.Marshal(b, m, deterministic)
+}
+func (m *InfluxDBIntegration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InfluxDBIntegration.Merge(m, src)
+}
+func (m *InfluxDBIntegration) XXX_Size() int {
+	return xxx_messageInfo_InfluxDBIntegration.Size(m)
+}
+func (m *InfluxDBIntegration) XXX_DiscardUnknown() {
+	xxx_messageInfo_InfluxDBIntegration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InfluxDBIntegration proto.InternalMessageInfo
+
+func (m *InfluxDBIntegration) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *InfluxDBIntegration) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *InfluxDBIntegration) GetDb() string {
+	if m != nil {
+		return m.Db
+	}
+	return ""
+}
+
+func (m *InfluxDBIntegration) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *InfluxDBIntegration) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *InfluxDBIntegration) GetRetentionPolicyName() string {
+	if m != nil {
+		return m.RetentionPolicyName
 	}
 	return ""
 }
@@ -1206,6 +1742,125 @@ func (m *InfluxDBIntegration) GetPrecision() InfluxDBPrecision {
 	return InfluxDBPrecision_NS
 }
 
+func (m *InfluxDBIntegration) GetVersion() InfluxDBVersion {
+	if m != nil {
+		return m.Version
+	}
+	return InfluxDBVersion_INFLUXDB_1
+}
+
+func (m *InfluxDBIntegration) GetOrganization() string {
+	if m != nil {
+		return m.Organization
+	}
+	return ""
+}
+
+func (m *InfluxDBIntegration) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *InfluxDBIntegration) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *InfluxDBIntegration) GetBatchSize() uint32 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+func (m *InfluxDBIntegration) GetBatchInterval() *duration.Duration {
+	if m != nil {
+		return m.BatchInterval
+	}
+	return nil
+}
+
+func (m *InfluxDBIntegration) GetRetryBufferSize() uint32 {
+	if m != nil {
+		return m.RetryBufferSize
+	}
+	return 0
+}
+
+func (m *InfluxDBIntegration) GetGzip() bool {
+	if m != nil {
+		return m.Gzip
+	}
+	return false
+}
+
+func (m *InfluxDBIntegration) GetTags() []*InfluxDBIntegrationTag {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *InfluxDBIntegration) GetMeasurementTemplate() string {
+	if m != nil {
+		return m.MeasurementTemplate
+	}
+	return ""
+}
+
+type InfluxDBIntegrationTag struct {
+	// Tag key.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Tag value (template).
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InfluxDBIntegrationTag) Reset()         { *m = InfluxDBIntegrationTag{} }
+func (m *InfluxDBIntegrationTag) String() string { return proto.CompactTextString(m) }
+func (*InfluxDBIntegrationTag) ProtoMessage()    {}
+func (*InfluxDBIntegrationTag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{25}
+}
+
+func (m *InfluxDBIntegrationTag) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InfluxDBIntegrationTag.Unmarshal(m, b)
+}
+func (m *InfluxDBIntegrationTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InfluxDBIntegrationTag.Marshal(b, m, deterministic)
+}
+func (m *InfluxDBIntegrationTag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InfluxDBIntegrationTag.Merge(m, src)
+}
+func (m *InfluxDBIntegrationTag) XXX_Size() int {
+	return xxx_messageInfo_InfluxDBIntegrationTag.Size(m)
+}
+func (m *InfluxDBIntegrationTag) XXX_DiscardUnknown() {
+	xxx_messageInfo_InfluxDBIntegrationTag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InfluxDBIntegrationTag proto.InternalMessageInfo
+
+func (m *InfluxDBIntegrationTag) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *InfluxDBIntegrationTag) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 type CreateInfluxDBIntegrationRequest struct {
 	// Integration object to create.
 	Integration          *InfluxDBIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
@@ -1218,7 +1873,7 @@ func (m *CreateInfluxDBIntegrationRequest) Reset()         { *m = CreateInfluxDB
 func (m *CreateInfluxDBIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateInfluxDBIntegrationRequest) ProtoMessage()    {}
 func (*CreateInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{21}
+	return fileDescriptor_fc846aced8fe6ea6, []int{26}
 }
 
 func (m *CreateInfluxDBIntegrationRequest) XXX_Unmarshal(b []byte) error {
@@ -1258,7 +1913,7 @@ func (m *GetInfluxDBIntegrationRequest) Reset()         { *m = GetInfluxDBIntegr
 func (m *GetInfluxDBIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*GetInfluxDBIntegrationRequest) ProtoMessage()    {}
 func (*GetInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{22}
+	return fileDescriptor_fc846aced8fe6ea6, []int{27}
 }
 
 func (m *GetInfluxDBIntegrationRequest) XXX_Unmarshal(b []byte) error {
@@ -1277,138 +1932,610 @@ func (m *GetInfluxDBIntegrationRequest) XXX_DiscardUnknown() {
 	xxx_messageInfo_GetInfluxDBIntegrationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetInfluxDBIntegrationRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetInfluxDBIntegrationRequest proto.InternalMessageInfo
+
+func (m *GetInfluxDBIntegrationRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+type GetInfluxDBIntegrationResponse struct {
+	// Integration object.
+	Integration          *InfluxDBIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetInfluxDBIntegrationResponse) Reset()         { *m = GetInfluxDBIntegrationResponse{} }
+func (m *GetInfluxDBIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*GetInfluxDBIntegrationResponse) ProtoMessage()    {}
+func (*GetInfluxDBIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{28}
+}
+
+func (m *GetInfluxDBIntegrationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetInfluxDBIntegrationResponse.Unmarshal(m, b)
+}
+func (m *GetInfluxDBIntegrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetInfluxDBIntegrationResponse.Marshal(b, m, deterministic)
+}
+func (m *GetInfluxDBIntegrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetInfluxDBIntegrationResponse.Merge(m, src)
+}
+func (m *GetInfluxDBIntegrationResponse) XXX_Size() int {
+	return xxx_messageInfo_GetInfluxDBIntegrationResponse.Size(m)
+}
+func (m *GetInfluxDBIntegrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetInfluxDBIntegrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetInfluxDBIntegrationResponse proto.InternalMessageInfo
+
+func (m *GetInfluxDBIntegrationResponse) GetIntegration() *InfluxDBIntegration {
+	if m != nil {
+		return m.Integration
+	}
+	return nil
+}
+
+type UpdateInfluxDBIntegrationRequest struct {
+	// Integration object.
+	Integration          *InfluxDBIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UpdateInfluxDBIntegrationRequest) Reset()         { *m = UpdateInfluxDBIntegrationRequest{} }
+func (m *UpdateInfluxDBIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateInfluxDBIntegrationRequest) ProtoMessage()    {}
+func (*UpdateInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{29}
+}
+
+func (m *UpdateInfluxDBIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateInfluxDBIntegrationRequest.Unmarshal(m, b)
+}
+func (m *UpdateInfluxDBIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateInfluxDBIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateInfluxDBIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateInfluxDBIntegrationRequest.Merge(m, src)
+}
+func (m *UpdateInfluxDBIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateInfluxDBIntegrationRequest.Size(m)
+}
+func (m *UpdateInfluxDBIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateInfluxDBIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateInfluxDBIntegrationRequest proto.InternalMessageInfo
+
+func (m *UpdateInfluxDBIntegrationRequest) GetIntegration() *InfluxDBIntegration {
+	if m != nil {
+		return m.Integration
+	}
+	return nil
+}
+
+type DeleteInfluxDBIntegrationRequest struct {
+	// Application ID.
+	ApplicationId        int64    `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteInfluxDBIntegrationRequest) Reset()         { *m = DeleteInfluxDBIntegrationRequest{} }
+func (m *DeleteInfluxDBIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteInfluxDBIntegrationRequest) ProtoMessage()    {}
+func (*DeleteInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{30}
+}
+
+func (m *DeleteInfluxDBIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteInfluxDBIntegrationRequest.Unmarshal(m, b)
+}
+func (m *DeleteInfluxDBIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteInfluxDBIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteInfluxDBIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteInfluxDBIntegrationRequest.Merge(m, src)
+}
+func (m *DeleteInfluxDBIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteInfluxDBIntegrationRequest.Size(m)
+}
+func (m *DeleteInfluxDBIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteInfluxDBIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteInfluxDBIntegrationRequest proto.InternalMessageInfo
+
+func (m *DeleteInfluxDBIntegrationRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+type StreamEventsRequest struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Sequence number of the last received event.
+	// When set, the events after this sequence number are sent first
+	// (if still available in the backlog).
+	LastSeq              uint64   `protobuf:"varint,2,opt,name=last_seq,json=lastSeq,proto3" json:"last_seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamEventsRequest) Reset()         { *m = StreamEventsRequest{} }
+func (m *StreamEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamEventsRequest) ProtoMessage()    {}
+func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{31}
+}
+
+func (m *StreamEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamEventsRequest.Unmarshal(m, b)
+}
+func (m *StreamEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *StreamEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamEventsRequest.Merge(m, src)
+}
+func (m *StreamEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamEventsRequest.Size(m)
+}
+func (m *StreamEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamEventsRequest proto.InternalMessageInfo
+
+func (m *StreamEventsRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *StreamEventsRequest) GetLastSeq() uint64 {
+	if m != nil {
+		return m.LastSeq
+	}
+	return 0
+}
+
+type StreamEventsResponse struct {
+	// Sequence number of the event.
+	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	// The event type.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// The event payload in JSON encoding.
+	PayloadJson          string   `protobuf:"bytes,3,opt,name=payload_json,json=payloadJSON,proto3" json:"payload_json,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamEventsResponse) Reset()         { *m = StreamEventsResponse{} }
+func (m *StreamEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamEventsResponse) ProtoMessage()    {}
+func (*StreamEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{32}
+}
+
+func (m *StreamEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamEventsResponse.Unmarshal(m, b)
+}
+func (m *StreamEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *StreamEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamEventsResponse.Merge(m, src)
+}
+func (m *StreamEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamEventsResponse.Size(m)
+}
+func (m *StreamEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamEventsResponse proto.InternalMessageInfo
+
+func (m *StreamEventsResponse) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *StreamEventsResponse) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *StreamEventsResponse) GetPayloadJson() string {
+	if m != nil {
+		return m.PayloadJson
+	}
+	return ""
+}
+
+type SendDownlinkRequest struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Queue-item object to enqueue.
+	DeviceQueueItem      *DeviceQueueItem `protobuf:"bytes,2,opt,name=device_queue_item,json=deviceQueueItem,proto3" json:"device_queue_item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SendDownlinkRequest) Reset()         { *m = SendDownlinkRequest{} }
+func (m *SendDownlinkRequest) String() string { return proto.CompactTextString(m) }
+func (*SendDownlinkRequest) ProtoMessage()    {}
+func (*SendDownlinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{33}
+}
+
+func (m *SendDownlinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendDownlinkRequest.Unmarshal(m, b)
+}
+func (m *SendDownlinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendDownlinkRequest.Marshal(b, m, deterministic)
+}
+func (m *SendDownlinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendDownlinkRequest.Merge(m, src)
+}
+func (m *SendDownlinkRequest) XXX_Size() int {
+	return xxx_messageInfo_SendDownlinkRequest.Size(m)
+}
+func (m *SendDownlinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendDownlinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendDownlinkRequest proto.InternalMessageInfo
+
+func (m *SendDownlinkRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *SendDownlinkRequest) GetDeviceQueueItem() *DeviceQueueItem {
+	if m != nil {
+		return m.DeviceQueueItem
+	}
+	return nil
+}
+
+type SendDownlinksResponse struct {
+	// Frame-counters of the enqueued payloads (in the order received).
+	FCnt                 []uint32 `protobuf:"varint,1,rep,packed,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendDownlinksResponse) Reset()         { *m = SendDownlinksResponse{} }
+func (m *SendDownlinksResponse) String() string { return proto.CompactTextString(m) }
+func (*SendDownlinksResponse) ProtoMessage()    {}
+func (*SendDownlinksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{34}
+}
+
+func (m *SendDownlinksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendDownlinksResponse.Unmarshal(m, b)
+}
+func (m *SendDownlinksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendDownlinksResponse.Marshal(b, m, deterministic)
+}
+func (m *SendDownlinksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendDownlinksResponse.Merge(m, src)
+}
+func (m *SendDownlinksResponse) XXX_Size() int {
+	return xxx_messageInfo_SendDownlinksResponse.Size(m)
+}
+func (m *SendDownlinksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendDownlinksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendDownlinksResponse proto.InternalMessageInfo
+
+func (m *SendDownlinksResponse) GetFCnt() []uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return nil
+}
+
+type StreamApplicationFrameLogsRequest struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Device EUIs (HEX encoded) to filter on.
+	// When left blank, the frames of all devices are streamed.
+	DevEuis              []string `protobuf:"bytes,2,rep,name=dev_euis,json=devEUIs,proto3" json:"dev_euis,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamApplicationFrameLogsRequest) Reset()         { *m = StreamApplicationFrameLogsRequest{} }
+func (m *StreamApplicationFrameLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamApplicationFrameLogsRequest) ProtoMessage()    {}
+func (*StreamApplicationFrameLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{35}
+}
+
+func (m *StreamApplicationFrameLogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamApplicationFrameLogsRequest.Unmarshal(m, b)
+}
+func (m *StreamApplicationFrameLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamApplicationFrameLogsRequest.Marshal(b, m, deterministic)
+}
+func (m *StreamApplicationFrameLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamApplicationFrameLogsRequest.Merge(m, src)
+}
+func (m *StreamApplicationFrameLogsRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamApplicationFrameLogsRequest.Size(m)
+}
+func (m *StreamApplicationFrameLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamApplicationFrameLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamApplicationFrameLogsRequest proto.InternalMessageInfo
+
+func (m *StreamApplicationFrameLogsRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *StreamApplicationFrameLogsRequest) GetDevEuis() []string {
+	if m != nil {
+		return m.DevEuis
+	}
+	return nil
+}
+
+type StreamApplicationFrameLogsResponse struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Types that are valid to be assigned to Frame:
+	//	*StreamApplicationFrameLogsResponse_UplinkFrame
+	//	*StreamApplicationFrameLogsResponse_DownlinkFrame
+	Frame isStreamApplicationFrameLogsResponse_Frame `protobuf_oneof:"frame"`
+	// The application has more devices than the frames can be streamed for
+	// and the frames are only streamed for a subset of the devices. This is
+	// only set on the first message (without frame) of the stream. Use the
+	// dev_euis filter to select the devices to stream the frames for.
+	Truncated            bool     `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamApplicationFrameLogsResponse) Reset()         { *m = StreamApplicationFrameLogsResponse{} }
+func (m *StreamApplicationFrameLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamApplicationFrameLogsResponse) ProtoMessage()    {}
+func (*StreamApplicationFrameLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{36}
+}
+
+func (m *StreamApplicationFrameLogsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamApplicationFrameLogsResponse.Unmarshal(m, b)
+}
+func (m *StreamApplicationFrameLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamApplicationFrameLogsResponse.Marshal(b, m, deterministic)
+}
+func (m *StreamApplicationFrameLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamApplicationFrameLogsResponse.Merge(m, src)
+}
+func (m *StreamApplicationFrameLogsResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamApplicationFrameLogsResponse.Size(m)
+}
+func (m *StreamApplicationFrameLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamApplicationFrameLogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamApplicationFrameLogsResponse proto.InternalMessageInfo
 
-func (m *GetInfluxDBIntegrationRequest) GetApplicationId() int64 {
+func (m *StreamApplicationFrameLogsResponse) GetDevEui() string {
 	if m != nil {
-		return m.ApplicationId
+		return m.DevEui
 	}
-	return 0
+	return ""
 }
 
-type GetInfluxDBIntegrationResponse struct {
-	// Integration object.
-	Integration          *InfluxDBIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type isStreamApplicationFrameLogsResponse_Frame interface {
+	isStreamApplicationFrameLogsResponse_Frame()
 }
 
-func (m *GetInfluxDBIntegrationResponse) Reset()         { *m = GetInfluxDBIntegrationResponse{} }
-func (m *GetInfluxDBIntegrationResponse) String() string { return proto.CompactTextString(m) }
-func (*GetInfluxDBIntegrationResponse) ProtoMessage()    {}
-func (*GetInfluxDBIntegrationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{23}
+type StreamApplicationFrameLogsResponse_UplinkFrame struct {
+	UplinkFrame *UplinkFrameLog `protobuf:"bytes,2,opt,name=uplink_frame,json=uplinkFrame,proto3,oneof"`
 }
 
-func (m *GetInfluxDBIntegrationResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetInfluxDBIntegrationResponse.Unmarshal(m, b)
-}
-func (m *GetInfluxDBIntegrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetInfluxDBIntegrationResponse.Marshal(b, m, deterministic)
+type StreamApplicationFrameLogsResponse_DownlinkFrame struct {
+	DownlinkFrame *DownlinkFrameLog `protobuf:"bytes,3,opt,name=downlink_frame,json=downlinkFrame,proto3,oneof"`
 }
-func (m *GetInfluxDBIntegrationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetInfluxDBIntegrationResponse.Merge(m, src)
+
+func (*StreamApplicationFrameLogsResponse_UplinkFrame) isStreamApplicationFrameLogsResponse_Frame() {}
+
+func (*StreamApplicationFrameLogsResponse_DownlinkFrame) isStreamApplicationFrameLogsResponse_Frame() {
 }
-func (m *GetInfluxDBIntegrationResponse) XXX_Size() int {
-	return xxx_messageInfo_GetInfluxDBIntegrationResponse.Size(m)
+
+func (m *StreamApplicationFrameLogsResponse) GetFrame() isStreamApplicationFrameLogsResponse_Frame {
+	if m != nil {
+		return m.Frame
+	}
+	return nil
 }
-func (m *GetInfluxDBIntegrationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetInfluxDBIntegrationResponse.DiscardUnknown(m)
+
+func (m *StreamApplicationFrameLogsResponse) GetUplinkFrame() *UplinkFrameLog {
+	if x, ok := m.GetFrame().(*StreamApplicationFrameLogsResponse_UplinkFrame); ok {
+		return x.UplinkFrame
+	}
+	return nil
 }
 
-var xxx_messageInfo_GetInfluxDBIntegrationResponse proto.InternalMessageInfo
+func (m *StreamApplicationFrameLogsResponse) GetDownlinkFrame() *DownlinkFrameLog {
+	if x, ok := m.GetFrame().(*StreamApplicationFrameLogsResponse_DownlinkFrame); ok {
+		return x.DownlinkFrame
+	}
+	return nil
+}
 
-func (m *GetInfluxDBIntegrationResponse) GetIntegration() *InfluxDBIntegration {
+func (m *StreamApplicationFrameLogsResponse) GetTruncated() bool {
 	if m != nil {
-		return m.Integration
+		return m.Truncated
 	}
-	return nil
+	return false
 }
 
-type UpdateInfluxDBIntegrationRequest struct {
-	// Integration object.
-	Integration          *InfluxDBIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*StreamApplicationFrameLogsResponse) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*StreamApplicationFrameLogsResponse_UplinkFrame)(nil),
+		(*StreamApplicationFrameLogsResponse_DownlinkFrame)(nil),
+	}
 }
 
-func (m *UpdateInfluxDBIntegrationRequest) Reset()         { *m = UpdateInfluxDBIntegrationRequest{} }
-func (m *UpdateInfluxDBIntegrationRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateInfluxDBIntegrationRequest) ProtoMessage()    {}
-func (*UpdateInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{24}
+type StreamApplicationEventLogsRequest struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Device EUIs (HEX encoded) to filter on.
+	// When left blank, the events of all devices are streamed.
+	DevEuis []string `protobuf:"bytes,2,rep,name=dev_euis,json=devEUIs,proto3" json:"dev_euis,omitempty"`
+	// Event types to filter on (e.g. uplink, join, ack, error, status,
+	// location). When left blank, all event types are streamed.
+	Types                []string `protobuf:"bytes,3,rep,name=types,proto3" json:"types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateInfluxDBIntegrationRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateInfluxDBIntegrationRequest.Unmarshal(m, b)
+func (m *StreamApplicationEventLogsRequest) Reset()         { *m = StreamApplicationEventLogsRequest{} }
+func (m *StreamApplicationEventLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamApplicationEventLogsRequest) ProtoMessage()    {}
+func (*StreamApplicationEventLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{37}
 }
-func (m *UpdateInfluxDBIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateInfluxDBIntegrationRequest.Marshal(b, m, deterministic)
+
+func (m *StreamApplicationEventLogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamApplicationEventLogsRequest.Unmarshal(m, b)
 }
-func (m *UpdateInfluxDBIntegrationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateInfluxDBIntegrationRequest.Merge(m, src)
+func (m *StreamApplicationEventLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamApplicationEventLogsRequest.Marshal(b, m, deterministic)
 }
-func (m *UpdateInfluxDBIntegrationRequest) XXX_Size() int {
-	return xxx_messageInfo_UpdateInfluxDBIntegrationRequest.Size(m)
+func (m *StreamApplicationEventLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamApplicationEventLogsRequest.Merge(m, src)
 }
-func (m *UpdateInfluxDBIntegrationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateInfluxDBIntegrationRequest.DiscardUnknown(m)
+func (m *StreamApplicationEventLogsRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamApplicationEventLogsRequest.Size(m)
+}
+func (m *StreamApplicationEventLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamApplicationEventLogsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateInfluxDBIntegrationRequest proto.InternalMessageInfo
+var xxx_messageInfo_StreamApplicationEventLogsRequest proto.InternalMessageInfo
 
-func (m *UpdateInfluxDBIntegrationRequest) GetIntegration() *InfluxDBIntegration {
+func (m *StreamApplicationEventLogsRequest) GetApplicationId() int64 {
 	if m != nil {
-		return m.Integration
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *StreamApplicationEventLogsRequest) GetDevEuis() []string {
+	if m != nil {
+		return m.DevEuis
 	}
 	return nil
 }
 
-type DeleteInfluxDBIntegrationRequest struct {
-	// Application ID.
-	ApplicationId        int64    `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+func (m *StreamApplicationEventLogsRequest) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+type StreamApplicationEventLogsResponse struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// The event type.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// The event payload in JSON encoding.
+	PayloadJson          string   `protobuf:"bytes,3,opt,name=payload_json,json=payloadJSON,proto3" json:"payload_json,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteInfluxDBIntegrationRequest) Reset()         { *m = DeleteInfluxDBIntegrationRequest{} }
-func (m *DeleteInfluxDBIntegrationRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteInfluxDBIntegrationRequest) ProtoMessage()    {}
-func (*DeleteInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{25}
+func (m *StreamApplicationEventLogsResponse) Reset()         { *m = StreamApplicationEventLogsResponse{} }
+func (m *StreamApplicationEventLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamApplicationEventLogsResponse) ProtoMessage()    {}
+func (*StreamApplicationEventLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{38}
 }
 
-func (m *DeleteInfluxDBIntegrationRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteInfluxDBIntegrationRequest.Unmarshal(m, b)
+func (m *StreamApplicationEventLogsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamApplicationEventLogsResponse.Unmarshal(m, b)
 }
-func (m *DeleteInfluxDBIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteInfluxDBIntegrationRequest.Marshal(b, m, deterministic)
+func (m *StreamApplicationEventLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamApplicationEventLogsResponse.Marshal(b, m, deterministic)
 }
-func (m *DeleteInfluxDBIntegrationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteInfluxDBIntegrationRequest.Merge(m, src)
+func (m *StreamApplicationEventLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamApplicationEventLogsResponse.Merge(m, src)
 }
-func (m *DeleteInfluxDBIntegrationRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteInfluxDBIntegrationRequest.Size(m)
+func (m *StreamApplicationEventLogsResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamApplicationEventLogsResponse.Size(m)
 }
-func (m *DeleteInfluxDBIntegrationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteInfluxDBIntegrationRequest.DiscardUnknown(m)
+func (m *StreamApplicationEventLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamApplicationEventLogsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteInfluxDBIntegrationRequest proto.InternalMessageInfo
+var xxx_messageInfo_StreamApplicationEventLogsResponse proto.InternalMessageInfo
 
-func (m *DeleteInfluxDBIntegrationRequest) GetApplicationId() int64 {
+func (m *StreamApplicationEventLogsResponse) GetDevEui() string {
 	if m != nil {
-		return m.ApplicationId
+		return m.DevEui
 	}
-	return 0
+	return ""
+}
+
+func (m *StreamApplicationEventLogsResponse) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *StreamApplicationEventLogsResponse) GetPayloadJson() string {
+	if m != nil {
+		return m.PayloadJson
+	}
+	return ""
 }
 
 func init() {
 	proto.RegisterEnum("api.IntegrationKind", IntegrationKind_name, IntegrationKind_value)
+	proto.RegisterEnum("api.HTTPIntegrationTemplateEngine", HTTPIntegrationTemplateEngine_name, HTTPIntegrationTemplateEngine_value)
+	proto.RegisterEnum("api.IntegrationEvent", IntegrationEvent_name, IntegrationEvent_value)
+	proto.RegisterEnum("api.IntegrationMarshaler", IntegrationMarshaler_name, IntegrationMarshaler_value)
 	proto.RegisterEnum("api.InfluxDBPrecision", InfluxDBPrecision_name, InfluxDBPrecision_value)
+	proto.RegisterEnum("api.InfluxDBVersion", InfluxDBVersion_name, InfluxDBVersion_value)
 	proto.RegisterType((*Application)(nil), "api.Application")
 	proto.RegisterType((*ApplicationListItem)(nil), "api.ApplicationListItem")
 	proto.RegisterType((*CreateApplicationRequest)(nil), "api.CreateApplicationRequest")
@@ -1421,6 +2548,7 @@ func init() {
 	proto.RegisterType((*ListApplicationResponse)(nil), "api.ListApplicationResponse")
 	proto.RegisterType((*HTTPIntegrationHeader)(nil), "api.HTTPIntegrationHeader")
 	proto.RegisterType((*HTTPIntegration)(nil), "api.HTTPIntegration")
+	proto.RegisterType((*HTTPIntegrationBodyTemplate)(nil), "api.HTTPIntegrationBodyTemplate")
 	proto.RegisterType((*CreateHTTPIntegrationRequest)(nil), "api.CreateHTTPIntegrationRequest")
 	proto.RegisterType((*GetHTTPIntegrationRequest)(nil), "api.GetHTTPIntegrationRequest")
 	proto.RegisterType((*GetHTTPIntegrationResponse)(nil), "api.GetHTTPIntegrationResponse")
@@ -1428,106 +2556,196 @@ func init() {
 	proto.RegisterType((*DeleteHTTPIntegrationRequest)(nil), "api.DeleteHTTPIntegrationRequest")
 	proto.RegisterType((*ListIntegrationRequest)(nil), "api.ListIntegrationRequest")
 	proto.RegisterType((*IntegrationListItem)(nil), "api.IntegrationListItem")
+	proto.RegisterType((*IntegrationStats)(nil), "api.IntegrationStats")
 	proto.RegisterType((*ListIntegrationResponse)(nil), "api.ListIntegrationResponse")
+	proto.RegisterType((*TestIntegrationRequest)(nil), "api.TestIntegrationRequest")
+	proto.RegisterType((*TestIntegrationResponse)(nil), "api.TestIntegrationResponse")
 	proto.RegisterType((*InfluxDBIntegration)(nil), "api.InfluxDBIntegration")
+	proto.RegisterType((*InfluxDBIntegrationTag)(nil), "api.InfluxDBIntegrationTag")
 	proto.RegisterType((*CreateInfluxDBIntegrationRequest)(nil), "api.CreateInfluxDBIntegrationRequest")
 	proto.RegisterType((*GetInfluxDBIntegrationRequest)(nil), "api.GetInfluxDBIntegrationRequest")
 	proto.RegisterType((*GetInfluxDBIntegrationResponse)(nil), "api.GetInfluxDBIntegrationResponse")
 	proto.RegisterType((*UpdateInfluxDBIntegrationRequest)(nil), "api.UpdateInfluxDBIntegrationRequest")
 	proto.RegisterType((*DeleteInfluxDBIntegrationRequest)(nil), "api.DeleteInfluxDBIntegrationRequest")
+	proto.RegisterType((*StreamEventsRequest)(nil), "api.StreamEventsRequest")
+	proto.RegisterType((*StreamEventsResponse)(nil), "api.StreamEventsResponse")
+	proto.RegisterType((*SendDownlinkRequest)(nil), "api.SendDownlinkRequest")
+	proto.RegisterType((*SendDownlinksResponse)(nil), "api.SendDownlinksResponse")
+	proto.RegisterType((*StreamApplicationFrameLogsRequest)(nil), "api.StreamApplicationFrameLogsRequest")
+	proto.RegisterType((*StreamApplicationFrameLogsResponse)(nil), "api.StreamApplicationFrameLogsResponse")
+	proto.RegisterType((*StreamApplicationEventLogsRequest)(nil), "api.StreamApplicationEventLogsRequest")
+	proto.RegisterType((*StreamApplicationEventLogsResponse)(nil), "api.StreamApplicationEventLogsResponse")
 }
 
 func init() { proto.RegisterFile("application.proto", fileDescriptor_fc846aced8fe6ea6) }
 
 var fileDescriptor_fc846aced8fe6ea6 = []byte{
-	// 1389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xdf, 0x6e, 0x1b, 0xc5,
-	0x17, 0xee, 0xda, 0x89, 0x9b, 0x1c, 0xb7, 0x89, 0x3b, 0x49, 0xdc, 0xcd, 0xd6, 0x4d, 0xf3, 0xdb,
-	0xea, 0x47, 0x83, 0x01, 0xbb, 0x84, 0xa8, 0xa0, 0x08, 0xa9, 0xa5, 0x75, 0x9a, 0x5a, 0x4d, 0x43,
-	0xb4, 0x69, 0x2a, 0x2e, 0xaa, 0x5a, 0x13, 0xef, 0x24, 0x1d, 0xb2, 0xd9, 0x5d, 0x76, 0xc7, 0x85,
-	0x80, 0x7a, 0xc3, 0x05, 0x48, 0x5c, 0x21, 0xf5, 0x16, 0x09, 0x21, 0x24, 0x6e, 0x78, 0x04, 0x1e,
-	0x83, 0x57, 0xe8, 0x83, 0xa0, 0xf9, 0xb3, 0xce, 0x66, 0x3d, 0x9b, 0xb4, 0x4e, 0x90, 0xb8, 0xb2,
-	0x67, 0xce, 0x37, 0x67, 0xbe, 0xf3, 0xcd, 0x99, 0x73, 0x66, 0xe1, 0x12, 0x0e, 0x43, 0x8f, 0x76,
-	0x31, 0xa3, 0x81, 0xdf, 0x08, 0xa3, 0x80, 0x05, 0xa8, 0x88, 0x43, 0x6a, 0xd5, 0x76, 0x83, 0x60,
-	0xd7, 0x23, 0x4d, 0x1c, 0xd2, 0x26, 0xf6, 0xfd, 0x80, 0x09, 0x44, 0x2c, 0x21, 0xd6, 0x15, 0x65,
-	0x15, 0xa3, 0xed, 0xde, 0x4e, 0x93, 0xec, 0x87, 0xec, 0x40, 0x1a, 0xed, 0xbf, 0x0a, 0x50, 0xfe,
-	0xec, 0xd0, 0x2b, 0x9a, 0x80, 0x02, 0x75, 0x4d, 0x63, 0xde, 0x58, 0x28, 0x3a, 0x05, 0xea, 0x22,
-	0x04, 0x23, 0x3e, 0xde, 0x27, 0x66, 0x61, 0xde, 0x58, 0x18, 0x77, 0xc4, 0x7f, 0x34, 0x0f, 0x65,
-	0x97, 0xc4, 0xdd, 0x88, 0x86, 0x7c, 0x89, 0x59, 0x14, 0xa6, 0xf4, 0x14, 0xba, 0x01, 0x93, 0x41,
-	0xb4, 0x8b, 0x7d, 0xfa, 0xad, 0xf0, 0xda, 0xa1, 0xae, 0x39, 0x22, 0x5c, 0x4e, 0xa4, 0xa7, 0xdb,
-	0x2d, 0xf4, 0x3e, 0xa0, 0x98, 0x44, 0x2f, 0x68, 0x97, 0x74, 0xc2, 0x28, 0xd8, 0xa1, 0x1e, 0xe1,
-	0xd8, 0x51, 0xe1, 0xb1, 0xa2, 0x2c, 0x1b, 0xd2, 0xd0, 0x6e, 0xa1, 0xeb, 0x70, 0x31, 0xc4, 0x07,
-	0x5e, 0x80, 0xdd, 0x4e, 0x37, 0x70, 0x49, 0xd7, 0x2c, 0x09, 0xe0, 0x05, 0x35, 0x79, 0x8f, 0xcf,
-	0xa1, 0x25, 0xa8, 0x26, 0x20, 0xe2, 0x73, 0x58, 0xd4, 0x91, 0xc4, 0xcc, 0xf3, 0x02, 0x3d, 0xad,
-	0xac, 0x2b, 0xd2, 0xb8, 0x29, 0x6c, 0xe9, 0x55, 0x2e, 0x39, 0xb2, 0x6a, 0xec, 0xc8, 0xaa, 0x16,
-	0x49, 0xad, 0xb2, 0x5f, 0x1b, 0x30, 0x95, 0x52, 0x6f, 0x8d, 0xc6, 0xac, 0xcd, 0xc8, 0xfe, 0x7f,
-	0x5b, 0xc5, 0x9b, 0x30, 0x9d, 0x45, 0x0b, 0x72, 0x52, 0x4c, 0x74, 0x14, 0xbf, 0x8e, 0xf7, 0x89,
-	0xbd, 0x0e, 0xe6, 0xbd, 0x88, 0x60, 0x46, 0x52, 0xb1, 0x3a, 0xe4, 0xab, 0x1e, 0x89, 0x19, 0x5a,
-	0x84, 0x72, 0x2a, 0x2b, 0x45, 0xcc, 0xe5, 0xc5, 0x4a, 0x03, 0x87, 0xb4, 0x91, 0x46, 0xa7, 0x41,
-	0xf6, 0x7b, 0x30, 0xab, 0xf1, 0x17, 0x87, 0x81, 0x1f, 0x93, 0xac, 0x76, 0xf6, 0x0d, 0x98, 0x59,
-	0x25, 0x4c, 0xb3, 0x73, 0x16, 0xb8, 0x06, 0xd5, 0x2c, 0x50, 0xb9, 0x1c, 0x86, 0xe3, 0x3a, 0x98,
-	0x5b, 0xa1, 0x7b, 0x76, 0x31, 0xd7, 0xc1, 0x6c, 0x11, 0x8f, 0x68, 0xfd, 0x65, 0x23, 0xf9, 0xd1,
-	0x80, 0x2a, 0xcf, 0x25, 0x0d, 0x74, 0x1a, 0x46, 0x3d, 0xba, 0x4f, 0x99, 0x42, 0xcb, 0x01, 0xaa,
-	0x42, 0x29, 0xd8, 0xd9, 0x89, 0x09, 0x13, 0x19, 0x56, 0x74, 0xd4, 0x48, 0x97, 0x41, 0x45, 0x6d,
-	0x06, 0x55, 0xa1, 0x14, 0x13, 0x1c, 0x75, 0x9f, 0x8b, 0x0c, 0x1b, 0x77, 0xd4, 0xc8, 0xf6, 0xe0,
-	0xf2, 0x00, 0x11, 0x25, 0xea, 0x35, 0x28, 0xb3, 0x80, 0x61, 0xaf, 0xd3, 0x0d, 0x7a, 0x7e, 0xc2,
-	0x07, 0xc4, 0xd4, 0x3d, 0x3e, 0x83, 0x6e, 0x42, 0x29, 0x22, 0x71, 0xcf, 0xe3, 0xa4, 0x8a, 0x0b,
-	0xe5, 0x45, 0x33, 0x2b, 0x50, 0x72, 0x5d, 0x1c, 0x85, 0xb3, 0x6f, 0xc3, 0xcc, 0x83, 0xc7, 0x8f,
-	0x37, 0xda, 0x3e, 0x23, 0xbb, 0x91, 0x80, 0x3c, 0x20, 0xd8, 0x25, 0x11, 0xaa, 0x40, 0x71, 0x8f,
-	0x1c, 0x88, 0x3d, 0xc6, 0x1d, 0xfe, 0x97, 0xeb, 0xf0, 0x02, 0x7b, 0xbd, 0xe4, 0x4a, 0xc9, 0x81,
-	0xfd, 0x47, 0x11, 0x26, 0x33, 0x1e, 0xd0, 0xff, 0x61, 0x22, 0x75, 0x0e, 0x9d, 0xbe, 0xd0, 0x17,
-	0x53, 0xb3, 0xed, 0x16, 0x5a, 0x82, 0xf3, 0xcf, 0xc5, 0x66, 0xb1, 0xa2, 0x6b, 0x09, 0xba, 0x5a,
-	0x3e, 0x4e, 0x02, 0x45, 0xef, 0xc0, 0x64, 0x2f, 0xf4, 0xa8, 0xbf, 0xd7, 0x71, 0x31, 0xc3, 0x9d,
-	0x5e, 0xe4, 0xa9, 0x8b, 0x7c, 0x51, 0x4e, 0xb7, 0x30, 0xc3, 0x5b, 0xce, 0x1a, 0x5a, 0x84, 0x99,
-	0x2f, 0x03, 0xea, 0x77, 0xfc, 0x80, 0xd1, 0x9d, 0x84, 0x0a, 0x47, 0x4b, 0xb9, 0xa7, 0xb8, 0x71,
-	0x3d, 0x65, 0xe3, 0x6b, 0x6e, 0xc2, 0x34, 0xee, 0xee, 0x0d, 0x2e, 0x91, 0xf7, 0x1a, 0xe1, 0xee,
-	0x5e, 0x76, 0xc5, 0x12, 0x54, 0x49, 0x14, 0x05, 0xd1, 0xe0, 0x1a, 0x79, 0xb7, 0xa7, 0x85, 0x35,
-	0xbb, 0xea, 0x16, 0x5c, 0x8e, 0x19, 0x66, 0xbd, 0x78, 0x70, 0x99, 0xac, 0x98, 0x33, 0xd2, 0x9c,
-	0x5d, 0xb7, 0x0c, 0xb3, 0x5e, 0xa0, 0xc0, 0x03, 0x2b, 0x65, 0xd5, 0xbc, 0x9c, 0x00, 0x32, 0x6b,
-	0xed, 0x27, 0x50, 0x93, 0x15, 0x20, 0xa3, 0x6f, 0x92, 0xe6, 0xb7, 0xa0, 0x4c, 0x0f, 0x67, 0xd5,
-	0x0d, 0x9b, 0xd6, 0x9d, 0x88, 0x93, 0x06, 0xda, 0x77, 0x61, 0x76, 0x95, 0xb0, 0x1c, 0xa7, 0x6f,
-	0x96, 0x09, 0xf6, 0x63, 0xb0, 0x74, 0x3e, 0x54, 0xda, 0x0f, 0xcb, 0xec, 0x09, 0xd4, 0x64, 0x3d,
-	0x39, 0xe3, 0x88, 0x57, 0xa0, 0x26, 0xeb, 0xca, 0xe9, 0x82, 0xbe, 0x2d, 0x2b, 0xce, 0x69, 0x1c,
-	0x4c, 0xa5, 0x16, 0xf7, 0x3b, 0xe1, 0x02, 0x8c, 0xec, 0x51, 0x5f, 0xae, 0x99, 0x50, 0xf1, 0xa4,
-	0x70, 0x0f, 0xa9, 0xef, 0x3a, 0x02, 0x91, 0x94, 0x1a, 0x9d, 0xe6, 0x43, 0x96, 0x1a, 0x0d, 0x9f,
-	0x7e, 0xa9, 0xf9, 0xa9, 0xc0, 0xf9, 0xee, 0x78, 0xbd, 0x6f, 0x5a, 0x77, 0x87, 0xa8, 0x16, 0x16,
-	0x8c, 0x11, 0xdf, 0x0d, 0x03, 0xea, 0x33, 0x55, 0x81, 0xfa, 0x63, 0x5e, 0xcd, 0xdd, 0x6d, 0x55,
-	0x06, 0x0a, 0xee, 0x36, 0xc7, 0xf6, 0x62, 0x12, 0x89, 0x1e, 0x2b, 0xaf, 0x7b, 0x7f, 0xcc, 0x6d,
-	0x21, 0x8e, 0xe3, 0xaf, 0x83, 0x28, 0xe9, 0xd7, 0xfd, 0x31, 0xaf, 0x19, 0x11, 0x61, 0xc4, 0x17,
-	0x44, 0xc2, 0xc0, 0xa3, 0xdd, 0x83, 0x74, 0xa3, 0x9e, 0xea, 0x1b, 0x37, 0x84, 0x8d, 0x77, 0x6a,
-	0xb4, 0x04, 0xe3, 0x61, 0x44, 0xba, 0x34, 0xe6, 0x39, 0x74, 0x5e, 0x68, 0x5e, 0x55, 0x5a, 0xc8,
-	0x58, 0x37, 0x12, 0xab, 0x73, 0x08, 0xb4, 0x9f, 0xc1, 0xbc, 0xbc, 0x8d, 0x1a, 0x45, 0x92, 0x34,
-	0x58, 0xd6, 0xe5, 0xa7, 0x79, 0xc4, 0x77, 0x6e, 0x8e, 0xde, 0x87, 0xab, 0xab, 0x84, 0x1d, 0xe3,
-	0xfc, 0x0d, 0x73, 0xec, 0x29, 0xcc, 0xe5, 0xf9, 0x51, 0x99, 0x72, 0x1a, 0x96, 0xcf, 0x60, 0x5e,
-	0xde, 0xd0, 0x7f, 0x49, 0x85, 0x36, 0xcc, 0xcb, 0x9b, 0x7a, 0x6a, 0x21, 0xea, 0xef, 0xc2, 0x64,
-	0xe6, 0x12, 0xa1, 0x31, 0x18, 0xe1, 0x15, 0xa0, 0x72, 0x0e, 0x5d, 0x80, 0xb1, 0xf6, 0xfa, 0xfd,
-	0xb5, 0xad, 0x2f, 0x5a, 0x77, 0x2b, 0x46, 0xfd, 0x36, 0x5c, 0x1a, 0x38, 0x7b, 0x54, 0x82, 0xc2,
-	0xfa, 0x66, 0xe5, 0x1c, 0x1a, 0x05, 0x63, 0xab, 0x62, 0xf0, 0xe1, 0xa3, 0xcd, 0x4a, 0x81, 0x0f,
-	0x37, 0x2b, 0x45, 0xfe, 0xf3, 0xa8, 0x32, 0xc2, 0x7f, 0x1e, 0x54, 0x46, 0x17, 0x7f, 0x9b, 0x04,
-	0x94, 0x6a, 0xda, 0x9b, 0xf2, 0x79, 0x88, 0x08, 0x94, 0x64, 0xce, 0xa0, 0xab, 0x22, 0xfc, 0xbc,
-	0x07, 0xa2, 0x35, 0x97, 0x67, 0x96, 0x47, 0x66, 0xd7, 0xbe, 0xff, 0xfb, 0xf5, 0xab, 0x42, 0xd5,
-	0xbe, 0x24, 0x3f, 0x5f, 0x0e, 0x11, 0xf1, 0xb2, 0x51, 0x47, 0xcf, 0xa0, 0xb8, 0x4a, 0x18, 0x92,
-	0xcd, 0x58, 0xfb, 0x0e, 0xb4, 0xae, 0x68, 0x6d, 0xca, 0xfb, 0x9c, 0xf0, 0x6e, 0xa2, 0xea, 0x80,
-	0xf7, 0xe6, 0x77, 0xd4, 0x7d, 0x89, 0x7c, 0x28, 0xc9, 0x43, 0x57, 0x61, 0xe4, 0xbd, 0xf9, 0xac,
-	0x6a, 0x43, 0x7e, 0x46, 0x35, 0x92, 0xcf, 0xa8, 0xc6, 0x0a, 0xff, 0x8c, 0xb2, 0x3f, 0x10, 0x1b,
-	0xdc, 0xb0, 0x6c, 0xcd, 0x06, 0xe9, 0xcf, 0x35, 0xea, 0xbe, 0xe4, 0xf1, 0x74, 0xa0, 0x24, 0x93,
-	0x40, 0xed, 0x97, 0xf7, 0x26, 0xcc, 0xdd, 0x4f, 0x05, 0x54, 0xcf, 0x0b, 0xe8, 0x29, 0x8c, 0xf0,
-	0x62, 0x87, 0xa4, 0x2a, 0xfa, 0x57, 0xa4, 0x55, 0xd3, 0x1b, 0x95, 0x66, 0xb3, 0x62, 0x8b, 0x29,
-	0x34, 0x78, 0x22, 0xe8, 0x57, 0x03, 0x66, 0xb4, 0x8d, 0x1b, 0xfd, 0x2f, 0x75, 0xcc, 0xfa, 0x56,
-	0x94, 0x1b, 0xd2, 0x43, 0xb1, 0xdf, 0x8a, 0x7d, 0x47, 0x17, 0xd2, 0xa1, 0x9b, 0xc6, 0xd1, 0x9b,
-	0xf1, 0xb2, 0x99, 0xb2, 0xc5, 0xcd, 0xe7, 0x8c, 0x85, 0x5c, 0xe0, 0x57, 0x06, 0xa0, 0xc1, 0xf6,
-	0x8d, 0xe6, 0x92, 0x24, 0xc9, 0xe1, 0x76, 0x2d, 0xd7, 0xae, 0x44, 0xf9, 0x54, 0x90, 0xbc, 0x85,
-	0x96, 0x8e, 0x3f, 0x67, 0x3d, 0x31, 0xa1, 0x9b, 0xb6, 0xfd, 0x2b, 0xdd, 0x8e, 0x7b, 0x1a, 0x9c,
-	0xa4, 0x9b, 0x75, 0x26, 0xba, 0xfd, 0x6c, 0xc0, 0x8c, 0xf6, 0x21, 0xa1, 0x18, 0x1e, 0xf7, 0xc8,
-	0xc8, 0x65, 0xa8, 0x44, 0xab, 0x0f, 0x27, 0xda, 0x9f, 0x46, 0xf2, 0x9d, 0xa8, 0xed, 0xd4, 0xa9,
-	0x84, 0xcb, 0xaf, 0xa8, 0xb9, 0xd4, 0x3e, 0x17, 0xd4, 0xda, 0x76, 0xeb, 0x34, 0xe2, 0x51, 0xb1,
-	0xaf, 0xbb, 0xcd, 0x05, 0xfc, 0xdd, 0x10, 0xdf, 0x9f, 0x3a, 0xaa, 0x76, 0x92, 0x5c, 0xc7, 0xf0,
-	0xbc, 0x7e, 0x2c, 0x46, 0x25, 0xe1, 0x1d, 0x41, 0x7a, 0x19, 0x7d, 0xf2, 0xb6, 0x7a, 0x26, 0x44,
-	0x85, 0xa6, 0xb9, 0x5d, 0x4e, 0x69, 0x7a, 0x52, 0x17, 0x3c, 0x49, 0x53, 0xeb, 0xcc, 0x34, 0xfd,
-	0xc5, 0x80, 0xd9, 0xdc, 0x9e, 0xa9, 0xd8, 0x9e, 0xd4, 0x53, 0x73, 0xd9, 0x2a, 0x31, 0xeb, 0xc3,
-	0x8b, 0xf9, 0x83, 0x01, 0x95, 0xcc, 0x9b, 0x35, 0x4e, 0x15, 0x5e, 0x0d, 0x97, 0x9a, 0xde, 0xa8,
-	0x8e, 0xf7, 0x63, 0xc1, 0xe8, 0x43, 0xd4, 0x7c, 0x4b, 0x46, 0xdb, 0x25, 0x11, 0xda, 0x47, 0xff,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x15, 0x7f, 0x76, 0x21, 0x14, 0x00, 0x00,
+	// 2630 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x6f, 0xdb, 0xc8,
+	0xf5, 0x37, 0x2d, 0x59, 0xb6, 0x9e, 0x2c, 0x89, 0x1e, 0xdb, 0x0a, 0xad, 0x38, 0x89, 0x97, 0x8b,
+	0xef, 0xc6, 0xab, 0xdd, 0xd8, 0x89, 0x37, 0xdf, 0x6c, 0x1a, 0x14, 0x9b, 0xd8, 0x96, 0xe2, 0x28,
+	0x71, 0x64, 0x97, 0x92, 0xb3, 0x2d, 0xb0, 0x08, 0x41, 0x8b, 0x23, 0x9b, 0x6b, 0x89, 0x64, 0xc8,
+	0x91, 0x77, 0x95, 0x45, 0x7a, 0xe8, 0xa1, 0x3d, 0x15, 0x68, 0xb1, 0x40, 0x8b, 0xa2, 0x05, 0x7a,
+	0xe8, 0xb1, 0x40, 0xaf, 0x3d, 0xf6, 0x8f, 0xe8, 0xbd, 0xa7, 0xbd, 0xf5, 0x9f, 0x28, 0xe6, 0x07,
+	0x25, 0x4a, 0xa2, 0x24, 0xc7, 0x49, 0x81, 0x9e, 0xc4, 0x79, 0xef, 0xcd, 0x9b, 0xcf, 0xbc, 0x79,
+	0xf3, 0x7e, 0x8c, 0x60, 0xc1, 0x70, 0xdd, 0xa6, 0x55, 0x37, 0x88, 0xe5, 0xd8, 0x1b, 0xae, 0xe7,
+	0x10, 0x07, 0xc5, 0x0c, 0xd7, 0xca, 0xaf, 0x9e, 0x38, 0xce, 0x49, 0x13, 0x6f, 0x1a, 0xae, 0xb5,
+	0x69, 0xd8, 0xb6, 0x43, 0x98, 0x84, 0xcf, 0x45, 0xf2, 0x37, 0x04, 0x97, 0x8d, 0x8e, 0xdb, 0x8d,
+	0x4d, 0x62, 0xb5, 0xb0, 0x4f, 0x8c, 0x96, 0x2b, 0x04, 0xae, 0x0e, 0x0a, 0xe0, 0x96, 0x4b, 0x3a,
+	0x82, 0x79, 0x7d, 0x90, 0x69, 0xb6, 0xbd, 0x10, 0x80, 0xfc, 0x82, 0x89, 0xcf, 0xad, 0x3a, 0xfe,
+	0x49, 0x1b, 0xb7, 0xb1, 0x20, 0xcd, 0xd7, 0x9d, 0x56, 0x2b, 0x10, 0x50, 0xff, 0x3d, 0x0d, 0xa9,
+	0xed, 0x1e, 0x6e, 0x94, 0x81, 0x69, 0xcb, 0x54, 0xa4, 0x35, 0x69, 0x3d, 0xa6, 0x4d, 0x5b, 0x26,
+	0x42, 0x10, 0xb7, 0x8d, 0x16, 0x56, 0xa6, 0xd7, 0xa4, 0xf5, 0xa4, 0xc6, 0xbe, 0xd1, 0x1a, 0xa4,
+	0x4c, 0xec, 0xd7, 0x3d, 0xcb, 0xa5, 0x53, 0x94, 0x18, 0x63, 0x85, 0x49, 0xe8, 0x26, 0x64, 0x1d,
+	0xef, 0xc4, 0xb0, 0xad, 0xd7, 0x4c, 0xab, 0x6e, 0x99, 0x4a, 0x9c, 0xa9, 0xcc, 0x84, 0xc9, 0xe5,
+	0x22, 0xfa, 0x14, 0x90, 0x8f, 0x3d, 0x0a, 0x51, 0x77, 0x3d, 0xa7, 0x61, 0x35, 0x31, 0x95, 0x9d,
+	0x61, 0x1a, 0x65, 0xc1, 0x39, 0xe4, 0x8c, 0x72, 0x11, 0x7d, 0x08, 0x69, 0xd7, 0xe8, 0x34, 0x1d,
+	0xc3, 0xd4, 0xeb, 0x8e, 0x89, 0xeb, 0x4a, 0x82, 0x09, 0xce, 0x0b, 0xe2, 0x2e, 0xa5, 0xa1, 0xbb,
+	0x90, 0x0b, 0x84, 0xb0, 0x4d, 0xc5, 0x3c, 0x9d, 0x03, 0x53, 0x66, 0x99, 0xf4, 0x92, 0xe0, 0x96,
+	0x38, 0xb3, 0xca, 0x78, 0xe1, 0x59, 0x26, 0xee, 0x9b, 0x35, 0xd7, 0x37, 0xab, 0x88, 0xc3, 0xb3,
+	0x36, 0x60, 0xb1, 0xed, 0x36, 0x2d, 0xfb, 0x4c, 0x3f, 0xb5, 0x7c, 0xe2, 0x78, 0x1d, 0xdd, 0x34,
+	0x3a, 0xbe, 0x92, 0x5c, 0x93, 0xd6, 0xd3, 0xda, 0x02, 0x67, 0x3d, 0xe1, 0x9c, 0xa2, 0xd1, 0xf1,
+	0xd5, 0x1f, 0x24, 0x58, 0x0c, 0x59, 0x7b, 0xdf, 0xf2, 0x49, 0x99, 0xe0, 0xd6, 0xff, 0xb6, 0xd5,
+	0x6f, 0xc3, 0xd2, 0xa0, 0x34, 0x03, 0xc7, 0x8d, 0x8f, 0xfa, 0xe5, 0x2b, 0x46, 0x0b, 0xab, 0x15,
+	0x50, 0x76, 0x3d, 0x6c, 0x10, 0x1c, 0xda, 0xab, 0x86, 0x5f, 0xb5, 0xb1, 0x4f, 0xd0, 0x16, 0xa4,
+	0x42, 0xf7, 0x84, 0xed, 0x39, 0xb5, 0x25, 0x6f, 0x18, 0xae, 0xb5, 0x11, 0x96, 0x0e, 0x0b, 0xa9,
+	0x9f, 0xc0, 0x4a, 0x84, 0x3e, 0xdf, 0x75, 0x6c, 0x1f, 0x0f, 0xda, 0x4e, 0xbd, 0x09, 0xcb, 0x7b,
+	0x98, 0x44, 0xac, 0x3c, 0x28, 0xb8, 0x0f, 0xb9, 0x41, 0x41, 0xa1, 0xf2, 0x32, 0x18, 0x2b, 0xa0,
+	0x1c, 0xb9, 0xe6, 0xfb, 0xdb, 0x73, 0x01, 0x94, 0x22, 0x6e, 0x62, 0x82, 0x2f, 0xb0, 0x93, 0x5f,
+	0x49, 0x90, 0xa3, 0xbe, 0x14, 0x21, 0xba, 0x04, 0x33, 0x4d, 0xab, 0x65, 0x11, 0x21, 0xcd, 0x07,
+	0x28, 0x07, 0x09, 0xa7, 0xd1, 0xf0, 0x31, 0x61, 0x1e, 0x16, 0xd3, 0xc4, 0x28, 0xca, 0x83, 0x62,
+	0x91, 0x1e, 0x94, 0x83, 0x84, 0x8f, 0x0d, 0xaf, 0x7e, 0xca, 0x3c, 0x2c, 0xa9, 0x89, 0x91, 0xda,
+	0x84, 0x2b, 0x43, 0x40, 0x84, 0x51, 0x6f, 0x40, 0x8a, 0x38, 0xc4, 0x68, 0xea, 0x75, 0xa7, 0x6d,
+	0x07, 0x78, 0x80, 0x91, 0x76, 0x29, 0x05, 0xdd, 0x86, 0x84, 0x87, 0xfd, 0x76, 0x93, 0x82, 0x8a,
+	0xad, 0xa7, 0xb6, 0x94, 0x41, 0x03, 0x05, 0xd7, 0x45, 0x13, 0x72, 0xea, 0x43, 0x58, 0x7e, 0x52,
+	0xab, 0x1d, 0x96, 0x6d, 0x82, 0x4f, 0x78, 0xd8, 0x7b, 0x82, 0x0d, 0x13, 0x7b, 0x48, 0x86, 0xd8,
+	0x19, 0xee, 0xb0, 0x35, 0x92, 0x1a, 0xfd, 0xa4, 0x76, 0x38, 0x37, 0x9a, 0xed, 0xe0, 0x4a, 0xf1,
+	0x81, 0xfa, 0xf7, 0x04, 0x64, 0x07, 0x34, 0xa0, 0xff, 0x83, 0x4c, 0xe8, 0x1c, 0xf4, 0xae, 0xa1,
+	0xd3, 0x21, 0x6a, 0xb9, 0x88, 0xee, 0xc2, 0xec, 0x29, 0x5b, 0xcc, 0x17, 0x70, 0xf3, 0x0c, 0x6e,
+	0x24, 0x1e, 0x2d, 0x10, 0x45, 0x1f, 0x41, 0x56, 0x04, 0x0c, 0xd3, 0x20, 0x86, 0xde, 0xf6, 0x9a,
+	0xe2, 0x22, 0xa7, 0x39, 0xb9, 0x68, 0x10, 0xe3, 0x48, 0xdb, 0x47, 0x5b, 0xb0, 0xfc, 0xb5, 0x63,
+	0xd9, 0xba, 0xed, 0x10, 0xab, 0x11, 0x40, 0xa1, 0xd2, 0xdc, 0xdc, 0x8b, 0x94, 0x59, 0x09, 0xf1,
+	0xe8, 0x9c, 0xdb, 0xb0, 0x64, 0xd4, 0xcf, 0x86, 0xa7, 0xf0, 0x7b, 0x8d, 0x8c, 0xfa, 0xd9, 0xe0,
+	0x8c, 0xbb, 0x90, 0xc3, 0x9e, 0xe7, 0x78, 0xc3, 0x73, 0xf8, 0xdd, 0x5e, 0x62, 0xdc, 0xc1, 0x59,
+	0xf7, 0xe0, 0x8a, 0x4f, 0x0c, 0xd2, 0xf6, 0x87, 0xa7, 0xf1, 0x08, 0xbb, 0xcc, 0xd9, 0x83, 0xf3,
+	0x1e, 0xc0, 0x4a, 0xd3, 0x11, 0xc2, 0x43, 0x33, 0x79, 0x94, 0xbd, 0x12, 0x08, 0x0c, 0xce, 0xfd,
+	0x1c, 0x92, 0x2d, 0xc3, 0xf3, 0x4f, 0x8d, 0x26, 0xf6, 0x58, 0x78, 0xcd, 0x6c, 0xad, 0x30, 0x7b,
+	0x87, 0x6c, 0xfd, 0x3c, 0x10, 0xd0, 0x7a, 0xb2, 0xd4, 0x51, 0x5b, 0x98, 0x9c, 0x3a, 0xa6, 0x02,
+	0xdc, 0x51, 0xf9, 0x08, 0x7d, 0x06, 0xb3, 0x34, 0xd1, 0x3a, 0x6d, 0xa2, 0xa4, 0xd8, 0x75, 0x5c,
+	0xd9, 0xe0, 0xa9, 0x74, 0x23, 0x48, 0xa5, 0x1b, 0x45, 0x91, 0x4a, 0xb5, 0x40, 0x12, 0x7d, 0x00,
+	0xf3, 0x75, 0xc7, 0x26, 0xd8, 0x26, 0x3a, 0xe9, 0xb8, 0x58, 0x99, 0xe7, 0x31, 0x58, 0xd0, 0x6a,
+	0x1d, 0x17, 0xa3, 0x67, 0x90, 0x25, 0xb8, 0xe5, 0x36, 0x0d, 0x82, 0x75, 0x6c, 0x9f, 0x58, 0x36,
+	0x56, 0xd2, 0x0c, 0xae, 0x1a, 0xe5, 0x1e, 0x35, 0x21, 0x5a, 0x62, 0x92, 0x5a, 0x86, 0xf4, 0x8d,
+	0xd1, 0x1e, 0x64, 0x8e, 0x1d, 0xb3, 0xa3, 0x07, 0x64, 0x5f, 0xc9, 0x30, 0x57, 0x5b, 0x8b, 0xd2,
+	0xb5, 0xe3, 0x98, 0x9d, 0x40, 0x9f, 0x96, 0x3e, 0x0e, 0x8d, 0x7c, 0xf4, 0x31, 0xc8, 0xa6, 0xf3,
+	0x8d, 0xcd, 0x1c, 0x0f, 0xdb, 0xc6, 0x71, 0x13, 0x9b, 0x4a, 0x76, 0x4d, 0x5a, 0x9f, 0xd3, 0xb2,
+	0x01, 0xbd, 0xc4, 0xc9, 0xd4, 0xfd, 0xbb, 0xa2, 0xc4, 0x39, 0xc3, 0xb6, 0x22, 0x73, 0x07, 0x0d,
+	0xa8, 0x35, 0x4a, 0x54, 0x1b, 0x70, 0x75, 0xcc, 0xfa, 0xe8, 0x13, 0x98, 0xc1, 0xe7, 0x58, 0x5c,
+	0xf3, 0xcc, 0xd6, 0xf2, 0xe0, 0x59, 0x95, 0x28, 0x53, 0xe3, 0x32, 0x28, 0x0f, 0x73, 0xc1, 0x0e,
+	0xc5, 0xf5, 0xec, 0x8e, 0xd5, 0x17, 0xb0, 0xca, 0x43, 0xff, 0xc0, 0x6a, 0x41, 0x7c, 0xbb, 0x07,
+	0x29, 0xab, 0x47, 0x15, 0xa1, 0x75, 0x29, 0xca, 0x3e, 0x5a, 0x58, 0x50, 0xdd, 0x81, 0x95, 0x3d,
+	0x4c, 0x46, 0x28, 0xbd, 0x58, 0x08, 0x50, 0x6b, 0x90, 0x8f, 0xd2, 0x21, 0xe2, 0xdd, 0x65, 0x91,
+	0xbd, 0x80, 0x55, 0x9e, 0x48, 0xde, 0xf3, 0x8e, 0x4b, 0xb0, 0xca, 0x13, 0xca, 0xbb, 0x6d, 0xfa,
+	0x21, 0x4f, 0x35, 0x97, 0x57, 0xd0, 0x84, 0xc5, 0xd0, 0xe4, 0x6e, 0x09, 0xb4, 0x0e, 0xf1, 0x33,
+	0xcb, 0x36, 0x85, 0xc3, 0x2c, 0x0d, 0x3a, 0xcc, 0x33, 0xcb, 0x36, 0x35, 0x26, 0x41, 0x7d, 0x8b,
+	0x06, 0x18, 0x9f, 0xf9, 0x4a, 0x6a, 0xd8, 0xb7, 0xaa, 0x94, 0xa9, 0x71, 0x19, 0xf5, 0x1f, 0xd3,
+	0x20, 0x0f, 0xf2, 0x68, 0x1d, 0xe9, 0xb7, 0xeb, 0x75, 0xec, 0xfb, 0x7d, 0xc9, 0x68, 0x5e, 0x10,
+	0x79, 0x3a, 0xba, 0x01, 0x29, 0x1e, 0x1c, 0xb9, 0x08, 0x4f, 0x94, 0xc0, 0x48, 0x5c, 0x60, 0x07,
+	0xb2, 0x4d, 0xc3, 0x27, 0x7a, 0xa0, 0xca, 0x20, 0x2c, 0x96, 0xd3, 0x4c, 0x30, 0x18, 0x4a, 0x6a,
+	0x41, 0x4d, 0xaf, 0xa5, 0xe9, 0x94, 0x2a, 0x9f, 0xb1, 0x4d, 0xd0, 0x17, 0xc0, 0x08, 0x3a, 0x5f,
+	0xc9, 0x20, 0x4a, 0x7c, 0xa2, 0x86, 0x14, 0x9d, 0x50, 0xa2, 0xf2, 0xdb, 0x04, 0x5d, 0x03, 0xe8,
+	0xcd, 0x17, 0x91, 0x3e, 0xd9, 0x15, 0xa0, 0x10, 0x8d, 0x73, 0xec, 0x19, 0x27, 0x58, 0xa7, 0xb7,
+	0xc9, 0xae, 0x77, 0x94, 0xc4, 0xa4, 0x68, 0x97, 0x11, 0x33, 0xf6, 0xf9, 0x84, 0x20, 0xa5, 0x47,
+	0xb9, 0xf8, 0x25, 0x53, 0x7a, 0xc4, 0xf1, 0x77, 0x53, 0xfa, 0xef, 0x24, 0xc8, 0xd5, 0xf0, 0x3b,
+	0xf8, 0x57, 0xd7, 0x91, 0xa6, 0x2f, 0xe2, 0x48, 0x3c, 0x48, 0xc5, 0x26, 0x07, 0x29, 0xf5, 0x0f,
+	0x12, 0x5c, 0xa9, 0xe1, 0x91, 0x76, 0x10, 0x19, 0x91, 0x36, 0x07, 0x0c, 0x56, 0x5a, 0x03, 0x4e,
+	0xa2, 0x4d, 0x09, 0xad, 0xe7, 0x69, 0x40, 0x0e, 0xea, 0x79, 0xfa, 0x4d, 0x33, 0x50, 0x70, 0x26,
+	0xb1, 0x89, 0x19, 0x48, 0x48, 0xd2, 0x32, 0x86, 0x1f, 0x35, 0xaf, 0x03, 0xf8, 0x40, 0xfd, 0xed,
+	0x0c, 0xbd, 0x53, 0x8d, 0x66, 0xfb, 0xdb, 0xe2, 0xce, 0x25, 0x4a, 0x99, 0x3c, 0xcc, 0x61, 0xdb,
+	0x74, 0x1d, 0x4b, 0xb8, 0x79, 0x52, 0xeb, 0x8e, 0x69, 0xa9, 0x69, 0x1e, 0x8b, 0x1a, 0x65, 0xda,
+	0x3c, 0xa6, 0xb2, 0x6d, 0x1f, 0x7b, 0xac, 0x01, 0xe0, 0x18, 0xba, 0x63, 0xca, 0x73, 0x0d, 0xdf,
+	0xff, 0xc6, 0xf1, 0x82, 0x66, 0xa2, 0x3b, 0xa6, 0x05, 0x8d, 0x87, 0x09, 0xb6, 0x19, 0x10, 0xd7,
+	0x69, 0x5a, 0xf5, 0x4e, 0xb8, 0x8b, 0x58, 0xec, 0x32, 0x0f, 0x19, 0x8f, 0xb6, 0x11, 0xe8, 0x2e,
+	0x24, 0x5d, 0x0f, 0xd7, 0x2d, 0x9f, 0xc6, 0xb9, 0x59, 0x76, 0x46, 0x39, 0x71, 0x46, 0x7c, 0xaf,
+	0x87, 0x01, 0x57, 0xeb, 0x09, 0xa2, 0x0d, 0x98, 0x3d, 0xc7, 0x1e, 0x9b, 0x33, 0xd7, 0xe7, 0x02,
+	0x7c, 0xce, 0x0b, 0xce, 0xd3, 0x02, 0x21, 0xa4, 0xc2, 0x7c, 0xb8, 0xb8, 0x65, 0xd5, 0x45, 0x52,
+	0xeb, 0xa3, 0xd1, 0x2a, 0xe2, 0xb8, 0x5d, 0x3f, 0xc3, 0x24, 0xa8, 0x22, 0xf8, 0x88, 0x1e, 0x07,
+	0xcf, 0x91, 0x29, 0x7e, 0x1c, 0x6c, 0x40, 0x2f, 0xe5, 0xb1, 0x41, 0xea, 0xa7, 0xba, 0x6f, 0xbd,
+	0xe6, 0x45, 0x42, 0x5a, 0x4b, 0x32, 0x4a, 0xd5, 0x7a, 0x8d, 0xd1, 0x23, 0xc8, 0x70, 0xb6, 0x65,
+	0x13, 0xec, 0x9d, 0x1b, 0x4d, 0x25, 0x3d, 0xe9, 0xfc, 0xd3, 0x6c, 0x42, 0x59, 0xc8, 0xa3, 0x02,
+	0x2c, 0x78, 0x98, 0x78, 0x1d, 0xfd, 0xb8, 0xdd, 0x68, 0x60, 0x8f, 0xaf, 0x93, 0x61, 0xeb, 0x64,
+	0x19, 0x63, 0x87, 0xd1, 0xd9, 0x6a, 0x08, 0xe2, 0x27, 0xaf, 0x2d, 0x57, 0xa4, 0x7b, 0xf6, 0x8d,
+	0x36, 0x21, 0x4e, 0x8c, 0x13, 0x5f, 0x91, 0xd9, 0xa5, 0xbc, 0xda, 0x67, 0x9f, 0x70, 0x75, 0x62,
+	0x9c, 0x68, 0x4c, 0x10, 0xdd, 0x81, 0xa5, 0x16, 0x36, 0xfc, 0xb6, 0x87, 0x5b, 0xac, 0xf8, 0x09,
+	0xb2, 0xf5, 0x02, 0x3f, 0xbc, 0x10, 0x2f, 0xa8, 0x00, 0xd4, 0x47, 0x90, 0x8b, 0x56, 0x79, 0xe1,
+	0xe2, 0xfc, 0x25, 0xac, 0xf1, 0xd4, 0x1f, 0xa1, 0x27, 0x88, 0x09, 0x0f, 0xa2, 0x92, 0xa1, 0x32,
+	0x6a, 0x43, 0xfd, 0x09, 0xf1, 0x31, 0x5c, 0xdb, 0xc3, 0x64, 0x8c, 0xf2, 0x0b, 0x26, 0xb4, 0xaf,
+	0xe0, 0xfa, 0x28, 0x3d, 0x22, 0x3e, 0xbc, 0x0b, 0xca, 0x97, 0xb0, 0xc6, 0xcb, 0x81, 0xff, 0x92,
+	0x15, 0xca, 0xb0, 0xc6, 0xcb, 0x82, 0x77, 0x37, 0xc4, 0x97, 0xb0, 0x58, 0x25, 0x1e, 0x36, 0x5a,
+	0x2c, 0x70, 0xfa, 0x6f, 0x19, 0xb7, 0x57, 0x60, 0x8e, 0xa7, 0x53, 0xfc, 0x8a, 0xf9, 0x41, 0x9c,
+	0x46, 0x3d, 0x9f, 0x54, 0xf1, 0x2b, 0x55, 0x87, 0xa5, 0x7e, 0xc5, 0xc2, 0xae, 0x32, 0xc4, 0xa8,
+	0xb4, 0xc4, 0xa4, 0xe9, 0x27, 0xf5, 0x76, 0x56, 0x99, 0x8b, 0x40, 0x4b, 0xbf, 0x69, 0xd5, 0x1e,
+	0x3c, 0xed, 0x7c, 0xed, 0xf7, 0x5e, 0x4e, 0x04, 0xed, 0x69, 0xf5, 0xa0, 0xa2, 0xfe, 0x1c, 0x16,
+	0xab, 0xd8, 0x36, 0x8b, 0xa2, 0xc4, 0x7d, 0x4b, 0xe4, 0x8f, 0x40, 0x3c, 0xb3, 0xe9, 0xaf, 0xe8,
+	0x3b, 0x9b, 0x6e, 0x11, 0xdc, 0x12, 0xc5, 0x09, 0x8f, 0x3d, 0xc5, 0xde, 0x23, 0x1c, 0x4b, 0x76,
+	0x59, 0xb3, 0x9f, 0xa0, 0x7e, 0x0a, 0xcb, 0xe1, 0xf5, 0x7b, 0x3b, 0x5c, 0x84, 0x99, 0x86, 0x5e,
+	0x67, 0xb9, 0x35, 0xb6, 0x9e, 0xd6, 0xe2, 0x8d, 0x5d, 0x9b, 0xa8, 0x18, 0x3e, 0xe0, 0xe6, 0x08,
+	0xf5, 0xc6, 0x8f, 0x3d, 0xa3, 0x85, 0xf7, 0x9d, 0x93, 0x4b, 0x58, 0xdd, 0xc4, 0xe7, 0x3a, 0x6e,
+	0x5b, 0xbc, 0x8f, 0x4d, 0x6a, 0xb3, 0x26, 0x3e, 0x2f, 0x1d, 0x95, 0x7d, 0xf5, 0x5f, 0x12, 0xa8,
+	0xe3, 0xd6, 0x11, 0x10, 0xaf, 0xc0, 0xac, 0xd0, 0x20, 0xae, 0x74, 0x82, 0x2b, 0x40, 0xf7, 0x61,
+	0x5e, 0xf4, 0xba, 0x0d, 0x2f, 0x78, 0xcc, 0x4a, 0x6d, 0x2d, 0x32, 0x8b, 0x1c, 0x31, 0x46, 0xa0,
+	0xec, 0xc9, 0x94, 0x96, 0x6a, 0xf7, 0x28, 0xe8, 0x8b, 0x50, 0x0f, 0xc2, 0xe7, 0xc6, 0x42, 0xa5,
+	0x5e, 0x60, 0xa5, 0xd0, 0xec, 0xb4, 0x19, 0xa6, 0xa1, 0x55, 0x48, 0x12, 0xaf, 0x6d, 0xd7, 0x0d,
+	0x82, 0xf9, 0x13, 0xd8, 0x9c, 0xd6, 0x23, 0xec, 0xcc, 0xc2, 0x0c, 0x53, 0xaa, 0x7e, 0x17, 0x61,
+	0x47, 0xe6, 0x61, 0xef, 0xd5, 0x8e, 0x2c, 0x49, 0x74, 0x5c, 0xec, 0x2b, 0x31, 0x46, 0xe7, 0x03,
+	0x95, 0x80, 0x3a, 0x6e, 0xf1, 0x49, 0xc6, 0xbd, 0x9c, 0xa3, 0x17, 0x3e, 0x86, 0xec, 0x40, 0x2d,
+	0x84, 0xe6, 0x20, 0x4e, 0x3b, 0x02, 0x79, 0x0a, 0xcd, 0xc3, 0x5c, 0xb9, 0xf2, 0x78, 0xff, 0xe8,
+	0xa7, 0xc5, 0x1d, 0x59, 0x2a, 0xdc, 0x87, 0x6b, 0x63, 0xbb, 0x55, 0x94, 0x85, 0xd4, 0xde, 0x81,
+	0x5e, 0x2b, 0x3d, 0x3f, 0xdc, 0xdf, 0xae, 0x95, 0xe4, 0x29, 0x94, 0x80, 0xe9, 0xa7, 0x55, 0x59,
+	0x2a, 0xbc, 0xe8, 0x2b, 0xb9, 0xd9, 0xa6, 0x10, 0x40, 0xe2, 0xe8, 0x70, 0xbf, 0x5c, 0x79, 0x26,
+	0x4f, 0xd1, 0x15, 0x9f, 0x1e, 0x94, 0x2b, 0xb2, 0x84, 0x66, 0x21, 0xb6, 0xbd, 0xfb, 0x4c, 0x9e,
+	0x46, 0x49, 0x98, 0x29, 0x69, 0xda, 0x81, 0x26, 0xc7, 0xa8, 0x64, 0xb5, 0xb6, 0x5d, 0x3b, 0xaa,
+	0xca, 0x71, 0x8a, 0x68, 0xff, 0x60, 0x77, 0xbb, 0x56, 0x3e, 0xa8, 0xc8, 0x33, 0x85, 0x2f, 0x61,
+	0x29, 0xaa, 0xdd, 0x67, 0xfa, 0xaa, 0x07, 0x15, 0xbe, 0x83, 0x43, 0xed, 0xa0, 0x76, 0xb0, 0x73,
+	0xf4, 0x58, 0x96, 0x28, 0xc0, 0xdd, 0xfd, 0x83, 0xa3, 0x62, 0xe9, 0x45, 0xa9, 0x52, 0xab, 0xca,
+	0xd3, 0x28, 0x07, 0x28, 0x44, 0xd0, 0x77, 0xca, 0x95, 0x6d, 0xed, 0x67, 0x72, 0xac, 0xf0, 0x10,
+	0x16, 0x86, 0x4a, 0x0a, 0xba, 0x9b, 0x4a, 0x55, 0x9e, 0x42, 0x33, 0x20, 0x1d, 0xc9, 0x12, 0x1d,
+	0x3e, 0xa7, 0x3a, 0x66, 0x40, 0xaa, 0xca, 0x31, 0xfa, 0xf3, 0x5c, 0x8e, 0xd3, 0x9f, 0x27, 0xf2,
+	0x4c, 0xe1, 0x0e, 0x64, 0x03, 0x05, 0xa2, 0xbe, 0x40, 0x19, 0x80, 0xc0, 0x98, 0xfa, 0x1d, 0x79,
+	0xaa, 0x6f, 0xbc, 0x25, 0x4b, 0x5b, 0x7f, 0x5b, 0x02, 0x14, 0x3a, 0xfa, 0x2a, 0x7f, 0x45, 0x45,
+	0x18, 0x12, 0x3c, 0xe9, 0xa1, 0x6b, 0xcc, 0xd9, 0x47, 0xbd, 0xa3, 0xe6, 0xaf, 0x8f, 0x62, 0x73,
+	0xcf, 0x51, 0x57, 0x7f, 0xf1, 0xcf, 0x1f, 0xbe, 0x9f, 0xce, 0xa9, 0x0b, 0xfc, 0x7f, 0x87, 0x9e,
+	0x84, 0xff, 0x40, 0x2a, 0xa0, 0x97, 0x10, 0xdb, 0xc3, 0x04, 0xf1, 0x37, 0xab, 0xc8, 0xe7, 0xd2,
+	0xfc, 0xd5, 0x48, 0x9e, 0xd0, 0x7e, 0x9d, 0x69, 0x57, 0x50, 0x6e, 0x48, 0xfb, 0xe6, 0x77, 0x96,
+	0xf9, 0x06, 0xd9, 0x90, 0xe0, 0x59, 0x4b, 0x6c, 0x63, 0xd4, 0xd3, 0x68, 0x3e, 0x37, 0x54, 0xf4,
+	0x94, 0xe8, 0xdf, 0x1b, 0xea, 0x2d, 0xb6, 0xc0, 0xcd, 0xbc, 0x1a, 0xb1, 0x40, 0x68, 0xb4, 0x61,
+	0x99, 0x6f, 0xe8, 0x7e, 0x74, 0x48, 0xf0, 0x2c, 0x26, 0xd6, 0x1b, 0xf5, 0x74, 0x3a, 0x72, 0x3d,
+	0xb1, 0xa1, 0xc2, 0xa8, 0x0d, 0x7d, 0x05, 0x71, 0xda, 0xab, 0x20, 0x6e, 0x95, 0xe8, 0xc7, 0xd6,
+	0xfc, 0x6a, 0x34, 0x53, 0xd8, 0x6c, 0x85, 0x2d, 0xb1, 0x88, 0x86, 0x4f, 0x04, 0xfd, 0x59, 0x82,
+	0xe5, 0xc8, 0x67, 0x0e, 0xf4, 0x41, 0xe8, 0x98, 0xa3, 0x1b, 0xf7, 0x91, 0x5b, 0x7a, 0xc6, 0xd6,
+	0x2b, 0xa9, 0x8f, 0xa2, 0xb6, 0xd4, 0x53, 0xb3, 0xd1, 0x1f, 0xde, 0xde, 0x6c, 0x86, 0x78, 0xfe,
+	0xe6, 0x29, 0x21, 0x2e, 0x35, 0xf0, 0xf7, 0x12, 0xa0, 0xe1, 0xc7, 0x0e, 0x74, 0x3d, 0x70, 0x92,
+	0x11, 0xd8, 0x6e, 0x8c, 0xe4, 0x0b, 0xa3, 0xfc, 0x98, 0x81, 0xbc, 0x87, 0xee, 0x8e, 0x3f, 0xe7,
+	0x68, 0x60, 0xcc, 0x6e, 0x91, 0x8f, 0x25, 0xc2, 0x6e, 0xe3, 0x1e, 0x52, 0x26, 0xd9, 0x2d, 0xff,
+	0x5e, 0xec, 0xf6, 0x1b, 0x09, 0x96, 0x23, 0x9f, 0x5d, 0x04, 0xc2, 0x71, 0x4f, 0x32, 0x23, 0x11,
+	0x0a, 0xa3, 0x15, 0x2e, 0x67, 0xb4, 0xbf, 0x4a, 0xc1, 0xdf, 0x29, 0x91, 0x3d, 0x63, 0xc8, 0xe1,
+	0x46, 0x97, 0x84, 0x23, 0xa1, 0x1d, 0x30, 0x68, 0x65, 0xb5, 0xf8, 0x2e, 0xc6, 0xb3, 0xd8, 0xba,
+	0xe6, 0x31, 0x35, 0xe0, 0x5f, 0x24, 0xf6, 0x37, 0x4d, 0x14, 0x54, 0x35, 0x70, 0xae, 0x31, 0x38,
+	0x3f, 0x1c, 0x2b, 0x23, 0x9c, 0xf0, 0x11, 0x03, 0xfd, 0x00, 0xdd, 0x7f, 0x5b, 0x7b, 0x06, 0x40,
+	0x99, 0x4d, 0x47, 0x96, 0xe9, 0xc2, 0xa6, 0x93, 0xca, 0xf8, 0x49, 0x36, 0xcd, 0xbf, 0x37, 0x9b,
+	0xfe, 0x49, 0x82, 0x95, 0x91, 0x45, 0xbf, 0x40, 0x3b, 0xa9, 0x29, 0x18, 0x89, 0x56, 0x18, 0xb3,
+	0x70, 0x79, 0x63, 0xfe, 0x52, 0x02, 0x79, 0xe0, 0xc9, 0xc9, 0x0f, 0x05, 0xde, 0x08, 0x2c, 0xab,
+	0xd1, 0x4c, 0x71, 0xbc, 0x9f, 0x33, 0x44, 0x77, 0xd0, 0xe6, 0x5b, 0x22, 0x42, 0xbf, 0x96, 0x20,
+	0x3b, 0xf0, 0xe6, 0x23, 0x70, 0xd4, 0xf0, 0x18, 0x1c, 0x23, 0x9e, 0x89, 0xd4, 0x87, 0x0c, 0xc7,
+	0x8f, 0xd4, 0xb7, 0xbe, 0xb6, 0x04, 0xfb, 0x84, 0x9e, 0xdb, 0xb7, 0x30, 0x1f, 0xee, 0x83, 0x10,
+	0x6f, 0xf1, 0x22, 0x7a, 0xae, 0xfc, 0x4a, 0x04, 0x47, 0xa0, 0xb8, 0xc3, 0x50, 0x7c, 0x82, 0x3e,
+	0xbe, 0x00, 0x0a, 0xf6, 0xf4, 0xe5, 0xdf, 0x96, 0x50, 0x19, 0xd2, 0x7d, 0x0d, 0x4a, 0xb0, 0xf4,
+	0x70, 0xd3, 0x94, 0xcf, 0x0f, 0x71, 0x7a, 0x6b, 0x4f, 0xad, 0x4b, 0xe8, 0xf7, 0x12, 0x64, 0x39,
+	0xb0, 0x6e, 0x2f, 0x81, 0x3e, 0x0a, 0xc1, 0x1d, 0xd3, 0xd4, 0xe4, 0x6f, 0x4e, 0x94, 0xbb, 0xc4,
+	0x26, 0x59, 0x33, 0x40, 0x37, 0xf9, 0xc7, 0x2e, 0xb2, 0x6e, 0x21, 0x3e, 0x0a, 0xd9, 0x60, 0x9b,
+	0x90, 0xbf, 0x39, 0x51, 0x4e, 0x20, 0xfb, 0x7f, 0x86, 0x6c, 0x13, 0xdd, 0xba, 0xa8, 0xf9, 0x6f,
+	0x35, 0x9d, 0x13, 0xff, 0xb6, 0x74, 0x9c, 0x60, 0xf7, 0xec, 0xb3, 0xff, 0x0c, 0x00, 0xc5, 0x21,
+	0x22, 0x6d, 0x67, 0x22, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1570,6 +2788,35 @@ type ApplicationServiceClient interface {
 	DeleteInfluxDBIntegration(ctx context.Context, in *DeleteInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// ListIntegrations lists all configured integrations.
 	ListIntegrations(ctx context.Context, in *ListIntegrationRequest, opts ...grpc.CallOption) (*ListIntegrationResponse, error)
+	// TestIntegration sends a synthetic event through the configured
+	// integration and returns the result.
+	TestIntegration(ctx context.Context, in *TestIntegrationRequest, opts ...grpc.CallOption) (*TestIntegrationResponse, error)
+	// StreamEvents streams the integration events (uplink, join, ack, error,
+	// status and location) of the given application.
+	// When last_seq is set, the events from the backlog with a greater
+	// sequence number are sent first, so that a client is able to resume
+	// after a disconnect.
+	//   * This endpoint does not work from a web-browser.
+	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (ApplicationService_StreamEventsClient, error)
+	// SendDownlinks enqueues the streamed downlink payloads. On closing the
+	// stream, the frame-counters of the enqueued payloads are returned.
+	// All payloads are validated before any payload is enqueued. In case
+	// enqueueing a payload fails, the error contains the frame-counters
+	// of the payloads enqueued before the failed payload. Max. 1000 payloads
+	// can be sent per stream.
+	// Note: this method is only available over gRPC.
+	SendDownlinks(ctx context.Context, opts ...grpc.CallOption) (ApplicationService_SendDownlinksClient, error)
+	// StreamFrameLogs streams the uplink and downlink frame-logs of all the
+	// devices of the given application.
+	//   * Without dev_euis filter, the frames are streamed for max. 1000 devices.
+	//   * This endpoint is intended for debugging only.
+	//   * This endpoint does not work from a web-browser.
+	StreamFrameLogs(ctx context.Context, in *StreamApplicationFrameLogsRequest, opts ...grpc.CallOption) (ApplicationService_StreamFrameLogsClient, error)
+	// StreamEventLogs streams the device events (uplink payloads, ACKs, joins,
+	// errors) of all the devices of the given application.
+	//   * This endpoint is intended for debugging only.
+	//   * This endpoint does not work from a web-browser.
+	StreamEventLogs(ctx context.Context, in *StreamApplicationEventLogsRequest, opts ...grpc.CallOption) (ApplicationService_StreamEventLogsClient, error)
 }
 
 type applicationServiceClient struct {
@@ -1706,6 +2953,145 @@ func (c *applicationServiceClient) ListIntegrations(ctx context.Context, in *Lis
 	return out, nil
 }
 
+func (c *applicationServiceClient) TestIntegration(ctx context.Context, in *TestIntegrationRequest, opts ...grpc.CallOption) (*TestIntegrationResponse, error) {
+	out := new(TestIntegrationResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/TestIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (ApplicationService_StreamEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApplicationService_serviceDesc.Streams[0], "/api.ApplicationService/StreamEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &applicationServiceStreamEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApplicationService_StreamEventsClient interface {
+	Recv() (*StreamEventsResponse, error)
+	grpc.ClientStream
+}
+
+type applicationServiceStreamEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *applicationServiceStreamEventsClient) Recv() (*StreamEventsResponse, error) {
+	m := new(StreamEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *applicationServiceClient) SendDownlinks(ctx context.Context, opts ...grpc.CallOption) (ApplicationService_SendDownlinksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApplicationService_serviceDesc.Streams[1], "/api.ApplicationService/SendDownlinks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &applicationServiceSendDownlinksClient{stream}
+	return x, nil
+}
+
+type ApplicationService_SendDownlinksClient interface {
+	Send(*SendDownlinkRequest) error
+	CloseAndRecv() (*SendDownlinksResponse, error)
+	grpc.ClientStream
+}
+
+type applicationServiceSendDownlinksClient struct {
+	grpc.ClientStream
+}
+
+func (x *applicationServiceSendDownlinksClient) Send(m *SendDownlinkRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *applicationServiceSendDownlinksClient) CloseAndRecv() (*SendDownlinksResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(SendDownlinksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *applicationServiceClient) StreamFrameLogs(ctx context.Context, in *StreamApplicationFrameLogsRequest, opts ...grpc.CallOption) (ApplicationService_StreamFrameLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApplicationService_serviceDesc.Streams[2], "/api.ApplicationService/StreamFrameLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &applicationServiceStreamFrameLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApplicationService_StreamFrameLogsClient interface {
+	Recv() (*StreamApplicationFrameLogsResponse, error)
+	grpc.ClientStream
+}
+
+type applicationServiceStreamFrameLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *applicationServiceStreamFrameLogsClient) Recv() (*StreamApplicationFrameLogsResponse, error) {
+	m := new(StreamApplicationFrameLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *applicationServiceClient) StreamEventLogs(ctx context.Context, in *StreamApplicationEventLogsRequest, opts ...grpc.CallOption) (ApplicationService_StreamEventLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApplicationService_serviceDesc.Streams[3], "/api.ApplicationService/StreamEventLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &applicationServiceStreamEventLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApplicationService_StreamEventLogsClient interface {
+	Recv() (*StreamApplicationEventLogsResponse, error)
+	grpc.ClientStream
+}
+
+type applicationServiceStreamEventLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *applicationServiceStreamEventLogsClient) Recv() (*StreamApplicationEventLogsResponse, error) {
+	m := new(StreamApplicationEventLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ApplicationServiceServer is the server API for ApplicationService service.
 type ApplicationServiceServer interface {
 	// Create creates the given application.
@@ -1736,6 +3122,35 @@ type ApplicationServiceServer interface {
 	DeleteInfluxDBIntegration(context.Context, *DeleteInfluxDBIntegrationRequest) (*empty.Empty, error)
 	// ListIntegrations lists all configured integrations.
 	ListIntegrations(context.Context, *ListIntegrationRequest) (*ListIntegrationResponse, error)
+	// TestIntegration sends a synthetic event through the configured
+	// integration and returns the result.
+	TestIntegration(context.Context, *TestIntegrationRequest) (*TestIntegrationResponse, error)
+	// StreamEvents streams the integration events (uplink, join, ack, error,
+	// status and location) of the given application.
+	// When last_seq is set, the events from the backlog with a greater
+	// sequence number are sent first, so that a client is able to resume
+	// after a disconnect.
+	//   * This endpoint does not work from a web-browser.
+	StreamEvents(*StreamEventsRequest, ApplicationService_StreamEventsServer) error
+	// SendDownlinks enqueues the streamed downlink payloads. On closing the
+	// stream, the frame-counters of the enqueued payloads are returned.
+	// All payloads are validated before any payload is enqueued. In case
+	// enqueueing a payload fails, the error contains the frame-counters
+	// of the payloads enqueued before the failed payload. Max. 1000 payloads
+	// can be sent per stream.
+	// Note: this method is only available over gRPC.
+	SendDownlinks(ApplicationService_SendDownlinksServer) error
+	// StreamFrameLogs streams the uplink and downlink frame-logs of all the
+	// devices of the given application.
+	//   * Without dev_euis filter, the frames are streamed for max. 1000 devices.
+	//   * This endpoint is intended for debugging only.
+	//   * This endpoint does not work from a web-browser.
+	StreamFrameLogs(*StreamApplicationFrameLogsRequest, ApplicationService_StreamFrameLogsServer) error
+	// StreamEventLogs streams the device events (uplink payloads, ACKs, joins,
+	// errors) of all the devices of the given application.
+	//   * This endpoint is intended for debugging only.
+	//   * This endpoint does not work from a web-browser.
+	StreamEventLogs(*StreamApplicationEventLogsRequest, ApplicationService_StreamEventLogsServer) error
 }
 
 func RegisterApplicationServiceServer(s *grpc.Server, srv ApplicationServiceServer) {
@@ -1994,6 +3409,113 @@ func _ApplicationService_ListIntegrations_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_TestIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).TestIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/TestIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).TestIntegration(ctx, req.(*TestIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApplicationServiceServer).StreamEvents(m, &applicationServiceStreamEventsServer{stream})
+}
+
+type ApplicationService_StreamEventsServer interface {
+	Send(*StreamEventsResponse) error
+	grpc.ServerStream
+}
+
+type applicationServiceStreamEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *applicationServiceStreamEventsServer) Send(m *StreamEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApplicationService_SendDownlinks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ApplicationServiceServer).SendDownlinks(&applicationServiceSendDownlinksServer{stream})
+}
+
+type ApplicationService_SendDownlinksServer interface {
+	SendAndClose(*SendDownlinksResponse) error
+	Recv() (*SendDownlinkRequest, error)
+	grpc.ServerStream
+}
+
+type applicationServiceSendDownlinksServer struct {
+	grpc.ServerStream
+}
+
+func (x *applicationServiceSendDownlinksServer) SendAndClose(m *SendDownlinksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *applicationServiceSendDownlinksServer) Recv() (*SendDownlinkRequest, error) {
+	m := new(SendDownlinkRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ApplicationService_StreamFrameLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamApplicationFrameLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApplicationServiceServer).StreamFrameLogs(m, &applicationServiceStreamFrameLogsServer{stream})
+}
+
+type ApplicationService_StreamFrameLogsServer interface {
+	Send(*StreamApplicationFrameLogsResponse) error
+	grpc.ServerStream
+}
+
+type applicationServiceStreamFrameLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *applicationServiceStreamFrameLogsServer) Send(m *StreamApplicationFrameLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApplicationService_StreamEventLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamApplicationEventLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApplicationServiceServer).StreamEventLogs(m, &applicationServiceStreamEventLogsServer{stream})
+}
+
+type ApplicationService_StreamEventLogsServer interface {
+	Send(*StreamApplicationEventLogsResponse) error
+	grpc.ServerStream
+}
+
+type applicationServiceStreamEventLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *applicationServiceStreamEventLogsServer) Send(m *StreamApplicationEventLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.ApplicationService",
 	HandlerType: (*ApplicationServiceServer)(nil),
@@ -2054,7 +3576,32 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListIntegrations",
 			Handler:    _ApplicationService_ListIntegrations_Handler,
 		},
+		{
+			MethodName: "TestIntegration",
+			Handler:    _ApplicationService_TestIntegration_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _ApplicationService_StreamEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SendDownlinks",
+			Handler:       _ApplicationService_SendDownlinks_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamFrameLogs",
+			Handler:       _ApplicationService_StreamFrameLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamEventLogs",
+			Handler:       _ApplicationService_StreamEventLogs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "application.proto",
 }
@@ -0,0 +1,1775 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: codec.proto
+
+package api
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Codec struct {
+	// Codec ID.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Organization ID.
+	// Leave blank (0) for a global codec, available to all organizations.
+	OrganizationId int64 `protobuf:"varint,2,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Codec name.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Codec description.
+	Description          string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Codec) Reset()         { *m = Codec{} }
+func (m *Codec) String() string { return proto.CompactTextString(m) }
+func (*Codec) ProtoMessage()    {}
+func (*Codec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{0}
+}
+
+func (m *Codec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Codec.Unmarshal(m, b)
+}
+func (m *Codec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Codec.Marshal(b, m, deterministic)
+}
+func (m *Codec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Codec.Merge(m, src)
+}
+func (m *Codec) XXX_Size() int {
+	return xxx_messageInfo_Codec.Size(m)
+}
+func (m *Codec) XXX_DiscardUnknown() {
+	xxx_messageInfo_Codec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Codec proto.InternalMessageInfo
+
+func (m *Codec) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Codec) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *Codec) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Codec) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type CodecListItem struct {
+	// Codec ID.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Organization ID (0 for global codecs).
+	OrganizationId int64 `protobuf:"varint,2,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Codec name.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Codec description.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Latest version (0 when the codec has no versions).
+	LatestVersion uint32 `protobuf:"varint,5,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Latest published version (0 when the codec has no published versions).
+	LatestPublishedVersion uint32   `protobuf:"varint,8,opt,name=latest_published_version,json=latestPublishedVersion,proto3" json:"latest_published_version,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
+}
+
+func (m *CodecListItem) Reset()         { *m = CodecListItem{} }
+func (m *CodecListItem) String() string { return proto.CompactTextString(m) }
+func (*CodecListItem) ProtoMessage()    {}
+func (*CodecListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{1}
+}
+
+func (m *CodecListItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CodecListItem.Unmarshal(m, b)
+}
+func (m *CodecListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CodecListItem.Marshal(b, m, deterministic)
+}
+func (m *CodecListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodecListItem.Merge(m, src)
+}
+func (m *CodecListItem) XXX_Size() int {
+	return xxx_messageInfo_CodecListItem.Size(m)
+}
+func (m *CodecListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodecListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodecListItem proto.InternalMessageInfo
+
+func (m *CodecListItem) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CodecListItem) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *CodecListItem) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CodecListItem) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CodecListItem) GetLatestVersion() uint32 {
+	if m != nil {
+		return m.LatestVersion
+	}
+	return 0
+}
+
+func (m *CodecListItem) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *CodecListItem) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *CodecListItem) GetLatestPublishedVersion() uint32 {
+	if m != nil {
+		return m.LatestPublishedVersion
+	}
+	return 0
+}
+
+type CodecVersion struct {
+	// Codec ID.
+	CodecId int64 `protobuf:"varint,1,opt,name=codec_id,json=codecID,proto3" json:"codec_id,omitempty"`
+	// Version.
+	// This is set by the server on creation.
+	Version uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Version description (e.g. the changes compared to the previous
+	// version).
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Payload codec.
+	PayloadCodec string `protobuf:"bytes,4,opt,name=payload_codec,json=payloadCodec,proto3" json:"payload_codec,omitempty"`
+	// Payload encoder script.
+	PayloadEncoderScript string `protobuf:"bytes,5,opt,name=payload_encoder_script,json=payloadEncoderScript,proto3" json:"payload_encoder_script,omitempty"`
+	// Payload decoder script.
+	PayloadDecoderScript string `protobuf:"bytes,6,opt,name=payload_decoder_script,json=payloadDecoderScript,proto3" json:"payload_decoder_script,omitempty"`
+	// Payload codec protobuf descriptor set.
+	// Binary encoded FileDescriptorSet, used by the PROTOBUF payload codec.
+	PayloadCodecDescriptorSet []byte `protobuf:"bytes,7,opt,name=payload_codec_descriptor_set,json=payloadCodecDescriptorSet,proto3" json:"payload_codec_descriptor_set,omitempty"`
+	// Payload codec WebAssembly module.
+	// Binary WebAssembly module, used by the WASM payload codec.
+	PayloadCodecWasmModule []byte `protobuf:"bytes,8,opt,name=payload_codec_wasm_module,json=payloadCodecWASMModule,proto3" json:"payload_codec_wasm_module,omitempty"`
+	// Published.
+	// Only published versions are used by device-profiles using the latest
+	// version of the codec. Set this on creation to publish the version
+	// immediately.
+	Published            bool     `protobuf:"varint,9,opt,name=published,proto3" json:"published,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CodecVersion) Reset()         { *m = CodecVersion{} }
+func (m *CodecVersion) String() string { return proto.CompactTextString(m) }
+func (*CodecVersion) ProtoMessage()    {}
+func (*CodecVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{2}
+}
+
+func (m *CodecVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CodecVersion.Unmarshal(m, b)
+}
+func (m *CodecVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CodecVersion.Marshal(b, m, deterministic)
+}
+func (m *CodecVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodecVersion.Merge(m, src)
+}
+func (m *CodecVersion) XXX_Size() int {
+	return xxx_messageInfo_CodecVersion.Size(m)
+}
+func (m *CodecVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodecVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodecVersion proto.InternalMessageInfo
+
+func (m *CodecVersion) GetCodecId() int64 {
+	if m != nil {
+		return m.CodecId
+	}
+	return 0
+}
+
+func (m *CodecVersion) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *CodecVersion) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CodecVersion) GetPayloadCodec() string {
+	if m != nil {
+		return m.PayloadCodec
+	}
+	return ""
+}
+
+func (m *CodecVersion) GetPayloadEncoderScript() string {
+	if m != nil {
+		return m.PayloadEncoderScript
+	}
+	return ""
+}
+
+func (m *CodecVersion) GetPayloadDecoderScript() string {
+	if m != nil {
+		return m.PayloadDecoderScript
+	}
+	return ""
+}
+
+func (m *CodecVersion) GetPayloadCodecDescriptorSet() []byte {
+	if m != nil {
+		return m.PayloadCodecDescriptorSet
+	}
+	return nil
+}
+
+func (m *CodecVersion) GetPayloadCodecWasmModule() []byte {
+	if m != nil {
+		return m.PayloadCodecWasmModule
+	}
+	return nil
+}
+
+func (m *CodecVersion) GetPublished() bool {
+	if m != nil {
+		return m.Published
+	}
+	return false
+}
+
+type CodecVersionListItem struct {
+	// Codec ID.
+	CodecId int64 `protobuf:"varint,1,opt,name=codec_id,json=codecID,proto3" json:"codec_id,omitempty"`
+	// Version.
+	Version uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Version description.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Payload codec.
+	PayloadCodec string `protobuf:"bytes,4,opt,name=payload_codec,json=payloadCodec,proto3" json:"payload_codec,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Published.
+	Published            bool     `protobuf:"varint,6,opt,name=published,proto3" json:"published,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CodecVersionListItem) Reset()         { *m = CodecVersionListItem{} }
+func (m *CodecVersionListItem) String() string { return proto.CompactTextString(m) }
+func (*CodecVersionListItem) ProtoMessage()    {}
+func (*CodecVersionListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{3}
+}
+
+func (m *CodecVersionListItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CodecVersionListItem.Unmarshal(m, b)
+}
+func (m *CodecVersionListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CodecVersionListItem.Marshal(b, m, deterministic)
+}
+func (m *CodecVersionListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodecVersionListItem.Merge(m, src)
+}
+func (m *CodecVersionListItem) XXX_Size() int {
+	return xxx_messageInfo_CodecVersionListItem.Size(m)
+}
+func (m *CodecVersionListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodecVersionListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodecVersionListItem proto.InternalMessageInfo
+
+func (m *CodecVersionListItem) GetCodecId() int64 {
+	if m != nil {
+		return m.CodecId
+	}
+	return 0
+}
+
+func (m *CodecVersionListItem) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *CodecVersionListItem) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CodecVersionListItem) GetPayloadCodec() string {
+	if m != nil {
+		return m.PayloadCodec
+	}
+	return ""
+}
+
+func (m *CodecVersionListItem) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *CodecVersionListItem) GetPublished() bool {
+	if m != nil {
+		return m.Published
+	}
+	return false
+}
+
+type CodecUsageListItem struct {
+	// Device-profile ID.
+	DeviceProfileId string `protobuf:"bytes,1,opt,name=device_profile_id,json=deviceProfileID,proto3" json:"device_profile_id,omitempty"`
+	// Device-profile name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Organization ID.
+	OrganizationId int64 `protobuf:"varint,3,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Used codec version (0 when using the latest published version).
+	CodecVersion         uint32   `protobuf:"varint,4,opt,name=codec_version,json=codecVersion,proto3" json:"codec_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CodecUsageListItem) Reset()         { *m = CodecUsageListItem{} }
+func (m *CodecUsageListItem) String() string { return proto.CompactTextString(m) }
+func (*CodecUsageListItem) ProtoMessage()    {}
+func (*CodecUsageListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{4}
+}
+
+func (m *CodecUsageListItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CodecUsageListItem.Unmarshal(m, b)
+}
+func (m *CodecUsageListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CodecUsageListItem.Marshal(b, m, deterministic)
+}
+func (m *CodecUsageListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodecUsageListItem.Merge(m, src)
+}
+func (m *CodecUsageListItem) XXX_Size() int {
+	return xxx_messageInfo_CodecUsageListItem.Size(m)
+}
+func (m *CodecUsageListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodecUsageListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodecUsageListItem proto.InternalMessageInfo
+
+func (m *CodecUsageListItem) GetDeviceProfileId() string {
+	if m != nil {
+		return m.DeviceProfileId
+	}
+	return ""
+}
+
+func (m *CodecUsageListItem) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CodecUsageListItem) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *CodecUsageListItem) GetCodecVersion() uint32 {
+	if m != nil {
+		return m.CodecVersion
+	}
+	return 0
+}
+
+type CreateCodecRequest struct {
+	// Codec object to create.
+	Codec                *Codec   `protobuf:"bytes,1,opt,name=codec,proto3" json:"codec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateCodecRequest) Reset()         { *m = CreateCodecRequest{} }
+func (m *CreateCodecRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCodecRequest) ProtoMessage()    {}
+func (*CreateCodecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{5}
+}
+
+func (m *CreateCodecRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCodecRequest.Unmarshal(m, b)
+}
+func (m *CreateCodecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCodecRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateCodecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCodecRequest.Merge(m, src)
+}
+func (m *CreateCodecRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCodecRequest.Size(m)
+}
+func (m *CreateCodecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCodecRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCodecRequest proto.InternalMessageInfo
+
+func (m *CreateCodecRequest) GetCodec() *Codec {
+	if m != nil {
+		return m.Codec
+	}
+	return nil
+}
+
+type CreateCodecResponse struct {
+	// Codec ID.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateCodecResponse) Reset()         { *m = CreateCodecResponse{} }
+func (m *CreateCodecResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateCodecResponse) ProtoMessage()    {}
+func (*CreateCodecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{6}
+}
+
+func (m *CreateCodecResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCodecResponse.Unmarshal(m, b)
+}
+func (m *CreateCodecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCodecResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateCodecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCodecResponse.Merge(m, src)
+}
+func (m *CreateCodecResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateCodecResponse.Size(m)
+}
+func (m *CreateCodecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCodecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCodecResponse proto.InternalMessageInfo
+
+func (m *CreateCodecResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetCodecRequest struct {
+	// Codec ID.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCodecRequest) Reset()         { *m = GetCodecRequest{} }
+func (m *GetCodecRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCodecRequest) ProtoMessage()    {}
+func (*GetCodecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{7}
+}
+
+func (m *GetCodecRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCodecRequest.Unmarshal(m, b)
+}
+func (m *GetCodecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCodecRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCodecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCodecRequest.Merge(m, src)
+}
+func (m *GetCodecRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCodecRequest.Size(m)
+}
+func (m *GetCodecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCodecRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCodecRequest proto.InternalMessageInfo
+
+func (m *GetCodecRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetCodecResponse struct {
+	// Codec object.
+	Codec *Codec `protobuf:"bytes,1,opt,name=codec,proto3" json:"codec,omitempty"`
+	// Latest version (0 when the codec has no versions).
+	LatestVersion uint32 `protobuf:"varint,2,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Latest published version (0 when the codec has no published versions).
+	LatestPublishedVersion uint32   `protobuf:"varint,5,opt,name=latest_published_version,json=latestPublishedVersion,proto3" json:"latest_published_version,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
+}
+
+func (m *GetCodecResponse) Reset()         { *m = GetCodecResponse{} }
+func (m *GetCodecResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCodecResponse) ProtoMessage()    {}
+func (*GetCodecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{8}
+}
+
+func (m *GetCodecResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCodecResponse.Unmarshal(m, b)
+}
+func (m *GetCodecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCodecResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCodecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCodecResponse.Merge(m, src)
+}
+func (m *GetCodecResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCodecResponse.Size(m)
+}
+func (m *GetCodecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCodecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCodecResponse proto.InternalMessageInfo
+
+func (m *GetCodecResponse) GetCodec() *Codec {
+	if m != nil {
+		return m.Codec
+	}
+	return nil
+}
+
+func (m *GetCodecResponse) GetLatestVersion() uint32 {
+	if m != nil {
+		return m.LatestVersion
+	}
+	return 0
+}
+
+func (m *GetCodecResponse) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *GetCodecResponse) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *GetCodecResponse) GetLatestPublishedVersion() uint32 {
+	if m != nil {
+		return m.LatestPublishedVersion
+	}
+	return 0
+}
+
+type UpdateCodecRequest struct {
+	// Codec object to update.
+	// Note that the organization of a codec can not be changed.
+	Codec                *Codec   `protobuf:"bytes,1,opt,name=codec,proto3" json:"codec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateCodecRequest) Reset()         { *m = UpdateCodecRequest{} }
+func (m *UpdateCodecRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateCodecRequest) ProtoMessage()    {}
+func (*UpdateCodecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{9}
+}
+
+func (m *UpdateCodecRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCodecRequest.Unmarshal(m, b)
+}
+func (m *UpdateCodecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCodecRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateCodecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCodecRequest.Merge(m, src)
+}
+func (m *UpdateCodecRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateCodecRequest.Size(m)
+}
+func (m *UpdateCodecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCodecRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateCodecRequest proto.InternalMessageInfo
+
+func (m *UpdateCodecRequest) GetCodec() *Codec {
+	if m != nil {
+		return m.Codec
+	}
+	return nil
+}
+
+type DeleteCodecRequest struct {
+	// Codec ID.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCodecRequest) Reset()         { *m = DeleteCodecRequest{} }
+func (m *DeleteCodecRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCodecRequest) ProtoMessage()    {}
+func (*DeleteCodecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{10}
+}
+
+func (m *DeleteCodecRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCodecRequest.Unmarshal(m, b)
+}
+func (m *DeleteCodecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCodecRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteCodecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCodecRequest.Merge(m, src)
+}
+func (m *DeleteCodecRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteCodecRequest.Size(m)
+}
+func (m *DeleteCodecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCodecRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCodecRequest proto.InternalMessageInfo
+
+func (m *DeleteCodecRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ListCodecRequest struct {
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Organization id to filter on.
+	// When left blank (0), only the global codecs are returned.
+	OrganizationId       int64    `protobuf:"varint,3,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCodecRequest) Reset()         { *m = ListCodecRequest{} }
+func (m *ListCodecRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCodecRequest) ProtoMessage()    {}
+func (*ListCodecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{11}
+}
+
+func (m *ListCodecRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCodecRequest.Unmarshal(m, b)
+}
+func (m *ListCodecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCodecRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCodecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCodecRequest.Merge(m, src)
+}
+func (m *ListCodecRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCodecRequest.Size(m)
+}
+func (m *ListCodecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCodecRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCodecRequest proto.InternalMessageInfo
+
+func (m *ListCodecRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListCodecRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListCodecRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+type ListCodecResponse struct {
+	// Total number of codecs.
+	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Codecs within the result-set.
+	Result               []*CodecListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListCodecResponse) Reset()         { *m = ListCodecResponse{} }
+func (m *ListCodecResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCodecResponse) ProtoMessage()    {}
+func (*ListCodecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{12}
+}
+
+func (m *ListCodecResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCodecResponse.Unmarshal(m, b)
+}
+func (m *ListCodecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCodecResponse.Marshal(b, m, deterministic)
+}
+func (m *ListCodecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCodecResponse.Merge(m, src)
+}
+func (m *ListCodecResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCodecResponse.Size(m)
+}
+func (m *ListCodecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCodecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCodecResponse proto.InternalMessageInfo
+
+func (m *ListCodecResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListCodecResponse) GetResult() []*CodecListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type CreateCodecVersionRequest struct {
+	// Codec version object to create.
+	CodecVersion         *CodecVersion `protobuf:"bytes,1,opt,name=codec_version,json=codecVersion,proto3" json:"codec_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CreateCodecVersionRequest) Reset()         { *m = CreateCodecVersionRequest{} }
+func (m *CreateCodecVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCodecVersionRequest) ProtoMessage()    {}
+func (*CreateCodecVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{13}
+}
+
+func (m *CreateCodecVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCodecVersionRequest.Unmarshal(m, b)
+}
+func (m *CreateCodecVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCodecVersionRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateCodecVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCodecVersionRequest.Merge(m, src)
+}
+func (m *CreateCodecVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCodecVersionRequest.Size(m)
+}
+func (m *CreateCodecVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCodecVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCodecVersionRequest proto.InternalMessageInfo
+
+func (m *CreateCodecVersionRequest) GetCodecVersion() *CodecVersion {
+	if m != nil {
+		return m.CodecVersion
+	}
+	return nil
+}
+
+type CreateCodecVersionResponse struct {
+	// Created version.
+	Version              uint32   `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateCodecVersionResponse) Reset()         { *m = CreateCodecVersionResponse{} }
+func (m *CreateCodecVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateCodecVersionResponse) ProtoMessage()    {}
+func (*CreateCodecVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{14}
+}
+
+func (m *CreateCodecVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCodecVersionResponse.Unmarshal(m, b)
+}
+func (m *CreateCodecVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCodecVersionResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateCodecVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCodecVersionResponse.Merge(m, src)
+}
+func (m *CreateCodecVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateCodecVersionResponse.Size(m)
+}
+func (m *CreateCodecVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCodecVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCodecVersionResponse proto.InternalMessageInfo
+
+func (m *CreateCodecVersionResponse) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type GetCodecVersionRequest struct {
+	// Codec ID.
+	CodecId int64 `protobuf:"varint,1,opt,name=codec_id,json=codecID,proto3" json:"codec_id,omitempty"`
+	// Version.
+	// Set to 0 to get the latest published version.
+	Version              uint32   `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCodecVersionRequest) Reset()         { *m = GetCodecVersionRequest{} }
+func (m *GetCodecVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCodecVersionRequest) ProtoMessage()    {}
+func (*GetCodecVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{15}
+}
+
+func (m *GetCodecVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCodecVersionRequest.Unmarshal(m, b)
+}
+func (m *GetCodecVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCodecVersionRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCodecVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCodecVersionRequest.Merge(m, src)
+}
+func (m *GetCodecVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCodecVersionRequest.Size(m)
+}
+func (m *GetCodecVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCodecVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCodecVersionRequest proto.InternalMessageInfo
+
+func (m *GetCodecVersionRequest) GetCodecId() int64 {
+	if m != nil {
+		return m.CodecId
+	}
+	return 0
+}
+
+func (m *GetCodecVersionRequest) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type GetCodecVersionResponse struct {
+	// Codec version object.
+	CodecVersion *CodecVersion `protobuf:"bytes,1,opt,name=codec_version,json=codecVersion,proto3" json:"codec_version,omitempty"`
+	// Created at timestamp.
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetCodecVersionResponse) Reset()         { *m = GetCodecVersionResponse{} }
+func (m *GetCodecVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCodecVersionResponse) ProtoMessage()    {}
+func (*GetCodecVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{16}
+}
+
+func (m *GetCodecVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCodecVersionResponse.Unmarshal(m, b)
+}
+func (m *GetCodecVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCodecVersionResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCodecVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCodecVersionResponse.Merge(m, src)
+}
+func (m *GetCodecVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCodecVersionResponse.Size(m)
+}
+func (m *GetCodecVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCodecVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCodecVersionResponse proto.InternalMessageInfo
+
+func (m *GetCodecVersionResponse) GetCodecVersion() *CodecVersion {
+	if m != nil {
+		return m.CodecVersion
+	}
+	return nil
+}
+
+func (m *GetCodecVersionResponse) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type PublishCodecVersionRequest struct {
+	// Codec ID.
+	CodecId int64 `protobuf:"varint,1,opt,name=codec_id,json=codecID,proto3" json:"codec_id,omitempty"`
+	// Version.
+	Version              uint32   `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PublishCodecVersionRequest) Reset()         { *m = PublishCodecVersionRequest{} }
+func (m *PublishCodecVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*PublishCodecVersionRequest) ProtoMessage()    {}
+func (*PublishCodecVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{17}
+}
+
+func (m *PublishCodecVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublishCodecVersionRequest.Unmarshal(m, b)
+}
+func (m *PublishCodecVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublishCodecVersionRequest.Marshal(b, m, deterministic)
+}
+func (m *PublishCodecVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishCodecVersionRequest.Merge(m, src)
+}
+func (m *PublishCodecVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_PublishCodecVersionRequest.Size(m)
+}
+func (m *PublishCodecVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishCodecVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishCodecVersionRequest proto.InternalMessageInfo
+
+func (m *PublishCodecVersionRequest) GetCodecId() int64 {
+	if m != nil {
+		return m.CodecId
+	}
+	return 0
+}
+
+func (m *PublishCodecVersionRequest) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type ListCodecVersionsRequest struct {
+	// Codec ID.
+	CodecId int64 `protobuf:"varint,1,opt,name=codec_id,json=codecID,proto3" json:"codec_id,omitempty"`
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset               int64    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCodecVersionsRequest) Reset()         { *m = ListCodecVersionsRequest{} }
+func (m *ListCodecVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCodecVersionsRequest) ProtoMessage()    {}
+func (*ListCodecVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{18}
+}
+
+func (m *ListCodecVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCodecVersionsRequest.Unmarshal(m, b)
+}
+func (m *ListCodecVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCodecVersionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCodecVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCodecVersionsRequest.Merge(m, src)
+}
+func (m *ListCodecVersionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCodecVersionsRequest.Size(m)
+}
+func (m *ListCodecVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCodecVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCodecVersionsRequest proto.InternalMessageInfo
+
+func (m *ListCodecVersionsRequest) GetCodecId() int64 {
+	if m != nil {
+		return m.CodecId
+	}
+	return 0
+}
+
+func (m *ListCodecVersionsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListCodecVersionsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListCodecVersionsResponse struct {
+	// Total number of versions.
+	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Versions within the result-set.
+	Result               []*CodecVersionListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ListCodecVersionsResponse) Reset()         { *m = ListCodecVersionsResponse{} }
+func (m *ListCodecVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCodecVersionsResponse) ProtoMessage()    {}
+func (*ListCodecVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{19}
+}
+
+func (m *ListCodecVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCodecVersionsResponse.Unmarshal(m, b)
+}
+func (m *ListCodecVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCodecVersionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListCodecVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCodecVersionsResponse.Merge(m, src)
+}
+func (m *ListCodecVersionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCodecVersionsResponse.Size(m)
+}
+func (m *ListCodecVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCodecVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCodecVersionsResponse proto.InternalMessageInfo
+
+func (m *ListCodecVersionsResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListCodecVersionsResponse) GetResult() []*CodecVersionListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ListCodecUsageRequest struct {
+	// Codec ID.
+	CodecId              int64    `protobuf:"varint,1,opt,name=codec_id,json=codecID,proto3" json:"codec_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCodecUsageRequest) Reset()         { *m = ListCodecUsageRequest{} }
+func (m *ListCodecUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCodecUsageRequest) ProtoMessage()    {}
+func (*ListCodecUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{20}
+}
+
+func (m *ListCodecUsageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCodecUsageRequest.Unmarshal(m, b)
+}
+func (m *ListCodecUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCodecUsageRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCodecUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCodecUsageRequest.Merge(m, src)
+}
+func (m *ListCodecUsageRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCodecUsageRequest.Size(m)
+}
+func (m *ListCodecUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCodecUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCodecUsageRequest proto.InternalMessageInfo
+
+func (m *ListCodecUsageRequest) GetCodecId() int64 {
+	if m != nil {
+		return m.CodecId
+	}
+	return 0
+}
+
+type ListCodecUsageResponse struct {
+	// Device-profiles using the codec.
+	Result               []*CodecUsageListItem `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ListCodecUsageResponse) Reset()         { *m = ListCodecUsageResponse{} }
+func (m *ListCodecUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCodecUsageResponse) ProtoMessage()    {}
+func (*ListCodecUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{21}
+}
+
+func (m *ListCodecUsageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCodecUsageResponse.Unmarshal(m, b)
+}
+func (m *ListCodecUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCodecUsageResponse.Marshal(b, m, deterministic)
+}
+func (m *ListCodecUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCodecUsageResponse.Merge(m, src)
+}
+func (m *ListCodecUsageResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCodecUsageResponse.Size(m)
+}
+func (m *ListCodecUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCodecUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCodecUsageResponse proto.InternalMessageInfo
+
+func (m *ListCodecUsageResponse) GetResult() []*CodecUsageListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Codec)(nil), "api.Codec")
+	proto.RegisterType((*CodecListItem)(nil), "api.CodecListItem")
+	proto.RegisterType((*CodecVersion)(nil), "api.CodecVersion")
+	proto.RegisterType((*CodecVersionListItem)(nil), "api.CodecVersionListItem")
+	proto.RegisterType((*CodecUsageListItem)(nil), "api.CodecUsageListItem")
+	proto.RegisterType((*CreateCodecRequest)(nil), "api.CreateCodecRequest")
+	proto.RegisterType((*CreateCodecResponse)(nil), "api.CreateCodecResponse")
+	proto.RegisterType((*GetCodecRequest)(nil), "api.GetCodecRequest")
+	proto.RegisterType((*GetCodecResponse)(nil), "api.GetCodecResponse")
+	proto.RegisterType((*UpdateCodecRequest)(nil), "api.UpdateCodecRequest")
+	proto.RegisterType((*DeleteCodecRequest)(nil), "api.DeleteCodecRequest")
+	proto.RegisterType((*ListCodecRequest)(nil), "api.ListCodecRequest")
+	proto.RegisterType((*ListCodecResponse)(nil), "api.ListCodecResponse")
+	proto.RegisterType((*CreateCodecVersionRequest)(nil), "api.CreateCodecVersionRequest")
+	proto.RegisterType((*CreateCodecVersionResponse)(nil), "api.CreateCodecVersionResponse")
+	proto.RegisterType((*GetCodecVersionRequest)(nil), "api.GetCodecVersionRequest")
+	proto.RegisterType((*GetCodecVersionResponse)(nil), "api.GetCodecVersionResponse")
+	proto.RegisterType((*PublishCodecVersionRequest)(nil), "api.PublishCodecVersionRequest")
+	proto.RegisterType((*ListCodecVersionsRequest)(nil), "api.ListCodecVersionsRequest")
+	proto.RegisterType((*ListCodecVersionsResponse)(nil), "api.ListCodecVersionsResponse")
+	proto.RegisterType((*ListCodecUsageRequest)(nil), "api.ListCodecUsageRequest")
+	proto.RegisterType((*ListCodecUsageResponse)(nil), "api.ListCodecUsageResponse")
+}
+
+func init() { proto.RegisterFile("codec.proto", fileDescriptor_9610d574777ab505) }
+
+var fileDescriptor_9610d574777ab505 = []byte{
+	// 1164 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xdd, 0x6e, 0xe3, 0x54,
+	0x10, 0x96, 0x9d, 0x26, 0x6d, 0x26, 0x49, 0x7f, 0xce, 0x76, 0xb3, 0x8e, 0x5b, 0xda, 0xd4, 0xbb,
+	0xab, 0x0d, 0x45, 0x24, 0x6a, 0x41, 0xd5, 0x82, 0x90, 0x50, 0xd5, 0xae, 0xaa, 0xa2, 0xad, 0xb4,
+	0x38, 0x2c, 0xdc, 0x20, 0x19, 0x37, 0x3e, 0x2d, 0x46, 0x4e, 0x6c, 0xec, 0x93, 0xa2, 0xdd, 0x55,
+	0x6f, 0xb8, 0x41, 0x02, 0x2e, 0x90, 0x78, 0x03, 0x6e, 0x78, 0x20, 0xc4, 0x1b, 0x70, 0xc7, 0x4b,
+	0x20, 0xcf, 0x39, 0xc7, 0xf5, 0x4f, 0xb2, 0x69, 0xab, 0x15, 0x7b, 0x95, 0xf8, 0xcc, 0xcc, 0x37,
+	0xdf, 0x7c, 0x33, 0x67, 0x6c, 0xa8, 0x0d, 0x7c, 0x87, 0x0e, 0xba, 0x41, 0xe8, 0x33, 0x9f, 0x94,
+	0xec, 0xc0, 0xd5, 0xd7, 0xcf, 0x7d, 0xff, 0xdc, 0xa3, 0x3d, 0x3b, 0x70, 0x7b, 0xf6, 0x68, 0xe4,
+	0x33, 0x9b, 0xb9, 0xfe, 0x28, 0xe2, 0x2e, 0xfa, 0xa6, 0xb0, 0xe2, 0xd3, 0xe9, 0xf8, 0xac, 0xc7,
+	0xdc, 0x21, 0x8d, 0x98, 0x3d, 0x0c, 0x84, 0xc3, 0x5a, 0xde, 0x81, 0x0e, 0x03, 0xf6, 0x82, 0x1b,
+	0x8d, 0x0b, 0x28, 0x1f, 0xc4, 0xf9, 0xc8, 0x22, 0xa8, 0xae, 0xa3, 0x29, 0x6d, 0xa5, 0x53, 0x32,
+	0x55, 0xd7, 0x21, 0x8f, 0x60, 0xc9, 0x0f, 0xcf, 0xed, 0x91, 0xfb, 0x12, 0xb3, 0x59, 0xae, 0xa3,
+	0xa9, 0x68, 0x5c, 0x4c, 0x1f, 0x1f, 0x1f, 0x12, 0x02, 0x73, 0x23, 0x7b, 0x48, 0xb5, 0x52, 0x5b,
+	0xe9, 0x54, 0x4d, 0xfc, 0x4f, 0xda, 0x50, 0x73, 0x68, 0x34, 0x08, 0xdd, 0x20, 0x76, 0xd2, 0xe6,
+	0xd0, 0x94, 0x3e, 0x32, 0xfe, 0x56, 0xa1, 0x81, 0x89, 0x9f, 0xba, 0x11, 0x3b, 0x66, 0x74, 0xf8,
+	0x3f, 0x13, 0x20, 0x0f, 0x61, 0xd1, 0xb3, 0x19, 0x8d, 0x98, 0x75, 0x41, 0xc3, 0x28, 0x76, 0x2a,
+	0xb7, 0x95, 0x4e, 0xc3, 0x6c, 0xf0, 0xd3, 0x2f, 0xf9, 0x21, 0xf9, 0x08, 0x60, 0x10, 0x52, 0x9b,
+	0x51, 0xc7, 0xb2, 0x99, 0x56, 0x69, 0x2b, 0x9d, 0xda, 0xae, 0xde, 0xe5, 0x8a, 0x76, 0xa5, 0xa2,
+	0xdd, 0x2f, 0xa4, 0xe4, 0x66, 0x55, 0x78, 0xef, 0xb3, 0x38, 0x74, 0x1c, 0x38, 0x32, 0x74, 0x7e,
+	0x76, 0xa8, 0xf0, 0xde, 0x67, 0xe4, 0x31, 0x68, 0x82, 0x5c, 0x30, 0x3e, 0xf5, 0xdc, 0xe8, 0x5b,
+	0xea, 0x24, 0x34, 0x17, 0x90, 0x66, 0x93, 0xdb, 0x9f, 0x49, 0xb3, 0xe0, 0x6b, 0xfc, 0x56, 0x82,
+	0x3a, 0xea, 0x2a, 0x0b, 0x68, 0xc1, 0x02, 0x0e, 0x94, 0x95, 0x88, 0x3b, 0x8f, 0xcf, 0xc7, 0x87,
+	0x44, 0x83, 0x79, 0x09, 0xaa, 0x22, 0xa8, 0x7c, 0xcc, 0xcb, 0x57, 0x2a, 0xca, 0x77, 0x1f, 0x1a,
+	0x81, 0xfd, 0xc2, 0xf3, 0x6d, 0xc7, 0x42, 0x38, 0x21, 0x71, 0x5d, 0x1c, 0xf2, 0x99, 0xfa, 0x10,
+	0x9a, 0xd2, 0x89, 0x8e, 0x62, 0xb7, 0xd0, 0xe2, 0x08, 0xa8, 0x75, 0xd5, 0x5c, 0x15, 0xd6, 0x27,
+	0xdc, 0xd8, 0x47, 0x5b, 0x3a, 0xca, 0xa1, 0x99, 0xa8, 0x4a, 0x26, 0xea, 0x90, 0xa6, 0xa3, 0x3e,
+	0x85, 0xf5, 0x0c, 0x21, 0x4b, 0xb2, 0xf5, 0x43, 0x2b, 0xa2, 0x5c, 0xff, 0xba, 0xd9, 0x4a, 0xf3,
+	0x3b, 0x4c, 0x3c, 0xfa, 0x34, 0x6e, 0x57, 0x2b, 0x0b, 0xf0, 0x83, 0x1d, 0x0d, 0xad, 0xa1, 0xef,
+	0x8c, 0x3d, 0x8a, 0xa2, 0xd7, 0xcd, 0x66, 0x3a, 0xfa, 0xab, 0xfd, 0xfe, 0xc9, 0x09, 0x5a, 0xc9,
+	0x3a, 0x54, 0x93, 0x3e, 0x69, 0xd5, 0xb6, 0xd2, 0x59, 0x30, 0xaf, 0x0e, 0x8c, 0x7f, 0x15, 0x58,
+	0x4d, 0xb7, 0x24, 0x99, 0xf8, 0xb7, 0xd9, 0x9a, 0xec, 0x5c, 0x97, 0x6f, 0x32, 0xd7, 0x99, 0x6a,
+	0x2b, 0xf9, 0x6a, 0xff, 0x50, 0x80, 0x60, 0x8a, 0xe7, 0x91, 0x7d, 0x4e, 0x93, 0x5a, 0xb7, 0x61,
+	0xc5, 0xa1, 0x17, 0xee, 0x80, 0x5a, 0x41, 0xe8, 0x9f, 0xb9, 0x1e, 0x95, 0x45, 0x57, 0xcd, 0x25,
+	0x6e, 0x78, 0xc6, 0xcf, 0x53, 0x17, 0x5a, 0x4d, 0x5d, 0xe8, 0x09, 0xdb, 0xa0, 0x34, 0x71, 0x1b,
+	0xdc, 0x87, 0x06, 0x17, 0x55, 0xea, 0x37, 0x87, 0xfa, 0xd5, 0x07, 0xa9, 0x0e, 0x18, 0x7b, 0x40,
+	0x0e, 0xb0, 0x1e, 0x64, 0x6a, 0xd2, 0xef, 0xc7, 0x34, 0x62, 0xa4, 0x0d, 0x65, 0x2e, 0x98, 0x82,
+	0x72, 0x40, 0xd7, 0x0e, 0xdc, 0x2e, 0xf7, 0xe0, 0x06, 0xe3, 0x21, 0xdc, 0xc9, 0xc4, 0x45, 0x81,
+	0x3f, 0x8a, 0x68, 0x7e, 0x75, 0x19, 0x5b, 0xb0, 0x74, 0x44, 0x59, 0x06, 0x3b, 0xef, 0xf2, 0x8b,
+	0x0a, 0xcb, 0x57, 0x3e, 0x02, 0x67, 0x26, 0x81, 0x09, 0x5b, 0x4b, 0x9d, 0xbd, 0xb5, 0x4a, 0xb7,
+	0xdf, 0x5a, 0x73, 0x6f, 0x6a, 0x6b, 0x95, 0x5f, 0xbb, 0xb5, 0xf6, 0x80, 0x3c, 0x47, 0x98, 0x1b,
+	0xf6, 0xe3, 0x01, 0x90, 0x43, 0xea, 0x51, 0x46, 0x5f, 0xab, 0xb5, 0x0b, 0xcb, 0xf1, 0x1c, 0x66,
+	0x7c, 0x56, 0xa1, 0xec, 0xb9, 0x43, 0x97, 0x09, 0x37, 0xfe, 0x40, 0x9a, 0x50, 0xf1, 0xcf, 0xce,
+	0xe2, 0x75, 0xc1, 0x5f, 0x35, 0xe2, 0xe9, 0xda, 0xd3, 0x67, 0x7c, 0x03, 0x2b, 0xa9, 0x54, 0xa2,
+	0xad, 0x9b, 0x50, 0x63, 0x3e, 0xb3, 0x3d, 0x6b, 0xe0, 0x8f, 0x47, 0x32, 0x23, 0xe0, 0xd1, 0x41,
+	0x7c, 0x42, 0xb6, 0xa1, 0x12, 0xd2, 0x68, 0xec, 0xc5, 0x69, 0x4b, 0x9d, 0xda, 0x2e, 0xb9, 0xaa,
+	0x54, 0x5e, 0x20, 0x53, 0x78, 0x18, 0x7d, 0x68, 0xa5, 0x46, 0x50, 0x08, 0x28, 0xab, 0xda, 0xcb,
+	0x0f, 0x3f, 0x57, 0x6e, 0xe5, 0x0a, 0x4f, 0x06, 0xe4, 0xef, 0x83, 0x3e, 0x09, 0x54, 0xf0, 0x4f,
+	0x2d, 0x23, 0x25, 0xb3, 0x8c, 0x8c, 0x13, 0x68, 0xca, 0x21, 0xce, 0x31, 0xb9, 0xcd, 0x6e, 0x33,
+	0x7e, 0x55, 0xe0, 0x5e, 0x01, 0x4f, 0x90, 0xb8, 0x65, 0x69, 0xb9, 0xab, 0xa0, 0xde, 0xe0, 0x2a,
+	0x18, 0x9f, 0x83, 0x2e, 0x26, 0xf5, 0x8d, 0x55, 0x38, 0x00, 0x2d, 0x99, 0x0f, 0x81, 0x17, 0x5d,
+	0x03, 0x30, 0x99, 0x56, 0x75, 0xf2, 0xb4, 0x96, 0xd2, 0xd3, 0x6a, 0xf8, 0xd0, 0x9a, 0x90, 0xe4,
+	0xba, 0xc3, 0xb8, 0x93, 0x1b, 0xc6, 0x56, 0x41, 0xe1, 0xc2, 0x4c, 0xee, 0xc2, 0xdd, 0x24, 0x21,
+	0xae, 0xfd, 0xd9, 0x25, 0x19, 0xc7, 0xd0, 0xcc, 0xc7, 0x08, 0x86, 0xbd, 0x84, 0x80, 0x82, 0x04,
+	0xee, 0x5d, 0x11, 0xc8, 0xbc, 0x53, 0x64, 0xfa, 0xdd, 0x3f, 0x17, 0xc4, 0x37, 0x4f, 0x9f, 0x86,
+	0xf1, 0x9b, 0x84, 0xf4, 0xa1, 0xc2, 0xc7, 0x99, 0x88, 0xd8, 0xc2, 0xae, 0xd7, 0xb5, 0xa2, 0x81,
+	0xa7, 0x37, 0x9a, 0x3f, 0xfe, 0xf5, 0xcf, 0xef, 0xea, 0xb2, 0x51, 0xc3, 0xef, 0x6d, 0xa4, 0x1b,
+	0x7d, 0xac, 0x6c, 0x93, 0xa7, 0x50, 0x3a, 0xa2, 0x8c, 0xac, 0x62, 0x60, 0x6e, 0xbd, 0xeb, 0x77,
+	0x73, 0xa7, 0x02, 0x4b, 0x43, 0x2c, 0x42, 0x96, 0x53, 0x58, 0xbd, 0x57, 0xae, 0x73, 0x49, 0xbe,
+	0x86, 0x0a, 0xdf, 0x78, 0x82, 0x62, 0x71, 0xfd, 0xe9, 0xcd, 0xc2, 0x94, 0x3e, 0x89, 0x3f, 0xdc,
+	0x8d, 0x2d, 0x04, 0x5d, 0xd3, 0x9b, 0x19, 0x50, 0xfc, 0xed, 0xba, 0xce, 0x65, 0xcc, 0xb5, 0x0f,
+	0x15, 0xbe, 0x17, 0x05, 0x7a, 0x71, 0x49, 0x4e, 0x45, 0x17, 0x94, 0xb7, 0x8b, 0x94, 0x3f, 0x83,
+	0xb9, 0x58, 0x7a, 0xc2, 0x6b, 0xcd, 0x6f, 0x54, 0xbd, 0x99, 0x3f, 0x16, 0x1a, 0xdc, 0x41, 0xc0,
+	0x06, 0x49, 0xeb, 0x49, 0x7e, 0x56, 0xa0, 0xc1, 0xc5, 0x97, 0xf7, 0x74, 0x23, 0xdf, 0x90, 0xec,
+	0x75, 0xd3, 0x37, 0xa7, 0xda, 0x45, 0x9e, 0xc7, 0x98, 0x67, 0xd7, 0x78, 0xbf, 0x28, 0x8b, 0xdc,
+	0x19, 0x5d, 0x39, 0x8c, 0x97, 0x3d, 0x71, 0x82, 0x9d, 0xfd, 0x49, 0x81, 0x45, 0x71, 0xd1, 0x25,
+	0x1b, 0x9e, 0x6d, 0xfa, 0xed, 0x9f, 0x2a, 0xdf, 0x27, 0xc8, 0x62, 0xcf, 0xd8, 0x99, 0xc0, 0x22,
+	0x9d, 0xb7, 0xf7, 0x4a, 0xfc, 0xbb, 0xec, 0x89, 0x97, 0x65, 0xcc, 0xe4, 0x25, 0xc0, 0x11, 0x4d,
+	0xde, 0xe2, 0x6b, 0x99, 0xa1, 0xca, 0x11, 0x58, 0x9f, 0x6c, 0x14, 0x62, 0xec, 0x20, 0x8d, 0xf7,
+	0xc8, 0xbb, 0xd7, 0xa6, 0x41, 0x2e, 0xa0, 0x1e, 0x37, 0x4f, 0x20, 0x45, 0xe4, 0x9d, 0x6c, 0x3f,
+	0x73, 0xdb, 0x4a, 0xdf, 0x98, 0x66, 0x16, 0x0c, 0x1e, 0x21, 0x83, 0x2d, 0xb2, 0x39, 0x83, 0x01,
+	0xf9, 0x0e, 0xaa, 0x31, 0x0a, 0x5e, 0x6d, 0xa2, 0x67, 0x51, 0xd3, 0xcb, 0x44, 0x5f, 0x9b, 0x68,
+	0x13, 0xe9, 0x1e, 0x60, 0xba, 0x0d, 0xb2, 0x3e, 0x25, 0xdd, 0x38, 0xf6, 0x3e, 0xad, 0x60, 0xb7,
+	0x3e, 0xf8, 0x6f, 0x00, 0x56, 0xef, 0xe6, 0x69, 0x64, 0x0f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CodecServiceClient is the client API for CodecService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CodecServiceClient interface {
+	// Create creates the given codec.
+	Create(ctx context.Context, in *CreateCodecRequest, opts ...grpc.CallOption) (*CreateCodecResponse, error)
+	// Get returns the codec matching the given id.
+	Get(ctx context.Context, in *GetCodecRequest, opts ...grpc.CallOption) (*GetCodecResponse, error)
+	// Update updates the given codec.
+	Update(ctx context.Context, in *UpdateCodecRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Delete deletes the codec matching the given id.
+	// A codec which is used by device-profiles can not be deleted.
+	Delete(ctx context.Context, in *DeleteCodecRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// List lists the codecs available to the given organization (including
+	// the global codecs).
+	List(ctx context.Context, in *ListCodecRequest, opts ...grpc.CallOption) (*ListCodecResponse, error)
+	// CreateVersion creates a new version of the given codec.
+	// Versions are immutable. Unless published is set, the version is
+	// created as draft. Device-profiles using the latest version of the
+	// codec will only use the new version once it has been published.
+	CreateVersion(ctx context.Context, in *CreateCodecVersionRequest, opts ...grpc.CallOption) (*CreateCodecVersionResponse, error)
+	// PublishVersion publishes the given (draft) codec version.
+	// Device-profiles using the latest version of the codec will use the
+	// published version immediately.
+	PublishVersion(ctx context.Context, in *PublishCodecVersionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetVersion returns the given codec version.
+	GetVersion(ctx context.Context, in *GetCodecVersionRequest, opts ...grpc.CallOption) (*GetCodecVersionResponse, error)
+	// ListVersions lists the versions of the given codec (latest first).
+	ListVersions(ctx context.Context, in *ListCodecVersionsRequest, opts ...grpc.CallOption) (*ListCodecVersionsResponse, error)
+	// ListUsage lists the device-profiles using the given codec.
+	ListUsage(ctx context.Context, in *ListCodecUsageRequest, opts ...grpc.CallOption) (*ListCodecUsageResponse, error)
+}
+
+type codecServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCodecServiceClient(cc *grpc.ClientConn) CodecServiceClient {
+	return &codecServiceClient{cc}
+}
+
+func (c *codecServiceClient) Create(ctx context.Context, in *CreateCodecRequest, opts ...grpc.CallOption) (*CreateCodecResponse, error) {
+	out := new(CreateCodecResponse)
+	err := c.cc.Invoke(ctx, "/api.CodecService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codecServiceClient) Get(ctx context.Context, in *GetCodecRequest, opts ...grpc.CallOption) (*GetCodecResponse, error) {
+	out := new(GetCodecResponse)
+	err := c.cc.Invoke(ctx, "/api.CodecService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codecServiceClient) Update(ctx context.Context, in *UpdateCodecRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.CodecService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codecServiceClient) Delete(ctx context.Context, in *DeleteCodecRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.CodecService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codecServiceClient) List(ctx context.Context, in *ListCodecRequest, opts ...grpc.CallOption) (*ListCodecResponse, error) {
+	out := new(ListCodecResponse)
+	err := c.cc.Invoke(ctx, "/api.CodecService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codecServiceClient) CreateVersion(ctx context.Context, in *CreateCodecVersionRequest, opts ...grpc.CallOption) (*CreateCodecVersionResponse, error) {
+	out := new(CreateCodecVersionResponse)
+	err := c.cc.Invoke(ctx, "/api.CodecService/CreateVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codecServiceClient) PublishVersion(ctx context.Context, in *PublishCodecVersionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.CodecService/PublishVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codecServiceClient) GetVersion(ctx context.Context, in *GetCodecVersionRequest, opts ...grpc.CallOption) (*GetCodecVersionResponse, error) {
+	out := new(GetCodecVersionResponse)
+	err := c.cc.Invoke(ctx, "/api.CodecService/GetVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codecServiceClient) ListVersions(ctx context.Context, in *ListCodecVersionsRequest, opts ...grpc.CallOption) (*ListCodecVersionsResponse, error) {
+	out := new(ListCodecVersionsResponse)
+	err := c.cc.Invoke(ctx, "/api.CodecService/ListVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codecServiceClient) ListUsage(ctx context.Context, in *ListCodecUsageRequest, opts ...grpc.CallOption) (*ListCodecUsageResponse, error) {
+	out := new(ListCodecUsageResponse)
+	err := c.cc.Invoke(ctx, "/api.CodecService/ListUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CodecServiceServer is the server API for CodecService service.
+type CodecServiceServer interface {
+	// Create creates the given codec.
+	Create(context.Context, *CreateCodecRequest) (*CreateCodecResponse, error)
+	// Get returns the codec matching the given id.
+	Get(context.Context, *GetCodecRequest) (*GetCodecResponse, error)
+	// Update updates the given codec.
+	Update(context.Context, *UpdateCodecRequest) (*empty.Empty, error)
+	// Delete deletes the codec matching the given id.
+	// A codec which is used by device-profiles can not be deleted.
+	Delete(context.Context, *DeleteCodecRequest) (*empty.Empty, error)
+	// List lists the codecs available to the given organization (including
+	// the global codecs).
+	List(context.Context, *ListCodecRequest) (*ListCodecResponse, error)
+	// CreateVersion creates a new version of the given codec.
+	// Versions are immutable. Unless published is set, the version is
+	// created as draft. Device-profiles using the latest version of the
+	// codec will only use the new version once it has been published.
+	CreateVersion(context.Context, *CreateCodecVersionRequest) (*CreateCodecVersionResponse, error)
+	// PublishVersion publishes the given (draft) codec version.
+	// Device-profiles using the latest version of the codec will use the
+	// published version immediately.
+	PublishVersion(context.Context, *PublishCodecVersionRequest) (*empty.Empty, error)
+	// GetVersion returns the given codec version.
+	GetVersion(context.Context, *GetCodecVersionRequest) (*GetCodecVersionResponse, error)
+	// ListVersions lists the versions of the given codec (latest first).
+	ListVersions(context.Context, *ListCodecVersionsRequest) (*ListCodecVersionsResponse, error)
+	// ListUsage lists the device-profiles using the given codec.
+	ListUsage(context.Context, *ListCodecUsageRequest) (*ListCodecUsageResponse, error)
+}
+
+func RegisterCodecServiceServer(s *grpc.Server, srv CodecServiceServer) {
+	s.RegisterService(&_CodecService_serviceDesc, srv)
+}
+
+func _CodecService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCodecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodecServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CodecService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodecServiceServer).Create(ctx, req.(*CreateCodecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodecService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCodecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodecServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CodecService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodecServiceServer).Get(ctx, req.(*GetCodecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodecService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCodecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodecServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CodecService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodecServiceServer).Update(ctx, req.(*UpdateCodecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodecService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCodecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodecServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CodecService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodecServiceServer).Delete(ctx, req.(*DeleteCodecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodecService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCodecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodecServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CodecService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodecServiceServer).List(ctx, req.(*ListCodecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodecService_CreateVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCodecVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodecServiceServer).CreateVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CodecService/CreateVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodecServiceServer).CreateVersion(ctx, req.(*CreateCodecVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodecService_PublishVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishCodecVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodecServiceServer).PublishVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CodecService/PublishVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodecServiceServer).PublishVersion(ctx, req.(*PublishCodecVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodecService_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCodecVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodecServiceServer).GetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CodecService/GetVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodecServiceServer).GetVersion(ctx, req.(*GetCodecVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodecService_ListVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCodecVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodecServiceServer).ListVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CodecService/ListVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodecServiceServer).ListVersions(ctx, req.(*ListCodecVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodecService_ListUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCodecUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodecServiceServer).ListUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CodecService/ListUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodecServiceServer).ListUsage(ctx, req.(*ListCodecUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _CodecService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.CodecService",
+	HandlerType: (*CodecServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _CodecService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _CodecService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _CodecService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _CodecService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _CodecService_List_Handler,
+		},
+		{
+			MethodName: "CreateVersion",
+			Handler:    _CodecService_CreateVersion_Handler,
+		},
+		{
+			MethodName: "PublishVersion",
+			Handler:    _CodecService_PublishVersion_Handler,
+		},
+		{
+			MethodName: "GetVersion",
+			Handler:    _CodecService_GetVersion_Handler,
+		},
+		{
+			MethodName: "ListVersions",
+			Handler:    _CodecService_ListVersions_Handler,
+		},
+		{
+			MethodName: "ListUsage",
+			Handler:    _CodecService_ListUsage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "codec.proto",
+}
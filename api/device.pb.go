@@ -26,6 +26,33 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type UplinkExportFormat int32
+
+const (
+	// JSON array of uplink objects.
+	UplinkExportFormat_EXPORT_JSON UplinkExportFormat = 0
+	// CSV with a header row.
+	UplinkExportFormat_EXPORT_CSV UplinkExportFormat = 1
+)
+
+var UplinkExportFormat_name = map[int32]string{
+	0: "EXPORT_JSON",
+	1: "EXPORT_CSV",
+}
+
+var UplinkExportFormat_value = map[string]int32{
+	"EXPORT_JSON": 0,
+	"EXPORT_CSV":  1,
+}
+
+func (x UplinkExportFormat) String() string {
+	return proto.EnumName(UplinkExportFormat_name, int32(x))
+}
+
+func (UplinkExportFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{0}
+}
+
 type Device struct {
 	// Device EUI (HEX encoded).
 	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
@@ -46,10 +73,14 @@ type Device struct {
 	// When using geolocation, this altitude will be used as a reference
 	// (when supported by the geolocation-server) to increase geolocation
 	// accuracy.
-	ReferenceAltitude    float64  `protobuf:"fixed64,7,opt,name=reference_altitude,json=referenceAltitude,proto3" json:"reference_altitude,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ReferenceAltitude float64 `protobuf:"fixed64,7,opt,name=reference_altitude,json=referenceAltitude,proto3" json:"reference_altitude,omitempty"`
+	// Variables (user defined).
+	// These variables (e.g. calibration values) are exposed to the
+	// payload codec.
+	Variables            map[string]string `protobuf:"bytes,8,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *Device) Reset()         { *m = Device{} }
@@ -126,6 +157,13 @@ func (m *Device) GetReferenceAltitude() float64 {
 	return 0
 }
 
+func (m *Device) GetVariables() map[string]string {
+	if m != nil {
+		return m.Variables
+	}
+	return nil
+}
+
 type DeviceListItem struct {
 	// Device EUI (HEX encoded).
 	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
@@ -1470,8 +1508,675 @@ func (m *StreamDeviceEventLogsResponse) GetPayloadJson() string {
 	return ""
 }
 
+type GetDeviceCodecStateRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceCodecStateRequest) Reset()         { *m = GetDeviceCodecStateRequest{} }
+func (m *GetDeviceCodecStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceCodecStateRequest) ProtoMessage()    {}
+func (*GetDeviceCodecStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{26}
+}
+
+func (m *GetDeviceCodecStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceCodecStateRequest.Unmarshal(m, b)
+}
+func (m *GetDeviceCodecStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceCodecStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDeviceCodecStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceCodecStateRequest.Merge(m, src)
+}
+func (m *GetDeviceCodecStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceCodecStateRequest.Size(m)
+}
+func (m *GetDeviceCodecStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceCodecStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceCodecStateRequest proto.InternalMessageInfo
+
+func (m *GetDeviceCodecStateRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+type GetDeviceCodecStateResponse struct {
+	// JSON encoded codec state.
+	// This is empty when the device does not have a codec state.
+	StateJson            string   `protobuf:"bytes,1,opt,name=state_json,json=stateJSON,proto3" json:"state_json,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceCodecStateResponse) Reset()         { *m = GetDeviceCodecStateResponse{} }
+func (m *GetDeviceCodecStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceCodecStateResponse) ProtoMessage()    {}
+func (*GetDeviceCodecStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{27}
+}
+
+func (m *GetDeviceCodecStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceCodecStateResponse.Unmarshal(m, b)
+}
+func (m *GetDeviceCodecStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceCodecStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetDeviceCodecStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceCodecStateResponse.Merge(m, src)
+}
+func (m *GetDeviceCodecStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceCodecStateResponse.Size(m)
+}
+func (m *GetDeviceCodecStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceCodecStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceCodecStateResponse proto.InternalMessageInfo
+
+func (m *GetDeviceCodecStateResponse) GetStateJson() string {
+	if m != nil {
+		return m.StateJson
+	}
+	return ""
+}
+
+type ResetDeviceCodecStateRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetDeviceCodecStateRequest) Reset()         { *m = ResetDeviceCodecStateRequest{} }
+func (m *ResetDeviceCodecStateRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetDeviceCodecStateRequest) ProtoMessage()    {}
+func (*ResetDeviceCodecStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{28}
+}
+
+func (m *ResetDeviceCodecStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetDeviceCodecStateRequest.Unmarshal(m, b)
+}
+func (m *ResetDeviceCodecStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetDeviceCodecStateRequest.Marshal(b, m, deterministic)
+}
+func (m *ResetDeviceCodecStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetDeviceCodecStateRequest.Merge(m, src)
+}
+func (m *ResetDeviceCodecStateRequest) XXX_Size() int {
+	return xxx_messageInfo_ResetDeviceCodecStateRequest.Size(m)
+}
+func (m *ResetDeviceCodecStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetDeviceCodecStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetDeviceCodecStateRequest proto.InternalMessageInfo
+
+func (m *ResetDeviceCodecStateRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+type DeviceUplink struct {
+	// Timestamp when the uplink was received.
+	ReceivedAt *timestamp.Timestamp `protobuf:"bytes,1,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	// Frame-counter.
+	FCnt uint32 `protobuf:"varint,2,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	// FPort.
+	FPort uint32 `protobuf:"varint,3,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// Data-rate.
+	Dr uint32 `protobuf:"varint,4,opt,name=dr,proto3" json:"dr,omitempty"`
+	// (Decrypted) payload data.
+	Data []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	// JSON encoded object decoded by the payload codec.
+	// This is empty when the payload was not decoded.
+	ObjectJson string `protobuf:"bytes,6,opt,name=object_json,json=objectJSON,proto3" json:"object_json,omitempty"`
+	// JSON encoded RX information of the receiving gateways.
+	RxInfoJson           string   `protobuf:"bytes,7,opt,name=rx_info_json,json=rxInfoJSON,proto3" json:"rx_info_json,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceUplink) Reset()         { *m = DeviceUplink{} }
+func (m *DeviceUplink) String() string { return proto.CompactTextString(m) }
+func (*DeviceUplink) ProtoMessage()    {}
+func (*DeviceUplink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{29}
+}
+
+func (m *DeviceUplink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceUplink.Unmarshal(m, b)
+}
+func (m *DeviceUplink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceUplink.Marshal(b, m, deterministic)
+}
+func (m *DeviceUplink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceUplink.Merge(m, src)
+}
+func (m *DeviceUplink) XXX_Size() int {
+	return xxx_messageInfo_DeviceUplink.Size(m)
+}
+func (m *DeviceUplink) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceUplink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceUplink proto.InternalMessageInfo
+
+func (m *DeviceUplink) GetReceivedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReceivedAt
+	}
+	return nil
+}
+
+func (m *DeviceUplink) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+func (m *DeviceUplink) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *DeviceUplink) GetDr() uint32 {
+	if m != nil {
+		return m.Dr
+	}
+	return 0
+}
+
+func (m *DeviceUplink) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *DeviceUplink) GetObjectJson() string {
+	if m != nil {
+		return m.ObjectJson
+	}
+	return ""
+}
+
+func (m *DeviceUplink) GetRxInfoJson() string {
+	if m != nil {
+		return m.RxInfoJson
+	}
+	return ""
+}
+
+type ListDeviceUplinksRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Start timestamp (inclusive).
+	// When not set, there is no lower bound.
+	Start *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// End timestamp (exclusive).
+	// When not set, there is no upper bound.
+	End *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// Max number of uplinks to return in the result-set.
+	Limit int64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset               int64    `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDeviceUplinksRequest) Reset()         { *m = ListDeviceUplinksRequest{} }
+func (m *ListDeviceUplinksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceUplinksRequest) ProtoMessage()    {}
+func (*ListDeviceUplinksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{30}
+}
+
+func (m *ListDeviceUplinksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeviceUplinksRequest.Unmarshal(m, b)
+}
+func (m *ListDeviceUplinksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeviceUplinksRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDeviceUplinksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceUplinksRequest.Merge(m, src)
+}
+func (m *ListDeviceUplinksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDeviceUplinksRequest.Size(m)
+}
+func (m *ListDeviceUplinksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceUplinksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeviceUplinksRequest proto.InternalMessageInfo
+
+func (m *ListDeviceUplinksRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *ListDeviceUplinksRequest) GetStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *ListDeviceUplinksRequest) GetEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (m *ListDeviceUplinksRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListDeviceUplinksRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListDeviceUplinksResponse struct {
+	// Total number of uplinks within the time range.
+	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Uplinks within the requested page.
+	Result               []*DeviceUplink `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListDeviceUplinksResponse) Reset()         { *m = ListDeviceUplinksResponse{} }
+func (m *ListDeviceUplinksResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceUplinksResponse) ProtoMessage()    {}
+func (*ListDeviceUplinksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{31}
+}
+
+func (m *ListDeviceUplinksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeviceUplinksResponse.Unmarshal(m, b)
+}
+func (m *ListDeviceUplinksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeviceUplinksResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDeviceUplinksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceUplinksResponse.Merge(m, src)
+}
+func (m *ListDeviceUplinksResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDeviceUplinksResponse.Size(m)
+}
+func (m *ListDeviceUplinksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceUplinksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeviceUplinksResponse proto.InternalMessageInfo
+
+func (m *ListDeviceUplinksResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListDeviceUplinksResponse) GetResult() []*DeviceUplink {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ExportDeviceUplinksRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Start timestamp (inclusive).
+	// When not set, there is no lower bound.
+	Start *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// End timestamp (exclusive).
+	// When not set, there is no upper bound.
+	End *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// Export format.
+	Format               UplinkExportFormat `protobuf:"varint,4,opt,name=format,proto3,enum=api.UplinkExportFormat" json:"format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ExportDeviceUplinksRequest) Reset()         { *m = ExportDeviceUplinksRequest{} }
+func (m *ExportDeviceUplinksRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportDeviceUplinksRequest) ProtoMessage()    {}
+func (*ExportDeviceUplinksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{32}
+}
+
+func (m *ExportDeviceUplinksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportDeviceUplinksRequest.Unmarshal(m, b)
+}
+func (m *ExportDeviceUplinksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportDeviceUplinksRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportDeviceUplinksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportDeviceUplinksRequest.Merge(m, src)
+}
+func (m *ExportDeviceUplinksRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportDeviceUplinksRequest.Size(m)
+}
+func (m *ExportDeviceUplinksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportDeviceUplinksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportDeviceUplinksRequest proto.InternalMessageInfo
+
+func (m *ExportDeviceUplinksRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *ExportDeviceUplinksRequest) GetStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *ExportDeviceUplinksRequest) GetEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (m *ExportDeviceUplinksRequest) GetFormat() UplinkExportFormat {
+	if m != nil {
+		return m.Format
+	}
+	return UplinkExportFormat_EXPORT_JSON
+}
+
+type ExportDeviceUplinksResponse struct {
+	// Content-type of the exported data.
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Exported data.
+	Data                 string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportDeviceUplinksResponse) Reset()         { *m = ExportDeviceUplinksResponse{} }
+func (m *ExportDeviceUplinksResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportDeviceUplinksResponse) ProtoMessage()    {}
+func (*ExportDeviceUplinksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{33}
+}
+
+func (m *ExportDeviceUplinksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportDeviceUplinksResponse.Unmarshal(m, b)
+}
+func (m *ExportDeviceUplinksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportDeviceUplinksResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportDeviceUplinksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportDeviceUplinksResponse.Merge(m, src)
+}
+func (m *ExportDeviceUplinksResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportDeviceUplinksResponse.Size(m)
+}
+func (m *ExportDeviceUplinksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportDeviceUplinksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportDeviceUplinksResponse proto.InternalMessageInfo
+
+func (m *ExportDeviceUplinksResponse) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *ExportDeviceUplinksResponse) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type DeviceStatusHistory struct {
+	// Timestamp of the (aggregated) device-status.
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Number of device-status reports within the interval.
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Average demodulation signal-to-noise ratio in dB.
+	Margin int32 `protobuf:"varint,3,opt,name=margin,proto3" json:"margin,omitempty"`
+	// Average battery level (in percent).
+	BatteryLevel float32 `protobuf:"fixed32,4,opt,name=battery_level,json=batteryLevel,proto3" json:"battery_level,omitempty"`
+	// None of the reports within the interval contained a battery level.
+	BatteryLevelUnavailable bool `protobuf:"varint,5,opt,name=battery_level_unavailable,json=batteryLevelUnavailable,proto3" json:"battery_level_unavailable,omitempty"`
+	// The device was connected to an external power source.
+	ExternalPowerSource  bool     `protobuf:"varint,6,opt,name=external_power_source,json=externalPowerSource,proto3" json:"external_power_source,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceStatusHistory) Reset()         { *m = DeviceStatusHistory{} }
+func (m *DeviceStatusHistory) String() string { return proto.CompactTextString(m) }
+func (*DeviceStatusHistory) ProtoMessage()    {}
+func (*DeviceStatusHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{34}
+}
+
+func (m *DeviceStatusHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceStatusHistory.Unmarshal(m, b)
+}
+func (m *DeviceStatusHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceStatusHistory.Marshal(b, m, deterministic)
+}
+func (m *DeviceStatusHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceStatusHistory.Merge(m, src)
+}
+func (m *DeviceStatusHistory) XXX_Size() int {
+	return xxx_messageInfo_DeviceStatusHistory.Size(m)
+}
+func (m *DeviceStatusHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceStatusHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceStatusHistory proto.InternalMessageInfo
+
+func (m *DeviceStatusHistory) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *DeviceStatusHistory) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *DeviceStatusHistory) GetMargin() int32 {
+	if m != nil {
+		return m.Margin
+	}
+	return 0
+}
+
+func (m *DeviceStatusHistory) GetBatteryLevel() float32 {
+	if m != nil {
+		return m.BatteryLevel
+	}
+	return 0
+}
+
+func (m *DeviceStatusHistory) GetBatteryLevelUnavailable() bool {
+	if m != nil {
+		return m.BatteryLevelUnavailable
+	}
+	return false
+}
+
+func (m *DeviceStatusHistory) GetExternalPowerSource() bool {
+	if m != nil {
+		return m.ExternalPowerSource
+	}
+	return false
+}
+
+type GetDeviceStatusHistoryRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Aggregation interval.  One of "second", "minute", "hour", "day", "week",
+	// "month", "quarter", "year".  Case insensitive.
+	Interval string `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Timestamp to start from.
+	StartTimestamp *timestamp.Timestamp `protobuf:"bytes,3,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	// Timestamp until to get from.
+	EndTimestamp         *timestamp.Timestamp `protobuf:"bytes,4,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetDeviceStatusHistoryRequest) Reset()         { *m = GetDeviceStatusHistoryRequest{} }
+func (m *GetDeviceStatusHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceStatusHistoryRequest) ProtoMessage()    {}
+func (*GetDeviceStatusHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{35}
+}
+
+func (m *GetDeviceStatusHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceStatusHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetDeviceStatusHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceStatusHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDeviceStatusHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceStatusHistoryRequest.Merge(m, src)
+}
+func (m *GetDeviceStatusHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceStatusHistoryRequest.Size(m)
+}
+func (m *GetDeviceStatusHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceStatusHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceStatusHistoryRequest proto.InternalMessageInfo
+
+func (m *GetDeviceStatusHistoryRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *GetDeviceStatusHistoryRequest) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
+}
+
+func (m *GetDeviceStatusHistoryRequest) GetStartTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTimestamp
+	}
+	return nil
+}
+
+func (m *GetDeviceStatusHistoryRequest) GetEndTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTimestamp
+	}
+	return nil
+}
+
+type GetDeviceStatusHistoryResponse struct {
+	Result []*DeviceStatusHistory `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	// Predicted timestamp at which the battery will be depleted, based on
+	// the battery levels within the requested time range.
+	// This is not set when there is not enough data or when the battery
+	// level is not decreasing.
+	BatteryDepletionPredictedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=battery_depletion_predicted_at,json=batteryDepletionPredictedAt,proto3" json:"battery_depletion_predicted_at,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{}             `json:"-"`
+	XXX_unrecognized            []byte               `json:"-"`
+	XXX_sizecache               int32                `json:"-"`
+}
+
+func (m *GetDeviceStatusHistoryResponse) Reset()         { *m = GetDeviceStatusHistoryResponse{} }
+func (m *GetDeviceStatusHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceStatusHistoryResponse) ProtoMessage()    {}
+func (*GetDeviceStatusHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{36}
+}
+
+func (m *GetDeviceStatusHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceStatusHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetDeviceStatusHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceStatusHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetDeviceStatusHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceStatusHistoryResponse.Merge(m, src)
+}
+func (m *GetDeviceStatusHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceStatusHistoryResponse.Size(m)
+}
+func (m *GetDeviceStatusHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceStatusHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceStatusHistoryResponse proto.InternalMessageInfo
+
+func (m *GetDeviceStatusHistoryResponse) GetResult() []*DeviceStatusHistory {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *GetDeviceStatusHistoryResponse) GetBatteryDepletionPredictedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.BatteryDepletionPredictedAt
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("api.UplinkExportFormat", UplinkExportFormat_name, UplinkExportFormat_value)
 	proto.RegisterType((*Device)(nil), "api.Device")
+	proto.RegisterMapType((map[string]string)(nil), "api.Device.VariablesEntry")
 	proto.RegisterType((*DeviceListItem)(nil), "api.DeviceListItem")
 	proto.RegisterType((*DeviceKeys)(nil), "api.DeviceKeys")
 	proto.RegisterType((*CreateDeviceRequest)(nil), "api.CreateDeviceRequest")
@@ -1497,112 +2202,166 @@ func init() {
 	proto.RegisterType((*StreamDeviceFrameLogsResponse)(nil), "api.StreamDeviceFrameLogsResponse")
 	proto.RegisterType((*StreamDeviceEventLogsRequest)(nil), "api.StreamDeviceEventLogsRequest")
 	proto.RegisterType((*StreamDeviceEventLogsResponse)(nil), "api.StreamDeviceEventLogsResponse")
+	proto.RegisterType((*GetDeviceCodecStateRequest)(nil), "api.GetDeviceCodecStateRequest")
+	proto.RegisterType((*GetDeviceCodecStateResponse)(nil), "api.GetDeviceCodecStateResponse")
+	proto.RegisterType((*ResetDeviceCodecStateRequest)(nil), "api.ResetDeviceCodecStateRequest")
+	proto.RegisterType((*DeviceUplink)(nil), "api.DeviceUplink")
+	proto.RegisterType((*ListDeviceUplinksRequest)(nil), "api.ListDeviceUplinksRequest")
+	proto.RegisterType((*ListDeviceUplinksResponse)(nil), "api.ListDeviceUplinksResponse")
+	proto.RegisterType((*ExportDeviceUplinksRequest)(nil), "api.ExportDeviceUplinksRequest")
+	proto.RegisterType((*ExportDeviceUplinksResponse)(nil), "api.ExportDeviceUplinksResponse")
+	proto.RegisterType((*DeviceStatusHistory)(nil), "api.DeviceStatusHistory")
+	proto.RegisterType((*GetDeviceStatusHistoryRequest)(nil), "api.GetDeviceStatusHistoryRequest")
+	proto.RegisterType((*GetDeviceStatusHistoryResponse)(nil), "api.GetDeviceStatusHistoryResponse")
 }
 
 func init() { proto.RegisterFile("device.proto", fileDescriptor_870276a56ac00da5) }
 
 var fileDescriptor_870276a56ac00da5 = []byte{
-	// 1591 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4b, 0x73, 0xdb, 0x46,
-	0x12, 0x5e, 0x88, 0x12, 0x25, 0x35, 0x49, 0x3d, 0x46, 0x0f, 0xc2, 0x94, 0xb5, 0xa2, 0xa0, 0x75,
-	0x99, 0x96, 0xbd, 0xa4, 0x56, 0x5b, 0xfb, 0x28, 0x97, 0x77, 0xab, 0x64, 0x49, 0xd6, 0x6a, 0x25,
-	0x3b, 0x2e, 0xd0, 0x4a, 0xaa, 0x92, 0x03, 0x6a, 0x44, 0x0c, 0x65, 0x84, 0xe0, 0x00, 0x01, 0x86,
-	0x54, 0x58, 0x89, 0xab, 0x12, 0x1f, 0x73, 0xcd, 0x3f, 0xc8, 0x3d, 0xbf, 0x26, 0xd7, 0x1c, 0x73,
-	0xc9, 0xbf, 0x48, 0xcd, 0x83, 0xd4, 0x90, 0x04, 0xf4, 0x48, 0x72, 0xc9, 0x0d, 0xe8, 0xfe, 0xfa,
-	0xf1, 0xf5, 0xf4, 0x74, 0x03, 0x90, 0x77, 0x49, 0xd7, 0x6b, 0x90, 0x6a, 0x18, 0x05, 0x2c, 0x40,
-	0x19, 0x1c, 0x7a, 0xa5, 0xfb, 0x17, 0x41, 0x70, 0xe1, 0x93, 0x1a, 0x0e, 0xbd, 0x1a, 0xa6, 0x34,
-	0x60, 0x98, 0x79, 0x01, 0x8d, 0x25, 0xa4, 0xb4, 0xa1, 0xb4, 0xe2, 0xed, 0xbc, 0xd3, 0xac, 0x31,
-	0xaf, 0x4d, 0x62, 0x86, 0xdb, 0xa1, 0x02, 0xac, 0x8d, 0x02, 0x48, 0x3b, 0x64, 0x3d, 0xa5, 0xcc,
-	0x37, 0x82, 0x76, 0x3b, 0xa0, 0xea, 0xad, 0xc8, 0x43, 0x48, 0x49, 0x4d, 0x57, 0x58, 0xef, 0x27,
-	0x20, 0x7b, 0x20, 0x12, 0x43, 0x45, 0x98, 0x76, 0x49, 0xd7, 0x21, 0x1d, 0xcf, 0x34, 0xca, 0x46,
-	0x65, 0xd6, 0xce, 0xba, 0xa4, 0x7b, 0x78, 0x76, 0x8c, 0x10, 0x4c, 0x52, 0xdc, 0x26, 0xe6, 0x84,
-	0x90, 0x8a, 0x67, 0xf4, 0x00, 0xe6, 0x70, 0x18, 0xfa, 0x5e, 0x43, 0xa4, 0xec, 0x78, 0xae, 0x99,
-	0x29, 0x1b, 0x95, 0x8c, 0x5d, 0xd0, 0xa4, 0xc7, 0x07, 0xa8, 0x0c, 0x39, 0x97, 0xc4, 0x8d, 0xc8,
-	0x0b, 0xb9, 0xc0, 0x9c, 0x14, 0x1e, 0x74, 0x11, 0xda, 0x86, 0x45, 0x59, 0x18, 0x27, 0x8c, 0x82,
-	0xa6, 0xe7, 0x13, 0xee, 0x6b, 0x4a, 0xe0, 0xe6, 0xa5, 0xe2, 0xb5, 0x94, 0x1f, 0x1f, 0xa0, 0x87,
-	0xb0, 0x10, 0xb7, 0xbc, 0xd0, 0x69, 0x3a, 0x0d, 0xca, 0x9c, 0xc6, 0x5b, 0xd2, 0x68, 0x99, 0xd9,
-	0xb2, 0x51, 0x99, 0xb1, 0x0b, 0x5c, 0xfe, 0x62, 0x9f, 0xb2, 0x7d, 0x2e, 0x44, 0x7f, 0x05, 0x14,
-	0x91, 0x26, 0x89, 0x08, 0x6d, 0x10, 0x07, 0xfb, 0xcc, 0x63, 0x1d, 0x97, 0x98, 0xd3, 0x65, 0xa3,
-	0x62, 0xd8, 0x8b, 0x03, 0xcd, 0x9e, 0x52, 0x58, 0x3f, 0x4f, 0xc2, 0x9c, 0x2c, 0xc2, 0xa9, 0x17,
-	0xb3, 0x63, 0x46, 0xda, 0x7f, 0x80, 0x62, 0x54, 0x61, 0x69, 0x04, 0x2b, 0xf2, 0xca, 0x0a, 0xf4,
-	0xe2, 0x10, 0xfa, 0x15, 0x4f, 0x72, 0x17, 0x56, 0x14, 0x3e, 0x66, 0x98, 0x75, 0x62, 0xe7, 0x1c,
-	0x33, 0x46, 0xa2, 0x9e, 0x28, 0x4b, 0xc1, 0x56, 0xce, 0xea, 0x42, 0xf7, 0x5c, 0xaa, 0xd0, 0x0e,
-	0x2c, 0x0f, 0xdb, 0xb4, 0x71, 0x74, 0xe1, 0x51, 0x73, 0xa6, 0x6c, 0x54, 0xa6, 0x6c, 0xa4, 0x9b,
-	0xbc, 0x14, 0x1a, 0x74, 0x0a, 0x5b, 0xc3, 0x16, 0xe4, 0x73, 0x46, 0x22, 0x8a, 0x7d, 0x27, 0x0c,
-	0x2e, 0x49, 0xe4, 0xc4, 0x41, 0x27, 0x6a, 0x10, 0x13, 0xc4, 0xa9, 0x6d, 0xe8, 0x0e, 0x0e, 0x15,
-	0xf0, 0x35, 0xc7, 0xd5, 0x05, 0x0c, 0xbd, 0x81, 0x87, 0x89, 0x39, 0x3b, 0x3e, 0xe9, 0x12, 0xdf,
-	0xe9, 0x50, 0xdc, 0xc5, 0x9e, 0x8f, 0xcf, 0x7d, 0x62, 0xe6, 0x84, 0xc7, 0xad, 0x04, 0x16, 0xa7,
-	0x1c, 0x7b, 0x76, 0x05, 0x45, 0xff, 0x81, 0xb5, 0x6b, 0xbc, 0x9a, 0xf9, 0xb2, 0x51, 0x99, 0xb0,
-	0xcd, 0x34, 0x4f, 0xe8, 0x19, 0xe4, 0x7d, 0x1c, 0x33, 0x27, 0x26, 0x84, 0x3a, 0x98, 0x99, 0xb3,
-	0x65, 0xa3, 0x92, 0xdb, 0x2d, 0x55, 0xe5, 0x6d, 0xac, 0xf6, 0x6f, 0x63, 0xf5, 0x4d, 0xff, 0xba,
-	0xda, 0xc0, 0xf1, 0x75, 0x42, 0xe8, 0x1e, 0xb3, 0x3e, 0x02, 0x90, 0xad, 0x76, 0x42, 0x7a, 0x71,
-	0x7a, 0x9b, 0x15, 0x61, 0x9a, 0x5e, 0xb6, 0x9c, 0x16, 0xe9, 0xa9, 0x4e, 0xcb, 0xd2, 0xcb, 0xd6,
-	0x09, 0xe9, 0x71, 0x05, 0x0e, 0x43, 0xa1, 0xc8, 0x48, 0x05, 0x0e, 0xc3, 0x13, 0xd2, 0xb3, 0x9e,
-	0xc2, 0xd2, 0x7e, 0x44, 0x30, 0x23, 0xd2, 0xbd, 0x4d, 0x3e, 0xeb, 0x90, 0x98, 0xa1, 0x2d, 0xc8,
-	0x4a, 0x26, 0x22, 0x40, 0x6e, 0x37, 0x57, 0xc5, 0xa1, 0x57, 0x55, 0x18, 0xa5, 0xb2, 0x1e, 0xc3,
-	0xc2, 0x11, 0x61, 0xc3, 0x86, 0x69, 0xa9, 0x59, 0xdf, 0x4c, 0xc0, 0xa2, 0x86, 0x8e, 0xc3, 0x80,
-	0xc6, 0xe4, 0x56, 0x71, 0xc6, 0x4a, 0x37, 0x75, 0x97, 0xd2, 0xa5, 0x77, 0x70, 0xf6, 0xee, 0x1d,
-	0xbc, 0x9c, 0xda, 0xc1, 0x4f, 0x60, 0xc6, 0x0f, 0xe4, 0x9d, 0x35, 0x57, 0x44, 0x7e, 0x0b, 0x55,
-	0x35, 0x32, 0x4f, 0x95, 0xdc, 0x1e, 0x20, 0xac, 0x1f, 0x0d, 0x58, 0xe4, 0x43, 0x63, 0xb8, 0x76,
-	0xcb, 0x30, 0xe5, 0x7b, 0x6d, 0x8f, 0x89, 0x5a, 0x64, 0x6c, 0xf9, 0x82, 0x56, 0x21, 0x1b, 0x34,
-	0x9b, 0x31, 0x61, 0xe2, 0x48, 0x33, 0xb6, 0x7a, 0xbb, 0xed, 0xf8, 0x58, 0x85, 0x6c, 0x4c, 0x70,
-	0xd4, 0x78, 0xab, 0x26, 0x87, 0x7a, 0x43, 0x4f, 0x00, 0xb5, 0x3b, 0x3e, 0xf3, 0x1a, 0xbc, 0xb2,
-	0x17, 0x51, 0xd0, 0x09, 0xaf, 0xa6, 0xc6, 0xc2, 0x40, 0x73, 0xc4, 0x15, 0xc7, 0x07, 0x1c, 0x1d,
-	0x93, 0x68, 0x74, 0xc6, 0xc8, 0xa9, 0xb1, 0xa0, 0x34, 0x83, 0x21, 0x63, 0x9d, 0x03, 0xd2, 0xd9,
-	0xa9, 0xb3, 0xde, 0x80, 0x1c, 0x0b, 0x18, 0xf6, 0x9d, 0x46, 0xd0, 0xa1, 0x7d, 0x92, 0x20, 0x44,
-	0xfb, 0x5c, 0x82, 0x1e, 0x43, 0x36, 0x22, 0x71, 0xc7, 0xe7, 0x4c, 0x33, 0x95, 0xdc, 0xee, 0x92,
-	0xd6, 0x0c, 0xfd, 0x11, 0x6b, 0x2b, 0x88, 0x55, 0x85, 0xa5, 0x03, 0xe2, 0x93, 0xd1, 0xc6, 0x4d,
-	0xed, 0xbf, 0xa7, 0xb0, 0x74, 0x16, 0xba, 0xbf, 0xae, 0xd1, 0x4f, 0xa0, 0xa8, 0x5f, 0x12, 0x7e,
-	0x07, 0xfb, 0xf6, 0x3b, 0x7c, 0x3a, 0x8b, 0xba, 0xb4, 0x48, 0x2f, 0x56, 0x4e, 0xe6, 0x35, 0x27,
-	0x02, 0x0c, 0xee, 0xe0, 0xd9, 0xaa, 0xc1, 0xf2, 0xe0, 0x1e, 0xe8, 0x9e, 0x52, 0x33, 0x3f, 0x86,
-	0x95, 0x11, 0x03, 0x55, 0xd0, 0xbb, 0xc7, 0x3e, 0x81, 0xa2, 0x5e, 0x84, 0xdf, 0x46, 0x64, 0x17,
-	0x8a, 0xfa, 0x09, 0xdc, 0x8a, 0xcb, 0xf7, 0x13, 0xb0, 0x20, 0xe1, 0x7b, 0x0d, 0xe6, 0x75, 0x45,
-	0x93, 0xa6, 0x8f, 0xb3, 0x7b, 0x30, 0xc3, 0x15, 0xd8, 0x75, 0x23, 0x35, 0xcf, 0x38, 0x70, 0xcf,
-	0x75, 0x23, 0x54, 0x82, 0x59, 0x3e, 0xd0, 0x62, 0x6d, 0xa4, 0xf1, 0x09, 0x57, 0xe7, 0xc3, 0x6e,
-	0x13, 0x0a, 0x7c, 0x0a, 0xc6, 0x0e, 0xa1, 0x0d, 0xa1, 0x97, 0x9d, 0x0f, 0xf4, 0xb2, 0x55, 0x3f,
-	0xa4, 0x0d, 0x0e, 0xf9, 0x0b, 0xcc, 0xc7, 0x8e, 0x04, 0x79, 0x94, 0x09, 0xd0, 0x8c, 0x5c, 0xac,
-	0xf1, 0xab, 0xcb, 0x56, 0xfd, 0x98, 0x32, 0x85, 0x6a, 0x8e, 0xa0, 0x66, 0x25, 0xaa, 0xa9, 0xa1,
-	0x4c, 0x98, 0x91, 0x9f, 0x16, 0x9d, 0x50, 0xdc, 0x9f, 0x82, 0x9d, 0x6d, 0xee, 0x53, 0x76, 0x16,
-	0xa2, 0x0d, 0xc8, 0x53, 0xf5, 0xd9, 0xe1, 0x06, 0x97, 0x54, 0x4d, 0x9c, 0x59, 0xca, 0x3f, 0x39,
-	0x0e, 0x82, 0x4b, 0xca, 0x01, 0x58, 0x07, 0x80, 0x04, 0xe0, 0x3e, 0xc0, 0xfa, 0x04, 0x56, 0x54,
-	0xa1, 0x46, 0xfa, 0xf6, 0xf9, 0x60, 0xe7, 0xe3, 0x41, 0x21, 0xd5, 0xa1, 0xad, 0x68, 0x87, 0x76,
-	0x55, 0x65, 0x7b, 0xc1, 0x1d, 0x91, 0xc8, 0x03, 0xc4, 0x89, 0xee, 0x53, 0x0f, 0xf0, 0x1f, 0x50,
-	0x1a, 0x34, 0xa3, 0xe6, 0xfc, 0x26, 0x33, 0x0c, 0x6b, 0x89, 0x66, 0xaa, 0x93, 0x7f, 0x27, 0x36,
-	0x47, 0x84, 0xd9, 0x98, 0xba, 0x41, 0xfb, 0x40, 0x76, 0xc9, 0x2d, 0xd8, 0x98, 0xe3, 0x36, 0x2a,
-	0x27, 0xbd, 0xf9, 0x8c, 0xa1, 0xe6, 0xb3, 0xfe, 0x05, 0xf7, 0xeb, 0x2c, 0x22, 0xb8, 0x2d, 0xd3,
-	0x7a, 0x11, 0xe1, 0x36, 0x39, 0x0d, 0x2e, 0x6e, 0x6e, 0xff, 0xef, 0x0c, 0x58, 0x4f, 0xb1, 0x54,
-	0x51, 0xff, 0x0d, 0xf9, 0x4e, 0xe8, 0x7b, 0xb4, 0xe5, 0x34, 0xb9, 0x4e, 0x15, 0x41, 0x4e, 0xc2,
-	0x33, 0xa1, 0xe8, 0xdb, 0xfc, 0xef, 0x4f, 0x76, 0xae, 0x73, 0x25, 0x41, 0xff, 0x85, 0x39, 0xde,
-	0x43, 0x9a, 0xed, 0x84, 0x5e, 0x40, 0xa5, 0xd2, 0xac, 0x0b, 0xae, 0x2e, 0x7b, 0x3e, 0x0d, 0x53,
-	0xc2, 0x6c, 0x94, 0xdd, 0x61, 0x97, 0x50, 0x76, 0x2b, 0x76, 0x1f, 0x0e, 0x93, 0xd3, 0x0c, 0x15,
-	0x39, 0x04, 0x93, 0xac, 0x17, 0x12, 0x65, 0x26, 0x9e, 0xd1, 0x26, 0xe4, 0x43, 0xdc, 0xf3, 0x03,
-	0xec, 0x3a, 0x9f, 0xc6, 0x01, 0x55, 0xf7, 0x3c, 0xa7, 0x64, 0xff, 0xaf, 0x7f, 0xf0, 0x6a, 0xf7,
-	0x7d, 0x01, 0x0a, 0xd2, 0x65, 0x5d, 0x6e, 0x1a, 0x54, 0x87, 0xac, 0x1c, 0xc8, 0xc8, 0x14, 0xec,
-	0x12, 0x3e, 0x61, 0x4a, 0xab, 0x63, 0xdf, 0x07, 0x87, 0xfc, 0x47, 0xc7, 0x2a, 0xbe, 0xff, 0xe1,
-	0xa7, 0x6f, 0x27, 0x16, 0xad, 0xbc, 0xf8, 0x81, 0x92, 0x6d, 0x14, 0x3f, 0x35, 0xb6, 0xd1, 0x1b,
-	0xc8, 0x1c, 0x11, 0x86, 0x64, 0xbd, 0x46, 0x3f, 0x6c, 0x4a, 0xab, 0xa3, 0x62, 0xc9, 0xc9, 0xfa,
-	0xb3, 0x70, 0x67, 0xa2, 0x55, 0xdd, 0x5d, 0xed, 0x0b, 0x55, 0xa1, 0x77, 0xe8, 0x25, 0x4c, 0xf2,
-	0xdd, 0x85, 0xa4, 0xfd, 0xd8, 0xd2, 0x2f, 0x15, 0xc7, 0xe4, 0xca, 0xf1, 0xb2, 0x70, 0x3c, 0x87,
-	0x86, 0xf2, 0x44, 0x1f, 0xf3, 0x1f, 0x2f, 0x3e, 0x74, 0x15, 0xf3, 0x84, 0x1d, 0x98, 0xca, 0x5c,
-	0xa5, 0xba, 0x9d, 0x96, 0xaa, 0x0b, 0x59, 0xb9, 0x1d, 0x94, 0xef, 0x84, 0x7d, 0x99, 0xea, 0xbb,
-	0x22, 0x7c, 0x5b, 0xa5, 0xf5, 0x31, 0xdf, 0xfc, 0xef, 0xb5, 0x1f, 0x82, 0x97, 0xb9, 0x0b, 0x20,
-	0x8f, 0x4b, 0x7c, 0xca, 0xde, 0x1f, 0x3b, 0x3f, 0x6d, 0x8f, 0xa4, 0x46, 0xdb, 0x15, 0xd1, 0x9e,
-	0x58, 0x0f, 0x93, 0xa2, 0x89, 0x05, 0x36, 0x08, 0x59, 0xe3, 0x6f, 0x3c, 0x2e, 0x81, 0xe9, 0x23,
-	0xc2, 0x44, 0xd0, 0x7b, 0xc3, 0x67, 0xa9, 0x47, 0x2c, 0x25, 0xa9, 0xd4, 0x89, 0x6c, 0x89, 0xa8,
-	0xeb, 0x68, 0x2d, 0xb9, 0x7e, 0x22, 0x12, 0xa7, 0x27, 0xeb, 0xa6, 0xd1, 0x4b, 0xd9, 0xb9, 0x37,
-	0xd1, 0x2b, 0xdd, 0x85, 0xde, 0x05, 0xff, 0x43, 0xe0, 0xbd, 0xa0, 0xc5, 0x4d, 0x59, 0xcf, 0xa9,
-	0x71, 0x15, 0xc1, 0xed, 0x6b, 0x09, 0x7e, 0x09, 0x33, 0xfd, 0x95, 0x84, 0x64, 0xb5, 0x12, 0x37,
-	0x54, 0x6a, 0x90, 0x67, 0x22, 0xc8, 0x3f, 0xad, 0xbf, 0x25, 0x92, 0xbb, 0x9a, 0xff, 0x57, 0x14,
-	0xfb, 0x2b, 0x8a, 0xd3, 0x6c, 0x73, 0x9a, 0x7d, 0xc1, 0x80, 0x26, 0xbe, 0x53, 0x06, 0x8f, 0x44,
-	0x06, 0x5b, 0xdb, 0x9b, 0x29, 0x34, 0xaf, 0x72, 0x40, 0xef, 0xa0, 0x70, 0x44, 0x98, 0xf6, 0xad,
-	0xb2, 0x31, 0xdc, 0x1f, 0x63, 0x2b, 0xb0, 0x54, 0x4e, 0x07, 0xa8, 0x36, 0x52, 0xe1, 0xd1, 0x2d,
-	0xc2, 0x7f, 0x65, 0x88, 0x5f, 0xac, 0xa1, 0x05, 0xa5, 0x48, 0xa7, 0xec, 0xba, 0xd2, 0x7a, 0x8a,
-	0x56, 0x05, 0xaf, 0x89, 0xe0, 0x8f, 0x12, 0x6e, 0x8e, 0x0c, 0x7e, 0x31, 0x1a, 0xed, 0x6b, 0x03,
-	0xe6, 0xe5, 0x54, 0x1f, 0x2c, 0x2b, 0xb4, 0x29, 0x62, 0x5c, 0xb7, 0x02, 0x4b, 0xd6, 0x75, 0x10,
-	0x95, 0xcb, 0x03, 0x91, 0xcb, 0x06, 0x5a, 0x4f, 0xc9, 0x45, 0xac, 0xa3, 0x78, 0xc7, 0xd0, 0x72,
-	0x18, 0xec, 0x94, 0x84, 0x1c, 0x46, 0x17, 0x55, 0x42, 0x0e, 0x63, 0x2b, 0xe9, 0xc6, 0x1c, 0x08,
-	0xb7, 0x88, 0x77, 0x8c, 0xf3, 0xac, 0x68, 0xa2, 0xbf, 0xff, 0x12, 0x00, 0x00, 0xff, 0xff, 0x06,
-	0xda, 0x2d, 0xd9, 0x91, 0x13, 0x00, 0x00,
+	// 2281 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x72, 0x1b, 0x59,
+	0xf5, 0x9f, 0x96, 0x2c, 0xd9, 0x3e, 0x92, 0x6c, 0xf9, 0xda, 0x8e, 0x3b, 0xed, 0x38, 0x56, 0xda,
+	0x93, 0x7f, 0x1c, 0x4f, 0x62, 0xe7, 0x6f, 0x6a, 0x98, 0x54, 0x08, 0x50, 0x8e, 0xed, 0x78, 0x4c,
+	0x32, 0x99, 0x54, 0xdb, 0x09, 0x14, 0x2c, 0xba, 0xae, 0xbb, 0xaf, 0x9c, 0x1e, 0xb7, 0x6e, 0x37,
+	0xdd, 0x57, 0x72, 0x54, 0x30, 0x14, 0x03, 0xbb, 0x59, 0xc2, 0x1b, 0xb0, 0x67, 0xc5, 0x13, 0xb0,
+	0xe1, 0x05, 0xd8, 0x51, 0x14, 0x2b, 0x36, 0xbc, 0x02, 0x2b, 0xea, 0x7e, 0x74, 0xab, 0xf5, 0xd1,
+	0x96, 0x3c, 0xb0, 0x80, 0x9d, 0xee, 0x39, 0xbf, 0xf3, 0x79, 0xcf, 0x3d, 0x7d, 0x8e, 0xa0, 0xea,
+	0x92, 0x8e, 0xe7, 0x90, 0xed, 0x30, 0x0a, 0x58, 0x80, 0x8a, 0x38, 0xf4, 0x8c, 0x5b, 0xe7, 0x41,
+	0x70, 0xee, 0x93, 0x1d, 0x1c, 0x7a, 0x3b, 0x98, 0xd2, 0x80, 0x61, 0xe6, 0x05, 0x34, 0x96, 0x10,
+	0x63, 0x5d, 0x71, 0xc5, 0xe9, 0xac, 0xdd, 0xdc, 0x61, 0x5e, 0x8b, 0xc4, 0x0c, 0xb7, 0x42, 0x05,
+	0x58, 0x1d, 0x04, 0x90, 0x56, 0xc8, 0xba, 0x8a, 0x59, 0x75, 0x82, 0x56, 0x2b, 0xa0, 0xea, 0xb4,
+	0xc2, 0x4d, 0x48, 0xca, 0x4e, 0x96, 0x61, 0xfe, 0xb3, 0x00, 0xe5, 0x03, 0xe1, 0x18, 0x5a, 0x81,
+	0x69, 0x97, 0x74, 0x6c, 0xd2, 0xf6, 0x74, 0xad, 0xa1, 0x6d, 0xce, 0x5a, 0x65, 0x97, 0x74, 0x0e,
+	0xdf, 0x1c, 0x23, 0x04, 0x53, 0x14, 0xb7, 0x88, 0x5e, 0x10, 0x54, 0xf1, 0x1b, 0xdd, 0x85, 0x39,
+	0x1c, 0x86, 0xbe, 0xe7, 0x08, 0x97, 0x6d, 0xcf, 0xd5, 0x8b, 0x0d, 0x6d, 0xb3, 0x68, 0xd5, 0x32,
+	0xd4, 0xe3, 0x03, 0xd4, 0x80, 0x8a, 0x4b, 0x62, 0x27, 0xf2, 0x42, 0x4e, 0xd0, 0xa7, 0x84, 0x86,
+	0x2c, 0x09, 0x6d, 0xc1, 0x82, 0x4c, 0x8c, 0x1d, 0x46, 0x41, 0xd3, 0xf3, 0x09, 0xd7, 0x55, 0x12,
+	0xb8, 0x79, 0xc9, 0x78, 0x2d, 0xe9, 0xc7, 0x07, 0xe8, 0x1e, 0xd4, 0xe3, 0x0b, 0x2f, 0xb4, 0x9b,
+	0xb6, 0x43, 0x99, 0xed, 0xbc, 0x23, 0xce, 0x85, 0x5e, 0x6e, 0x68, 0x9b, 0x33, 0x56, 0x8d, 0xd3,
+	0x9f, 0xef, 0x53, 0xb6, 0xcf, 0x89, 0xe8, 0x21, 0xa0, 0x88, 0x34, 0x49, 0x44, 0xa8, 0x43, 0x6c,
+	0xec, 0x33, 0x8f, 0xb5, 0x5d, 0xa2, 0x4f, 0x37, 0xb4, 0x4d, 0xcd, 0x5a, 0x48, 0x39, 0x7b, 0x8a,
+	0x81, 0x1e, 0xc3, 0x6c, 0x07, 0x47, 0x1e, 0x3e, 0xf3, 0x49, 0xac, 0xcf, 0x34, 0x8a, 0x9b, 0x95,
+	0x5d, 0x63, 0x1b, 0x87, 0xde, 0xb6, 0xcc, 0xcc, 0xf6, 0xdb, 0x84, 0x79, 0x48, 0x59, 0xd4, 0xb5,
+	0x7a, 0x60, 0xe3, 0x29, 0xcc, 0xf5, 0x33, 0x51, 0x1d, 0x8a, 0x17, 0xa4, 0xab, 0x32, 0xc8, 0x7f,
+	0xa2, 0x25, 0x28, 0x75, 0xb0, 0xdf, 0x4e, 0xf2, 0x27, 0x0f, 0x4f, 0x0a, 0x8f, 0x35, 0xf3, 0x1f,
+	0x53, 0x30, 0x27, 0x4d, 0xbc, 0xf4, 0x62, 0x76, 0xcc, 0x48, 0xeb, 0x7f, 0xe0, 0x12, 0xb6, 0x61,
+	0x71, 0x00, 0x2b, 0xfc, 0x2a, 0x0b, 0xf4, 0x42, 0x1f, 0xfa, 0x15, 0x77, 0x72, 0x17, 0x96, 0x15,
+	0x3e, 0x66, 0x98, 0xb5, 0x63, 0xfb, 0x0c, 0x33, 0x46, 0xa2, 0xae, 0xb8, 0x8e, 0x9a, 0xa5, 0x94,
+	0x9d, 0x08, 0xde, 0x33, 0xc9, 0x42, 0x8f, 0x60, 0xa9, 0x5f, 0xa6, 0x85, 0xa3, 0x73, 0x8f, 0xea,
+	0x33, 0x0d, 0x6d, 0xb3, 0x64, 0xa1, 0xac, 0xc8, 0x67, 0x82, 0x83, 0x5e, 0xc2, 0x46, 0xbf, 0x04,
+	0x79, 0xcf, 0x48, 0x44, 0xb1, 0x6f, 0x87, 0xc1, 0x25, 0x89, 0xec, 0x38, 0x68, 0x47, 0x0e, 0xd1,
+	0x41, 0x54, 0xcb, 0x7a, 0x56, 0xc1, 0xa1, 0x02, 0xbe, 0xe6, 0xb8, 0x13, 0x01, 0x43, 0xa7, 0x70,
+	0x6f, 0xa4, 0xcf, 0xb6, 0x4f, 0x3a, 0xc4, 0xb7, 0xdb, 0x14, 0x77, 0xb0, 0xe7, 0xf3, 0x5b, 0xd7,
+	0x2b, 0x42, 0xe3, 0xc6, 0x88, 0x28, 0x5e, 0x72, 0xec, 0x9b, 0x1e, 0x14, 0x7d, 0x17, 0x56, 0xaf,
+	0xd0, 0xaa, 0x57, 0x1b, 0xda, 0x66, 0xc1, 0xd2, 0xf3, 0x34, 0xa1, 0xa7, 0x50, 0xf5, 0x71, 0xcc,
+	0xec, 0x98, 0x10, 0x6a, 0x63, 0xa6, 0xcf, 0x36, 0x34, 0x51, 0xa8, 0xb2, 0x0b, 0x6c, 0x27, 0x5d,
+	0x60, 0xfb, 0x34, 0x69, 0x13, 0x16, 0x70, 0xfc, 0x09, 0x21, 0x74, 0x8f, 0x99, 0x3f, 0x04, 0x90,
+	0xa5, 0xf6, 0x82, 0x74, 0xe3, 0xfc, 0x32, 0x5b, 0x81, 0x69, 0x7a, 0x79, 0x61, 0xf3, 0x12, 0x96,
+	0x95, 0x56, 0xa6, 0x97, 0x17, 0x2f, 0x48, 0x97, 0x33, 0x70, 0x18, 0x0a, 0x46, 0x51, 0x32, 0x70,
+	0x18, 0xbe, 0x20, 0x5d, 0xf3, 0x09, 0x2c, 0xee, 0x47, 0x04, 0x33, 0x22, 0xd5, 0x5b, 0xe4, 0xa7,
+	0x6d, 0x12, 0x33, 0xb4, 0x01, 0x65, 0x19, 0x89, 0x30, 0x50, 0xd9, 0xad, 0x64, 0x1e, 0x94, 0xa5,
+	0x58, 0xe6, 0x47, 0x50, 0x3f, 0x22, 0xac, 0x5f, 0x30, 0xcf, 0x35, 0xf3, 0xeb, 0x02, 0x2c, 0x64,
+	0xd0, 0x71, 0x18, 0xd0, 0x98, 0x4c, 0x64, 0x67, 0x28, 0x75, 0xa5, 0xeb, 0xa4, 0x2e, 0xbf, 0x82,
+	0xcb, 0xd7, 0xaf, 0xe0, 0xa5, 0xdc, 0x0a, 0x7e, 0x00, 0x33, 0x7e, 0x20, 0xdf, 0xac, 0xbe, 0x2c,
+	0xfc, 0xab, 0x6f, 0xab, 0x56, 0xfd, 0x52, 0xd1, 0xad, 0x14, 0x61, 0xfe, 0x55, 0x83, 0x05, 0xde,
+	0x34, 0xfa, 0x73, 0xb7, 0x04, 0x25, 0xdf, 0x6b, 0x79, 0x4c, 0xe4, 0xa2, 0x68, 0xc9, 0x03, 0xba,
+	0x01, 0xe5, 0xa0, 0xd9, 0x8c, 0x09, 0x13, 0x57, 0x5a, 0xb4, 0xd4, 0x69, 0xd2, 0xf6, 0x71, 0x03,
+	0xca, 0x31, 0xc1, 0x91, 0xf3, 0x4e, 0x75, 0x0e, 0x75, 0x42, 0x0f, 0x00, 0xb5, 0xda, 0x3e, 0xf3,
+	0x1c, 0x9e, 0xd9, 0xf3, 0x28, 0x68, 0x87, 0xbd, 0xae, 0x51, 0x4f, 0x39, 0x47, 0x9c, 0x71, 0x7c,
+	0xc0, 0xd1, 0x31, 0x89, 0x06, 0x7b, 0x8c, 0xec, 0x1a, 0x75, 0xc5, 0x49, 0x9b, 0x8c, 0x79, 0x06,
+	0x28, 0x1b, 0x9d, 0xba, 0xeb, 0x75, 0xa8, 0xb0, 0x80, 0x61, 0xdf, 0x76, 0x82, 0x36, 0x4d, 0x82,
+	0x04, 0x41, 0xda, 0xe7, 0x14, 0xf4, 0x11, 0x94, 0x23, 0x12, 0xb7, 0x7d, 0x1e, 0x29, 0xef, 0xe2,
+	0x8b, 0x99, 0x62, 0x48, 0x5a, 0xac, 0xa5, 0x20, 0xe6, 0x36, 0x2c, 0x1e, 0x10, 0x9f, 0x30, 0x32,
+	0x61, 0xfd, 0x3d, 0x81, 0xc5, 0x37, 0xa1, 0xfb, 0xcd, 0x0a, 0xfd, 0x05, 0xac, 0x64, 0x1f, 0x09,
+	0x7f, 0x83, 0x89, 0xfc, 0x23, 0xde, 0x9d, 0x45, 0x5e, 0x2e, 0x48, 0x37, 0x56, 0x4a, 0xe6, 0x33,
+	0x4a, 0x04, 0x18, 0xdc, 0xf4, 0xb7, 0xb9, 0x03, 0x4b, 0xe9, 0x3b, 0xc8, 0x6a, 0xca, 0xf5, 0xfc,
+	0x18, 0x96, 0x07, 0x04, 0x54, 0x42, 0xaf, 0x6f, 0xfb, 0x05, 0xac, 0x64, 0x93, 0xf0, 0xef, 0x05,
+	0xb2, 0x0b, 0x2b, 0xd9, 0x1b, 0x98, 0x28, 0x96, 0xdf, 0x17, 0xa0, 0x2e, 0xe1, 0x7b, 0x0e, 0xf3,
+	0x3a, 0xa2, 0x48, 0xf3, 0xdb, 0xd9, 0x4d, 0x98, 0xe1, 0x0c, 0xec, 0xba, 0x91, 0xea, 0x67, 0x1c,
+	0xb8, 0xe7, 0xba, 0x11, 0x32, 0x60, 0x96, 0x37, 0xb4, 0x38, 0xd3, 0xd2, 0x78, 0x87, 0x3b, 0xe1,
+	0xcd, 0xee, 0x0e, 0xd4, 0x78, 0x17, 0x8c, 0x6d, 0x42, 0x1d, 0xc1, 0x97, 0x95, 0x0f, 0xf4, 0xf2,
+	0xe2, 0xe4, 0x90, 0x3a, 0x1c, 0xf2, 0x21, 0xcc, 0xc7, 0xb6, 0x04, 0x79, 0x94, 0x09, 0xd0, 0x8c,
+	0xfc, 0xb0, 0xc6, 0xaf, 0x2e, 0x2f, 0x4e, 0x8e, 0x29, 0x53, 0xa8, 0xe6, 0x00, 0x6a, 0x56, 0xa2,
+	0x9a, 0x19, 0x94, 0x0e, 0x33, 0x72, 0xa4, 0x69, 0x87, 0xe2, 0xfd, 0xd4, 0xac, 0x72, 0x73, 0x9f,
+	0xb2, 0x37, 0x21, 0x5a, 0x87, 0x2a, 0x55, 0xe3, 0x8e, 0x1b, 0x5c, 0x52, 0xd5, 0x71, 0x66, 0x29,
+	0x1f, 0x75, 0x0e, 0x82, 0x4b, 0xca, 0x01, 0x38, 0x0b, 0x00, 0x09, 0xc0, 0x09, 0xc0, 0xfc, 0x09,
+	0x2c, 0xab, 0x44, 0x0d, 0xd4, 0xed, 0xb3, 0xf4, 0x9b, 0x8f, 0xd3, 0x44, 0xaa, 0x4b, 0x5b, 0xce,
+	0x5c, 0x5a, 0x2f, 0xcb, 0x56, 0xdd, 0x1d, 0xa0, 0xc8, 0x0b, 0xc4, 0x23, 0xd5, 0xe7, 0x5e, 0xe0,
+	0xc7, 0x60, 0xa4, 0xc5, 0x98, 0x51, 0x3e, 0x4e, 0x0c, 0xc3, 0xea, 0x48, 0x31, 0x55, 0xc9, 0xff,
+	0xa1, 0x68, 0x8e, 0x08, 0xb3, 0x30, 0x75, 0x83, 0xd6, 0x81, 0xac, 0x92, 0x09, 0xa2, 0xd1, 0x87,
+	0x65, 0x94, 0x4f, 0xd9, 0xe2, 0xd3, 0xfa, 0x8a, 0xcf, 0xfc, 0x04, 0x6e, 0x9d, 0xb0, 0x88, 0xe0,
+	0x96, 0x74, 0xeb, 0x79, 0x84, 0x5b, 0xe4, 0x65, 0x70, 0x3e, 0xbe, 0xfc, 0x7f, 0xa7, 0xc1, 0x5a,
+	0x8e, 0xa4, 0xb2, 0xfa, 0x18, 0xaa, 0xed, 0xd0, 0xf7, 0xe8, 0x85, 0xdd, 0xe4, 0x3c, 0x95, 0x04,
+	0xd9, 0x09, 0xdf, 0x08, 0x46, 0x22, 0xf3, 0xe9, 0x07, 0x56, 0xa5, 0xdd, 0xa3, 0xa0, 0xef, 0xc1,
+	0x1c, 0xaf, 0xa1, 0x8c, 0x6c, 0x21, 0x9b, 0x40, 0xc5, 0xca, 0x48, 0xd7, 0xdc, 0x2c, 0xed, 0xd9,
+	0x34, 0x94, 0x84, 0xd8, 0x60, 0x74, 0x87, 0x1d, 0x42, 0xd9, 0x44, 0xd1, 0xbd, 0x85, 0xb5, 0x1c,
+	0x41, 0x15, 0x1c, 0x82, 0x29, 0xd6, 0x0d, 0x89, 0x12, 0x13, 0xbf, 0xd1, 0x1d, 0xa8, 0x86, 0xb8,
+	0xeb, 0x07, 0xd8, 0xb5, 0xbf, 0x88, 0x03, 0xaa, 0xde, 0x79, 0x45, 0xd1, 0x7e, 0x70, 0xf2, 0xf9,
+	0xab, 0xbe, 0x9a, 0xdb, 0x0f, 0x5c, 0xe2, 0xf0, 0x2f, 0xef, 0xf8, 0x52, 0x7d, 0x0a, 0xab, 0x23,
+	0xc5, 0x94, 0x33, 0x6b, 0x00, 0xfc, 0xe3, 0x4e, 0xa4, 0x59, 0x29, 0x3a, 0x2b, 0x28, 0xc2, 0xe8,
+	0x27, 0x70, 0xcb, 0x22, 0xf1, 0x37, 0x30, 0xfb, 0x37, 0x0d, 0xaa, 0x52, 0x48, 0xde, 0x17, 0xfa,
+	0x0e, 0x54, 0x22, 0xe2, 0x10, 0xaf, 0x43, 0x5c, 0x3e, 0xbd, 0x68, 0xe3, 0xa7, 0x97, 0x04, 0xbe,
+	0xc7, 0xd0, 0x22, 0x94, 0x44, 0x7f, 0x10, 0x79, 0xa9, 0x59, 0x53, 0xbc, 0xb3, 0xa0, 0x65, 0x28,
+	0x37, 0xed, 0x30, 0x88, 0x98, 0xe8, 0x7c, 0x35, 0xab, 0xd4, 0x7c, 0x1d, 0x44, 0x0c, 0xcd, 0x41,
+	0xc1, 0x8d, 0x44, 0xb3, 0xab, 0x59, 0x05, 0x37, 0xe2, 0xe9, 0x76, 0x31, 0xc3, 0xa2, 0x29, 0x55,
+	0x2d, 0xf1, 0x9b, 0x7f, 0x84, 0x83, 0xb3, 0x2f, 0x88, 0xc3, 0x64, 0xd8, 0xf2, 0x0b, 0x0e, 0x92,
+	0xc4, 0xe3, 0x46, 0x0d, 0xa8, 0x46, 0xef, 0x6d, 0x8f, 0x36, 0x03, 0x89, 0x98, 0x96, 0x88, 0xe8,
+	0xfd, 0x31, 0x6d, 0x06, 0x22, 0x33, 0x7f, 0xd4, 0x40, 0xef, 0x7d, 0xde, 0x65, 0x90, 0x63, 0x8b,
+	0x03, 0x3d, 0x82, 0x52, 0xcc, 0x70, 0xc4, 0xf4, 0xc2, 0xd8, 0xf8, 0x25, 0x10, 0x3d, 0x80, 0x22,
+	0xa1, 0x72, 0xaa, 0xb9, 0x1a, 0xcf, 0x61, 0xbd, 0xe1, 0x69, 0x6a, 0xf4, 0xf0, 0x54, 0xca, 0x0e,
+	0x4f, 0xe6, 0x39, 0xdc, 0x1c, 0x11, 0xc2, 0xa4, 0x83, 0xca, 0xfd, 0x81, 0x41, 0x65, 0x21, 0xd3,
+	0xa3, 0xa4, 0xb2, 0x74, 0x4c, 0xf9, 0x93, 0x06, 0xc6, 0xe1, 0x7b, 0x7e, 0x57, 0xff, 0x5d, 0xe9,
+	0xda, 0x81, 0x72, 0x33, 0x88, 0x5a, 0x58, 0xe6, 0x6b, 0x6e, 0x77, 0x25, 0xd3, 0x61, 0xa4, 0xbf,
+	0xcf, 0x05, 0xdb, 0x52, 0x30, 0xf3, 0x14, 0x56, 0x47, 0xc6, 0xa1, 0x72, 0x76, 0x07, 0xaa, 0x4e,
+	0x40, 0x19, 0xa1, 0xcc, 0xce, 0x3c, 0xf1, 0x8a, 0xa2, 0x9d, 0xf2, 0x97, 0x9e, 0x94, 0xa3, 0xda,
+	0x81, 0xf9, 0x6f, 0xf3, 0x37, 0x05, 0x58, 0x94, 0x0a, 0xe5, 0x34, 0xfd, 0xa9, 0x17, 0xb3, 0x20,
+	0xea, 0xf2, 0x9d, 0x3e, 0xfd, 0xbf, 0x64, 0x82, 0x17, 0xd3, 0x03, 0xf3, 0x3a, 0x90, 0xd7, 0x56,
+	0x10, 0xb3, 0xba, 0x3c, 0xf0, 0x3a, 0x50, 0x23, 0x7c, 0x51, 0x90, 0xd5, 0x09, 0x6d, 0x40, 0xad,
+	0x7f, 0x8d, 0x9b, 0x12, 0x6b, 0x5c, 0xf5, 0x2c, 0xbb, 0xba, 0x3d, 0x81, 0x9b, 0xf9, 0x1b, 0x64,
+	0x49, 0x6c, 0x90, 0x2b, 0x67, 0x39, 0x5b, 0xe3, 0x2e, 0x2c, 0x8f, 0xde, 0x65, 0xe5, 0x3f, 0x1f,
+	0x8b, 0x64, 0x78, 0x7f, 0x35, 0xff, 0xa2, 0xc1, 0x5a, 0xda, 0xb9, 0xfa, 0xf2, 0x32, 0xb6, 0x6c,
+	0x0c, 0x98, 0xf1, 0x28, 0x23, 0x51, 0x07, 0xfb, 0x2a, 0xcf, 0xe9, 0x19, 0xed, 0xc3, 0xbc, 0xa8,
+	0x14, 0xbb, 0x97, 0xd9, 0xf1, 0xc5, 0x32, 0x27, 0x44, 0xd2, 0x33, 0xfa, 0x3e, 0xd4, 0x08, 0x75,
+	0x33, 0x2a, 0xa6, 0xc6, 0xaa, 0xa8, 0x12, 0xea, 0xa6, 0x27, 0xf3, 0x0f, 0x1a, 0xdc, 0xce, 0x0b,
+	0x2e, 0x9d, 0x6b, 0x93, 0xe7, 0xa5, 0x89, 0xe7, 0xa5, 0x67, 0x9e, 0x57, 0xbf, 0x84, 0xc2, 0x21,
+	0x1b, 0x6e, 0x27, 0x37, 0xe4, 0x92, 0xd0, 0x27, 0x62, 0x23, 0x0a, 0x23, 0xe2, 0x7a, 0x0e, 0x93,
+	0x5d, 0x77, 0xfc, 0x33, 0x5a, 0x55, 0x1a, 0x0e, 0x12, 0x05, 0xaf, 0x13, 0xf9, 0x3d, 0xb6, 0xf5,
+	0x31, 0xa0, 0xe1, 0xb7, 0x81, 0xe6, 0xa1, 0x72, 0xf8, 0xa3, 0xd7, 0x9f, 0x5b, 0xa7, 0x36, 0x6f,
+	0x8c, 0xf5, 0x0f, 0xd0, 0x1c, 0x80, 0x22, 0xec, 0x9f, 0xbc, 0xad, 0x6b, 0xbb, 0xbf, 0x46, 0x50,
+	0x53, 0x7e, 0xcb, 0x1d, 0x09, 0x9d, 0x40, 0x59, 0xae, 0x12, 0x48, 0x46, 0x35, 0x62, 0xf9, 0x36,
+	0x6e, 0x0c, 0x79, 0x79, 0xc8, 0xff, 0x1a, 0x34, 0x57, 0x7e, 0xf5, 0xe7, 0xbf, 0xff, 0xb6, 0xb0,
+	0x60, 0x56, 0xc5, 0x5f, 0x8e, 0x72, 0x00, 0x8a, 0x9f, 0x68, 0x5b, 0xe8, 0x14, 0x8a, 0x47, 0x84,
+	0x21, 0xf9, 0xa5, 0x1f, 0x5c, 0xc9, 0x8d, 0x1b, 0x83, 0x64, 0x99, 0x66, 0xf3, 0xb6, 0x50, 0xa7,
+	0xa3, 0x1b, 0x59, 0x75, 0x3b, 0x3f, 0x53, 0x85, 0xf5, 0x25, 0xfa, 0x0c, 0xa6, 0x78, 0x8f, 0x44,
+	0x52, 0x7e, 0x68, 0x5d, 0x35, 0x56, 0x86, 0xe8, 0x4a, 0xf1, 0x92, 0x50, 0x3c, 0x87, 0xfa, 0xfc,
+	0x44, 0x3f, 0x86, 0xb2, 0x5c, 0x17, 0x50, 0x72, 0x9f, 0x3e, 0x99, 0x34, 0x72, 0xe5, 0xea, 0x56,
+	0x9e, 0xab, 0x2e, 0x94, 0xe5, 0x5e, 0xa3, 0x74, 0x8f, 0xd8, 0xf4, 0x72, 0x75, 0x6f, 0x0a, 0xdd,
+	0xa6, 0xb1, 0x36, 0xa4, 0xdb, 0x73, 0xc8, 0x76, 0x62, 0x82, 0xa7, 0xb9, 0x03, 0x20, 0xaf, 0x4b,
+	0xfc, 0x09, 0x73, 0x6b, 0xe8, 0xfe, 0x32, 0x1b, 0x50, 0xae, 0xb5, 0x5d, 0x61, 0xed, 0x81, 0x79,
+	0x6f, 0x94, 0x35, 0xb1, 0x7a, 0xa5, 0x26, 0x77, 0xf8, 0x89, 0xdb, 0x25, 0x30, 0x7d, 0x44, 0x98,
+	0x30, 0x7a, 0xb3, 0xff, 0x2e, 0xb3, 0x16, 0x8d, 0x51, 0x2c, 0x75, 0x23, 0x1b, 0xc2, 0xea, 0x1a,
+	0x5a, 0x1d, 0x9d, 0x3f, 0x61, 0x89, 0x87, 0x27, 0xf3, 0x96, 0x09, 0x2f, 0x67, 0x5b, 0x1c, 0x17,
+	0x9e, 0x71, 0x9d, 0xf0, 0xce, 0x01, 0x64, 0x2d, 0x64, 0xec, 0xe6, 0x2c, 0x96, 0xb9, 0x76, 0x55,
+	0x80, 0x5b, 0x57, 0x06, 0xf8, 0x73, 0x98, 0x49, 0x96, 0x29, 0x24, 0xb3, 0x35, 0x72, 0xb7, 0xca,
+	0x35, 0xf2, 0x54, 0x18, 0xf9, 0xb6, 0xf9, 0xff, 0x23, 0x83, 0xeb, 0x6d, 0x2e, 0xbd, 0x10, 0x15,
+	0x8d, 0xf0, 0x30, 0x5b, 0x3c, 0xcc, 0x84, 0x90, 0x86, 0x89, 0xaf, 0xe5, 0xc1, 0x7d, 0xe1, 0xc1,
+	0xc6, 0xd6, 0x9d, 0x9c, 0x30, 0x7b, 0x3e, 0xa0, 0x2f, 0xa1, 0x76, 0x44, 0x58, 0x66, 0xcb, 0x5e,
+	0xef, 0xaf, 0x8f, 0xa1, 0xe5, 0xcd, 0x68, 0xe4, 0x03, 0x54, 0x19, 0x29, 0xf3, 0x68, 0x02, 0xf3,
+	0xbf, 0xd4, 0xa0, 0x3e, 0xb8, 0x5a, 0xa9, 0xa0, 0x73, 0xb6, 0x34, 0x63, 0x2d, 0x87, 0xab, 0x8c,
+	0xef, 0x08, 0xe3, 0xf7, 0xcd, 0x7b, 0x39, 0xc6, 0xcf, 0x07, 0xad, 0x7d, 0xa5, 0xc1, 0xbc, 0xdc,
+	0x47, 0xd2, 0x35, 0x0b, 0xdd, 0x11, 0x36, 0xae, 0x5a, 0xde, 0x0c, 0xf3, 0x2a, 0x88, 0xf2, 0xe5,
+	0xae, 0xf0, 0x65, 0x1d, 0xad, 0xe5, 0xf8, 0x22, 0x16, 0xa9, 0xf8, 0x91, 0x96, 0xf1, 0x21, 0xdd,
+	0x86, 0x46, 0xf8, 0x30, 0xb8, 0x62, 0x19, 0xe6, 0x55, 0x90, 0x09, 0x7d, 0x20, 0x5c, 0x82, 0xfb,
+	0xf0, 0x0b, 0x51, 0x09, 0xbd, 0x0d, 0x66, 0xb0, 0x12, 0x86, 0x76, 0x1b, 0xa3, 0x91, 0x0f, 0x50,
+	0xc6, 0xb7, 0x84, 0xf1, 0x0f, 0x91, 0x99, 0x63, 0xdc, 0xe1, 0x22, 0x0f, 0xc5, 0x36, 0x85, 0xde,
+	0xc3, 0xbc, 0xd8, 0xa4, 0x32, 0x1e, 0xc8, 0x14, 0x5c, 0xb5, 0x5f, 0xe5, 0x3e, 0x01, 0x65, 0x79,
+	0x6b, 0x12, 0xcb, 0x0c, 0x2a, 0xfc, 0xf3, 0xa4, 0x66, 0x55, 0xb4, 0x36, 0xf0, 0xc1, 0xea, 0x9f,
+	0xc5, 0x8d, 0xdb, 0x79, 0x6c, 0x15, 0xf3, 0xff, 0x09, 0xcb, 0x0d, 0x74, 0x3b, 0xc7, 0x72, 0x5b,
+	0x99, 0xf9, 0x4a, 0x83, 0x9a, 0x1c, 0x13, 0x12, 0xc3, 0x32, 0xe1, 0xf9, 0x6b, 0x80, 0xd1, 0xc8,
+	0x07, 0x28, 0xe3, 0x0f, 0x85, 0xf1, 0x7b, 0xe8, 0xee, 0xd5, 0xc6, 0x77, 0x88, 0xd0, 0x81, 0xbe,
+	0x96, 0xcf, 0xaf, 0x7f, 0xa8, 0x36, 0xfb, 0xaf, 0x75, 0xd4, 0x64, 0x69, 0x6c, 0x5c, 0x89, 0x99,
+	0xd0, 0x19, 0xf9, 0xa7, 0xf9, 0xc3, 0x77, 0x52, 0xec, 0xac, 0x2c, 0xae, 0xf0, 0x5b, 0xff, 0x1a,
+	0x00, 0x30, 0x50, 0x70, 0xe1, 0x44, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1651,6 +2410,23 @@ type DeviceServiceClient interface {
 	//   * This endpoint is intended for debugging only.
 	//   * This endpoint does not work from a web-browser.
 	StreamEventLogs(ctx context.Context, in *StreamDeviceEventLogsRequest, opts ...grpc.CallOption) (DeviceService_StreamEventLogsClient, error)
+	// GetCodecState returns the codec state of the device.
+	// This is the state stored by the payload codec (context.state).
+	GetCodecState(ctx context.Context, in *GetDeviceCodecStateRequest, opts ...grpc.CallOption) (*GetDeviceCodecStateResponse, error)
+	// ResetCodecState resets (removes) the codec state of the device.
+	ResetCodecState(ctx context.Context, in *ResetDeviceCodecStateRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ListUplinks returns the stored uplinks of the device, sorted by
+	// received at timestamp (newest first).
+	// Uplinks are only stored when the uplink history of the application
+	// is enabled.
+	ListUplinks(ctx context.Context, in *ListDeviceUplinksRequest, opts ...grpc.CallOption) (*ListDeviceUplinksResponse, error)
+	// ExportUplinks exports the stored uplinks of the device within the
+	// given time range, sorted by received at timestamp (oldest first).
+	ExportUplinks(ctx context.Context, in *ExportDeviceUplinksRequest, opts ...grpc.CallOption) (*ExportDeviceUplinksResponse, error)
+	// GetStatusHistory returns the device-status history of the device,
+	// aggregated by the given interval, and the predicted battery depletion
+	// based on the battery level trend.
+	GetStatusHistory(ctx context.Context, in *GetDeviceStatusHistoryRequest, opts ...grpc.CallOption) (*GetDeviceStatusHistoryResponse, error)
 }
 
 type deviceServiceClient struct {
@@ -1842,6 +2618,51 @@ func (x *deviceServiceStreamEventLogsClient) Recv() (*StreamDeviceEventLogsRespo
 	return m, nil
 }
 
+func (c *deviceServiceClient) GetCodecState(ctx context.Context, in *GetDeviceCodecStateRequest, opts ...grpc.CallOption) (*GetDeviceCodecStateResponse, error) {
+	out := new(GetDeviceCodecStateResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/GetCodecState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) ResetCodecState(ctx context.Context, in *ResetDeviceCodecStateRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/ResetCodecState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) ListUplinks(ctx context.Context, in *ListDeviceUplinksRequest, opts ...grpc.CallOption) (*ListDeviceUplinksResponse, error) {
+	out := new(ListDeviceUplinksResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/ListUplinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) ExportUplinks(ctx context.Context, in *ExportDeviceUplinksRequest, opts ...grpc.CallOption) (*ExportDeviceUplinksResponse, error) {
+	out := new(ExportDeviceUplinksResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/ExportUplinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) GetStatusHistory(ctx context.Context, in *GetDeviceStatusHistoryRequest, opts ...grpc.CallOption) (*GetDeviceStatusHistoryResponse, error) {
+	out := new(GetDeviceStatusHistoryResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/GetStatusHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeviceServiceServer is the server API for DeviceService service.
 type DeviceServiceServer interface {
 	// Create creates the given device.
@@ -1878,6 +2699,23 @@ type DeviceServiceServer interface {
 	//   * This endpoint is intended for debugging only.
 	//   * This endpoint does not work from a web-browser.
 	StreamEventLogs(*StreamDeviceEventLogsRequest, DeviceService_StreamEventLogsServer) error
+	// GetCodecState returns the codec state of the device.
+	// This is the state stored by the payload codec (context.state).
+	GetCodecState(context.Context, *GetDeviceCodecStateRequest) (*GetDeviceCodecStateResponse, error)
+	// ResetCodecState resets (removes) the codec state of the device.
+	ResetCodecState(context.Context, *ResetDeviceCodecStateRequest) (*empty.Empty, error)
+	// ListUplinks returns the stored uplinks of the device, sorted by
+	// received at timestamp (newest first).
+	// Uplinks are only stored when the uplink history of the application
+	// is enabled.
+	ListUplinks(context.Context, *ListDeviceUplinksRequest) (*ListDeviceUplinksResponse, error)
+	// ExportUplinks exports the stored uplinks of the device within the
+	// given time range, sorted by received at timestamp (oldest first).
+	ExportUplinks(context.Context, *ExportDeviceUplinksRequest) (*ExportDeviceUplinksResponse, error)
+	// GetStatusHistory returns the device-status history of the device,
+	// aggregated by the given interval, and the predicted battery depletion
+	// based on the battery level trend.
+	GetStatusHistory(context.Context, *GetDeviceStatusHistoryRequest) (*GetDeviceStatusHistoryResponse, error)
 }
 
 func RegisterDeviceServiceServer(s *grpc.Server, srv DeviceServiceServer) {
@@ -2160,6 +2998,96 @@ func (x *deviceServiceStreamEventLogsServer) Send(m *StreamDeviceEventLogsRespon
 	return x.ServerStream.SendMsg(m)
 }
 
+func _DeviceService_GetCodecState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceCodecStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).GetCodecState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/GetCodecState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).GetCodecState(ctx, req.(*GetDeviceCodecStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_ResetCodecState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetDeviceCodecStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).ResetCodecState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/ResetCodecState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).ResetCodecState(ctx, req.(*ResetDeviceCodecStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_ListUplinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeviceUplinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).ListUplinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/ListUplinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).ListUplinks(ctx, req.(*ListDeviceUplinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_ExportUplinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportDeviceUplinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).ExportUplinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/ExportUplinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).ExportUplinks(ctx, req.(*ExportDeviceUplinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_GetStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).GetStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/GetStatusHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).GetStatusHistory(ctx, req.(*GetDeviceStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DeviceService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.DeviceService",
 	HandlerType: (*DeviceServiceServer)(nil),
@@ -2216,6 +3144,26 @@ var _DeviceService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetRandomDevAddr",
 			Handler:    _DeviceService_GetRandomDevAddr_Handler,
 		},
+		{
+			MethodName: "GetCodecState",
+			Handler:    _DeviceService_GetCodecState_Handler,
+		},
+		{
+			MethodName: "ResetCodecState",
+			Handler:    _DeviceService_ResetCodecState_Handler,
+		},
+		{
+			MethodName: "ListUplinks",
+			Handler:    _DeviceService_ListUplinks_Handler,
+		},
+		{
+			MethodName: "ExportUplinks",
+			Handler:    _DeviceService_ExportUplinks_Handler,
+		},
+		{
+			MethodName: "GetStatusHistory",
+			Handler:    _DeviceService_GetStatusHistory_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	empty "github.com/golang/protobuf/ptypes/empty"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -483,6 +484,236 @@ func (m *ListDeviceProfileResponse) GetResult() []*DeviceProfileListItem {
 	return nil
 }
 
+type TestPayloadCodecRequest struct {
+	// Organization ID.
+	OrganizationId int64 `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Payload codec.
+	// Leave blank to disable the codec feature.
+	PayloadCodec string `protobuf:"bytes,2,opt,name=payload_codec,json=payloadCodec,proto3" json:"payload_codec,omitempty"`
+	// Payload encoder script.
+	// Depending the codec, it is possible to provide a script which implements
+	// the encoder function.
+	PayloadEncoderScript string `protobuf:"bytes,3,opt,name=payload_encoder_script,json=payloadEncoderScript,proto3" json:"payload_encoder_script,omitempty"`
+	// Payload decoder script.
+	// Depending the codec, it is possible to provide a script which implements
+	// the decoder function.
+	PayloadDecoderScript string `protobuf:"bytes,4,opt,name=payload_decoder_script,json=payloadDecoderScript,proto3" json:"payload_decoder_script,omitempty"`
+	// FPort.
+	FPort uint32 `protobuf:"varint,5,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// Data to decode.
+	// When set, the data is decoded into an object.
+	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	// JSON encoded object to encode.
+	// When set (and data is empty), the object is encoded into bytes.
+	ObjectJson string `protobuf:"bytes,7,opt,name=object_json,json=objectJSON,proto3" json:"object_json,omitempty"`
+	// Payload codec protobuf descriptor set.
+	// Binary encoded FileDescriptorSet, used by the PROTOBUF payload codec.
+	PayloadCodecDescriptorSet []byte `protobuf:"bytes,8,opt,name=payload_codec_descriptor_set,json=payloadCodecDescriptorSet,proto3" json:"payload_codec_descriptor_set,omitempty"`
+	// Device variables.
+	// These are exposed to the codec as variables of the context argument.
+	Variables map[string]string `protobuf:"bytes,9,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Payload codec WebAssembly module.
+	// Binary WebAssembly module, used by the WASM payload codec.
+	PayloadCodecWasmModule []byte `protobuf:"bytes,10,opt,name=payload_codec_wasm_module,json=payloadCodecWASMModule,proto3" json:"payload_codec_wasm_module,omitempty"`
+	// Codec ID.
+	// When set, the codec (of the codec library) is tested instead of the
+	// payload codec defined above, e.g. to test a draft version before
+	// publishing it.
+	CodecId int64 `protobuf:"varint,11,opt,name=codec_id,json=codecID,proto3" json:"codec_id,omitempty"`
+	// Codec version.
+	// Set to 0 to test the latest published version of the codec.
+	CodecVersion         uint32   `protobuf:"varint,12,opt,name=codec_version,json=codecVersion,proto3" json:"codec_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TestPayloadCodecRequest) Reset()         { *m = TestPayloadCodecRequest{} }
+func (m *TestPayloadCodecRequest) String() string { return proto.CompactTextString(m) }
+func (*TestPayloadCodecRequest) ProtoMessage()    {}
+func (*TestPayloadCodecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed478be08b3cbfaf, []int{9}
+}
+
+func (m *TestPayloadCodecRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TestPayloadCodecRequest.Unmarshal(m, b)
+}
+func (m *TestPayloadCodecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TestPayloadCodecRequest.Marshal(b, m, deterministic)
+}
+func (m *TestPayloadCodecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestPayloadCodecRequest.Merge(m, src)
+}
+func (m *TestPayloadCodecRequest) XXX_Size() int {
+	return xxx_messageInfo_TestPayloadCodecRequest.Size(m)
+}
+func (m *TestPayloadCodecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestPayloadCodecRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TestPayloadCodecRequest proto.InternalMessageInfo
+
+func (m *TestPayloadCodecRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *TestPayloadCodecRequest) GetPayloadCodec() string {
+	if m != nil {
+		return m.PayloadCodec
+	}
+	return ""
+}
+
+func (m *TestPayloadCodecRequest) GetPayloadEncoderScript() string {
+	if m != nil {
+		return m.PayloadEncoderScript
+	}
+	return ""
+}
+
+func (m *TestPayloadCodecRequest) GetPayloadDecoderScript() string {
+	if m != nil {
+		return m.PayloadDecoderScript
+	}
+	return ""
+}
+
+func (m *TestPayloadCodecRequest) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *TestPayloadCodecRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *TestPayloadCodecRequest) GetObjectJson() string {
+	if m != nil {
+		return m.ObjectJson
+	}
+	return ""
+}
+
+func (m *TestPayloadCodecRequest) GetPayloadCodecDescriptorSet() []byte {
+	if m != nil {
+		return m.PayloadCodecDescriptorSet
+	}
+	return nil
+}
+
+func (m *TestPayloadCodecRequest) GetVariables() map[string]string {
+	if m != nil {
+		return m.Variables
+	}
+	return nil
+}
+
+func (m *TestPayloadCodecRequest) GetPayloadCodecWasmModule() []byte {
+	if m != nil {
+		return m.PayloadCodecWasmModule
+	}
+	return nil
+}
+
+func (m *TestPayloadCodecRequest) GetCodecId() int64 {
+	if m != nil {
+		return m.CodecId
+	}
+	return 0
+}
+
+func (m *TestPayloadCodecRequest) GetCodecVersion() uint32 {
+	if m != nil {
+		return m.CodecVersion
+	}
+	return 0
+}
+
+type TestPayloadCodecResponse struct {
+	// JSON encoded decoded object (decode).
+	ObjectJson string `protobuf:"bytes,1,opt,name=object_json,json=objectJSON,proto3" json:"object_json,omitempty"`
+	// Encoded data (encode).
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Codec error.
+	// This is set when the codec returned an error.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Execution time.
+	ExecutionTime *duration.Duration `protobuf:"bytes,4,opt,name=execution_time,json=executionTime,proto3" json:"execution_time,omitempty"`
+	// Log output (e.g. console.log lines of the JavaScript codec).
+	Logs                 []string `protobuf:"bytes,5,rep,name=logs,proto3" json:"logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TestPayloadCodecResponse) Reset()         { *m = TestPayloadCodecResponse{} }
+func (m *TestPayloadCodecResponse) String() string { return proto.CompactTextString(m) }
+func (*TestPayloadCodecResponse) ProtoMessage()    {}
+func (*TestPayloadCodecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed478be08b3cbfaf, []int{10}
+}
+
+func (m *TestPayloadCodecResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TestPayloadCodecResponse.Unmarshal(m, b)
+}
+func (m *TestPayloadCodecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TestPayloadCodecResponse.Marshal(b, m, deterministic)
+}
+func (m *TestPayloadCodecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestPayloadCodecResponse.Merge(m, src)
+}
+func (m *TestPayloadCodecResponse) XXX_Size() int {
+	return xxx_messageInfo_TestPayloadCodecResponse.Size(m)
+}
+func (m *TestPayloadCodecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestPayloadCodecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TestPayloadCodecResponse proto.InternalMessageInfo
+
+func (m *TestPayloadCodecResponse) GetObjectJson() string {
+	if m != nil {
+		return m.ObjectJson
+	}
+	return ""
+}
+
+func (m *TestPayloadCodecResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *TestPayloadCodecResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *TestPayloadCodecResponse) GetExecutionTime() *duration.Duration {
+	if m != nil {
+		return m.ExecutionTime
+	}
+	return nil
+}
+
+func (m *TestPayloadCodecResponse) GetLogs() []string {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CreateDeviceProfileRequest)(nil), "api.CreateDeviceProfileRequest")
 	proto.RegisterType((*CreateDeviceProfileResponse)(nil), "api.CreateDeviceProfileResponse")
@@ -493,51 +724,78 @@ func init() {
 	proto.RegisterType((*DeviceProfileListItem)(nil), "api.DeviceProfileListItem")
 	proto.RegisterType((*ListDeviceProfileRequest)(nil), "api.ListDeviceProfileRequest")
 	proto.RegisterType((*ListDeviceProfileResponse)(nil), "api.ListDeviceProfileResponse")
+	proto.RegisterType((*TestPayloadCodecRequest)(nil), "api.TestPayloadCodecRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.TestPayloadCodecRequest.VariablesEntry")
+	proto.RegisterType((*TestPayloadCodecResponse)(nil), "api.TestPayloadCodecResponse")
 }
 
 func init() { proto.RegisterFile("deviceProfile.proto", fileDescriptor_ed478be08b3cbfaf) }
 
 var fileDescriptor_ed478be08b3cbfaf = []byte{
-	// 616 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x56, 0xe2, 0xd4, 0x52, 0xa7, 0x6a, 0x2a, 0x96, 0x52, 0x52, 0x27, 0x90, 0x62, 0x09, 0x51,
-	0x22, 0xe2, 0x48, 0xe9, 0xa9, 0xdc, 0xaa, 0x06, 0x55, 0x91, 0x38, 0x20, 0x03, 0xe2, 0x18, 0x6d,
-	0xed, 0x49, 0xb4, 0xc2, 0xf6, 0x2e, 0xf6, 0x26, 0x08, 0x50, 0x2f, 0x1c, 0x78, 0x01, 0x2e, 0x3c,
-	0x05, 0x2f, 0xc2, 0x91, 0x57, 0xe0, 0x41, 0x90, 0x77, 0xd7, 0x28, 0x3f, 0x36, 0x3f, 0x15, 0x37,
-	0x7b, 0xe7, 0x9b, 0x99, 0x6f, 0xbe, 0xf9, 0x81, 0x9b, 0x21, 0x2e, 0x58, 0x80, 0xcf, 0x52, 0x3e,
-	0x65, 0x11, 0x7a, 0x22, 0xe5, 0x92, 0x13, 0x8b, 0x0a, 0xe6, 0x74, 0x66, 0x9c, 0xcf, 0x22, 0x1c,
-	0x50, 0xc1, 0x06, 0x34, 0x49, 0xb8, 0xa4, 0x92, 0xf1, 0x24, 0xd3, 0x10, 0xa7, 0x6b, 0xac, 0xea,
-	0xef, 0x72, 0x3e, 0x1d, 0x48, 0x16, 0x63, 0x26, 0x69, 0x2c, 0x0c, 0xa0, 0xbd, 0x0e, 0xc0, 0x58,
-	0xc8, 0x77, 0xc6, 0xd8, 0x14, 0x3a, 0x9f, 0x89, 0xe6, 0xbe, 0x02, 0xe7, 0x3c, 0x45, 0x2a, 0x71,
-	0xb4, 0xcc, 0xc6, 0xc7, 0x37, 0x73, 0xcc, 0x24, 0x39, 0x85, 0xa6, 0x66, 0x39, 0x31, 0x6e, 0xad,
-	0xda, 0x51, 0xed, 0x78, 0x67, 0x48, 0x3c, 0x2a, 0x98, 0xb7, 0xea, 0xb2, 0xbb, 0x52, 0x8f, 0xdb,
-	0x87, 0x76, 0x69, 0xe0, 0x4c, 0xf0, 0x24, 0x43, 0xd2, 0x84, 0x3a, 0x0b, 0x55, 0xb4, 0x6d, 0xbf,
-	0xce, 0x42, 0xf7, 0x21, 0xdc, 0xbe, 0x40, 0x59, 0x4a, 0x62, 0x1d, 0xfa, 0xad, 0x06, 0xad, 0x4d,
-	0xac, 0x89, 0x7b, 0x7d, 0xc6, 0xe4, 0x14, 0x20, 0x50, 0x8c, 0xc3, 0x09, 0x95, 0xad, 0xba, 0x72,
-	0x73, 0x3c, 0x2d, 0xa6, 0x57, 0x88, 0xe9, 0xbd, 0x28, 0xd4, 0xf6, 0xb7, 0x0d, 0xfa, 0x2c, 0xd7,
-	0x09, 0xe6, 0x22, 0x2c, 0x5c, 0xad, 0x3f, 0xbb, 0x1a, 0xf4, 0x99, 0xcc, 0x1b, 0xf0, 0x52, 0xfd,
-	0xfc, 0xef, 0x06, 0x3c, 0x02, 0x67, 0x84, 0x11, 0x56, 0x04, 0x5e, 0x17, 0xf5, 0x53, 0x1d, 0x6e,
-	0xad, 0x00, 0x9f, 0xb2, 0x4c, 0x8e, 0x25, 0xc6, 0xeb, 0x48, 0x42, 0xa0, 0x91, 0xd0, 0x18, 0x95,
-	0x40, 0xdb, 0xbe, 0xfa, 0x26, 0x0f, 0x60, 0x8f, 0xa7, 0x33, 0x9a, 0xb0, 0xf7, 0x6a, 0x54, 0x27,
-	0x2c, 0x54, 0x22, 0x58, 0x7e, 0x73, 0xf9, 0x79, 0x3c, 0x22, 0x3d, 0xb8, 0x91, 0xa0, 0x7c, 0xcb,
-	0xd3, 0xd7, 0x93, 0x0c, 0xd3, 0x05, 0xa6, 0x39, 0xb4, 0xa1, 0xa0, 0x7b, 0xc6, 0xf0, 0x5c, 0xbd,
-	0x8f, 0x47, 0x6b, 0xfd, 0xd8, 0xba, 0x7e, 0x3f, 0xec, 0x7f, 0xe9, 0xc7, 0x97, 0x1a, 0xb4, 0xf2,
-	0xda, 0x4b, 0x55, 0xdb, 0x87, 0xad, 0x88, 0xc5, 0x4c, 0x2a, 0x39, 0x2c, 0x5f, 0xff, 0x90, 0x03,
-	0xb0, 0xf9, 0x74, 0x9a, 0xa1, 0x1e, 0x1a, 0xcb, 0x37, 0x7f, 0x7f, 0xaf, 0xca, 0x7d, 0x68, 0x52,
-	0x21, 0x22, 0x16, 0xfc, 0xc2, 0x69, 0x49, 0x76, 0x97, 0x5e, 0xc7, 0x23, 0x57, 0xc0, 0x61, 0x09,
-	0x33, 0x33, 0xf8, 0x5d, 0xd8, 0x91, 0x5c, 0xd2, 0x68, 0x12, 0xf0, 0x79, 0x52, 0x10, 0x04, 0xf5,
-	0x74, 0x9e, 0xbf, 0x90, 0x21, 0xd8, 0x29, 0x66, 0xf3, 0x28, 0x67, 0x69, 0x29, 0x3d, 0x36, 0x46,
-	0xa8, 0xe8, 0xb9, 0x6f, 0x90, 0xc3, 0xaf, 0x0d, 0xd8, 0x5f, 0x41, 0xe4, 0xcd, 0x61, 0x01, 0x92,
-	0x08, 0x6c, 0xbd, 0xdd, 0xa4, 0xab, 0xc2, 0x54, 0xdf, 0x10, 0xe7, 0xa8, 0x1a, 0xa0, 0xa9, 0xbb,
-	0xdd, 0x8f, 0xdf, 0x7f, 0x7c, 0xae, 0x1f, 0xba, 0xfb, 0xea, 0xe2, 0xe9, 0x29, 0xee, 0x17, 0x77,
-	0xea, 0x71, 0xad, 0x47, 0x10, 0xac, 0x0b, 0x94, 0xa4, 0xa3, 0x22, 0x55, 0x9c, 0x09, 0xe7, 0x4e,
-	0x85, 0xd5, 0x24, 0xb9, 0xa7, 0x92, 0xb4, 0xc9, 0x61, 0x59, 0x92, 0xc1, 0x07, 0x16, 0x5e, 0x91,
-	0x05, 0xd8, 0x7a, 0x15, 0x4d, 0x51, 0xd5, 0x7b, 0xe9, 0x1c, 0x6c, 0x0c, 0xd3, 0x93, 0xfc, 0xc8,
-	0xba, 0x27, 0x2a, 0x4b, 0xdf, 0x39, 0x2e, 0xcf, 0xb2, 0xba, 0xcb, 0x1e, 0x0b, 0xaf, 0xf2, 0xf2,
-	0x42, 0xb0, 0xf5, 0xa6, 0x9a, 0xbc, 0xd5, 0x6b, 0x5b, 0x99, 0xd7, 0x54, 0xd7, 0xfb, 0x4d, 0x75,
-	0x01, 0x34, 0xf2, 0xfe, 0x12, 0xad, 0x53, 0xd5, 0x88, 0x3b, 0x77, 0xab, 0xcc, 0x46, 0xc7, 0x8e,
-	0xca, 0x74, 0x40, 0x4a, 0x9b, 0x75, 0x69, 0x2b, 0x5e, 0x27, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0xce, 0xf1, 0xe7, 0xa5, 0xdd, 0x06, 0x00, 0x00,
+	// 1006 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdf, 0x6e, 0xdc, 0xc4,
+	0x17, 0x96, 0x77, 0xb3, 0xdb, 0xee, 0x49, 0xb2, 0xed, 0x6f, 0x7e, 0x69, 0xea, 0x75, 0xd2, 0x26,
+	0x2c, 0x42, 0x2d, 0xa1, 0xd9, 0x48, 0x29, 0x17, 0x24, 0x42, 0x82, 0x28, 0x1b, 0x55, 0x41, 0x14,
+	0x22, 0x6f, 0x69, 0x2f, 0xad, 0x89, 0x7d, 0x36, 0x9a, 0xd6, 0xeb, 0x31, 0xe3, 0xd9, 0x2d, 0x01,
+	0xf5, 0x06, 0x24, 0x5e, 0x80, 0x1b, 0x1e, 0x84, 0x3b, 0x1e, 0x81, 0x4b, 0x5e, 0xa1, 0x0f, 0x82,
+	0xe6, 0x8f, 0x53, 0x7b, 0xd7, 0x86, 0x52, 0x71, 0xe7, 0x99, 0xf3, 0x9d, 0x39, 0xe7, 0x7c, 0xdf,
+	0x99, 0x39, 0x86, 0xff, 0x47, 0x38, 0x63, 0x21, 0x9e, 0x09, 0x3e, 0x66, 0x31, 0x0e, 0x52, 0xc1,
+	0x25, 0x27, 0x4d, 0x9a, 0x32, 0x6f, 0xf3, 0x82, 0xf3, 0x8b, 0x18, 0xf7, 0x68, 0xca, 0xf6, 0x68,
+	0x92, 0x70, 0x49, 0x25, 0xe3, 0x49, 0x66, 0x20, 0xde, 0x96, 0xb5, 0xea, 0xd5, 0xf9, 0x74, 0xbc,
+	0x27, 0xd9, 0x04, 0x33, 0x49, 0x27, 0xa9, 0x05, 0xdc, 0x9d, 0x07, 0x44, 0x53, 0xa1, 0x4f, 0xb0,
+	0xf6, 0x8d, 0x79, 0x3b, 0x4e, 0x52, 0x79, 0x69, 0x8d, 0xdd, 0xd4, 0xe4, 0x63, 0xa3, 0xf5, 0x9f,
+	0x81, 0x77, 0x2c, 0x90, 0x4a, 0x1c, 0x16, 0xb3, 0xf5, 0xf1, 0xdb, 0x29, 0x66, 0x92, 0x1c, 0x40,
+	0xd7, 0x54, 0x11, 0x58, 0x37, 0xd7, 0xd9, 0x76, 0xee, 0x2f, 0xef, 0x93, 0x01, 0x4d, 0xd9, 0xa0,
+	0xec, 0xb2, 0x5a, 0xaa, 0xb7, 0xbf, 0x0b, 0x1b, 0x95, 0x07, 0x67, 0x29, 0x4f, 0x32, 0x24, 0x5d,
+	0x68, 0xb0, 0x48, 0x9f, 0xd6, 0xf1, 0x1b, 0x2c, 0xea, 0x7f, 0x08, 0xb7, 0x1f, 0xa1, 0xac, 0x4c,
+	0x62, 0x1e, 0xfa, 0x87, 0x03, 0xee, 0x22, 0xd6, 0x9e, 0xfb, 0xee, 0x19, 0x93, 0x03, 0x80, 0x50,
+	0x67, 0x1c, 0x05, 0x54, 0xba, 0x0d, 0xed, 0xe6, 0x0d, 0x0c, 0x99, 0x83, 0x9c, 0xcc, 0xc1, 0x93,
+	0x5c, 0x0d, 0xbf, 0x63, 0xd1, 0x47, 0x8a, 0x27, 0x98, 0xa6, 0x51, 0xee, 0xda, 0xfc, 0x67, 0x57,
+	0x8b, 0x3e, 0x92, 0x4a, 0x80, 0x6f, 0xf4, 0xe2, 0xbf, 0x16, 0xe0, 0x01, 0x78, 0x43, 0x8c, 0x51,
+	0xe2, 0x5b, 0x91, 0xfa, 0x73, 0x03, 0x6e, 0x95, 0x80, 0x5f, 0xb2, 0x4c, 0x9e, 0x4a, 0x9c, 0xcc,
+	0x23, 0x09, 0x81, 0xa5, 0x84, 0x4e, 0x50, 0x13, 0xd4, 0xf1, 0xf5, 0x37, 0xb9, 0x07, 0x37, 0xb8,
+	0xb8, 0xa0, 0x09, 0xfb, 0x5e, 0x37, 0x62, 0xc0, 0x22, 0x4d, 0x42, 0xd3, 0xef, 0x16, 0xb7, 0x4f,
+	0x87, 0x64, 0x07, 0xfe, 0x97, 0xa0, 0x7c, 0xc9, 0xc5, 0x8b, 0x20, 0x43, 0x31, 0x43, 0xa1, 0xa0,
+	0x4b, 0x1a, 0x7a, 0xc3, 0x1a, 0x46, 0x7a, 0xff, 0x74, 0x38, 0xa7, 0x47, 0xeb, 0xdd, 0xf5, 0x68,
+	0xff, 0x1b, 0x3d, 0x7e, 0x75, 0xc0, 0x55, 0xb5, 0x57, 0xb2, 0xb6, 0x06, 0xad, 0x98, 0x4d, 0x98,
+	0xd4, 0x74, 0x34, 0x7d, 0xb3, 0x20, 0xeb, 0xd0, 0xe6, 0xe3, 0x71, 0x86, 0xa6, 0x69, 0x9a, 0xbe,
+	0x5d, 0xbd, 0x3d, 0x2b, 0x1f, 0x40, 0x97, 0xa6, 0x69, 0xcc, 0xc2, 0x2b, 0x9c, 0xa1, 0x64, 0xb5,
+	0xb0, 0x7b, 0x3a, 0xec, 0xa7, 0xd0, 0xab, 0xc8, 0xcc, 0x36, 0xfe, 0x16, 0x2c, 0x4b, 0x2e, 0x69,
+	0x1c, 0x84, 0x7c, 0x9a, 0xe4, 0x09, 0x82, 0xde, 0x3a, 0x56, 0x3b, 0x64, 0x1f, 0xda, 0x02, 0xb3,
+	0x69, 0xac, 0xb2, 0x6c, 0x6a, 0x3e, 0x16, 0x5a, 0x28, 0xd7, 0xdc, 0xb7, 0xc8, 0xfe, 0xeb, 0x25,
+	0xb8, 0xfd, 0x04, 0x33, 0x79, 0x46, 0x2f, 0x63, 0x4e, 0xa3, 0x63, 0x1e, 0x61, 0x98, 0x73, 0x51,
+	0x51, 0x9d, 0x53, 0x59, 0xdd, 0xfb, 0xb0, 0x9a, 0x1a, 0xff, 0x20, 0x54, 0x07, 0xd8, 0xce, 0x59,
+	0x49, 0x0b, 0x87, 0x92, 0x8f, 0x61, 0x3d, 0x07, 0x61, 0xa2, 0x60, 0x22, 0xc8, 0x42, 0xc1, 0x52,
+	0x73, 0x9b, 0x3a, 0xfe, 0x9a, 0xb5, 0x9e, 0x18, 0xe3, 0x48, 0xdb, 0x8a, 0x5e, 0x11, 0x96, 0xbc,
+	0x96, 0x4a, 0x5e, 0x43, 0x2c, 0x7a, 0xdd, 0x82, 0xf6, 0x38, 0x48, 0xb9, 0x30, 0x4d, 0xb5, 0xea,
+	0xb7, 0xc6, 0x67, 0x5c, 0x48, 0xd5, 0xd8, 0x11, 0x95, 0x54, 0xb7, 0xcb, 0x8a, 0xaf, 0xbf, 0x15,
+	0xab, 0xfc, 0xfc, 0x39, 0x86, 0x32, 0x78, 0x9e, 0xf1, 0xc4, 0xbd, 0xa6, 0x4f, 0x05, 0xb3, 0xf5,
+	0xc5, 0xe8, 0xeb, 0xaf, 0xc8, 0x67, 0xb0, 0x59, 0x2a, 0x2e, 0x88, 0xd0, 0x64, 0xc0, 0x45, 0xa0,
+	0x3a, 0xe2, 0xba, 0x3e, 0xac, 0x57, 0xac, 0x75, 0x78, 0x85, 0x18, 0xa1, 0x24, 0xa7, 0xd0, 0x99,
+	0x51, 0xc1, 0xe8, 0x79, 0x8c, 0x99, 0xdb, 0xd1, 0xca, 0x7c, 0xa4, 0x95, 0xa9, 0xe1, 0x7d, 0xf0,
+	0x34, 0x47, 0x9f, 0x24, 0x52, 0x5c, 0xfa, 0x6f, 0xbc, 0xc9, 0x01, 0xf4, 0xca, 0xb9, 0xbc, 0xa4,
+	0xd9, 0x24, 0x98, 0xf0, 0x68, 0x1a, 0xa3, 0x0b, 0x3a, 0x91, 0xf5, 0x62, 0x22, 0xcf, 0x8e, 0x46,
+	0x8f, 0x1f, 0x6b, 0x2b, 0xe9, 0xc1, 0x75, 0xe3, 0xc2, 0x22, 0x77, 0x59, 0xab, 0x78, 0x4d, 0xaf,
+	0x8d, 0x7c, 0xc6, 0x34, 0x43, 0x91, 0x31, 0x9e, 0xb8, 0x2b, 0x9a, 0xb4, 0x15, 0xbd, 0xf9, 0xd4,
+	0xec, 0x79, 0x9f, 0x42, 0xb7, 0x9c, 0x17, 0xb9, 0x09, 0xcd, 0x17, 0x78, 0x69, 0xdf, 0x0d, 0xf5,
+	0xa9, 0x2e, 0xcf, 0x8c, 0xc6, 0xd3, 0xfc, 0xe5, 0x30, 0x8b, 0xc3, 0xc6, 0x27, 0x4e, 0xff, 0x77,
+	0x07, 0xdc, 0xc5, 0x72, 0xdf, 0x34, 0x76, 0x51, 0x02, 0x67, 0x41, 0x82, 0x5c, 0xb7, 0x46, 0x41,
+	0xb7, 0x35, 0x68, 0xa1, 0x10, 0x5c, 0xd8, 0xee, 0x31, 0x0b, 0xf2, 0x39, 0x74, 0xf1, 0x3b, 0x0c,
+	0xa7, 0xba, 0x5f, 0xd5, 0x58, 0xd5, 0x6d, 0xb2, 0xbc, 0xdf, 0x5b, 0x78, 0x1a, 0x86, 0x76, 0xa4,
+	0xfa, 0xab, 0x57, 0x0e, 0xea, 0xb5, 0x50, 0xb1, 0x62, 0x7e, 0x91, 0xb9, 0xad, 0xed, 0xa6, 0x7a,
+	0xfc, 0xd4, 0xf7, 0xfe, 0x6f, 0x2d, 0x58, 0x2b, 0x5d, 0x23, 0xf5, 0x82, 0xb1, 0x10, 0x49, 0x0c,
+	0x6d, 0x33, 0x02, 0xc9, 0x96, 0x56, 0xb4, 0x7e, 0xd0, 0x7a, 0xdb, 0xf5, 0x00, 0x43, 0x43, 0x7f,
+	0xeb, 0xc7, 0x3f, 0x5f, 0xff, 0xd2, 0xe8, 0xf5, 0xd7, 0xf4, 0x6f, 0x83, 0x79, 0xea, 0x77, 0xf3,
+	0x61, 0x7e, 0xe8, 0xec, 0x10, 0x84, 0xe6, 0x23, 0x94, 0x64, 0x53, 0x9f, 0x54, 0x33, 0x4b, 0xbd,
+	0x3b, 0x35, 0x56, 0x1b, 0xe4, 0x3d, 0x1d, 0x64, 0x83, 0xf4, 0xaa, 0x82, 0xec, 0xfd, 0xc0, 0xa2,
+	0x57, 0x64, 0x06, 0x6d, 0x33, 0xaf, 0x6c, 0x51, 0xf5, 0xc3, 0xcb, 0x5b, 0x5f, 0xa0, 0xf5, 0x44,
+	0xfd, 0x89, 0xf4, 0x1f, 0xea, 0x28, 0xbb, 0xde, 0xfd, 0xea, 0x28, 0xe5, 0x81, 0x37, 0x60, 0xd1,
+	0x2b, 0x55, 0x5e, 0x04, 0x6d, 0x33, 0xce, 0x6c, 0xdc, 0xfa, 0xd9, 0x56, 0x1b, 0xd7, 0x56, 0xb7,
+	0xf3, 0x37, 0xd5, 0x85, 0xb0, 0xa4, 0x1e, 0x41, 0x62, 0x78, 0xaa, 0x9b, 0x03, 0xde, 0xdd, 0x3a,
+	0xb3, 0xe5, 0x71, 0x53, 0x47, 0x5a, 0x27, 0x95, 0x62, 0x91, 0x9f, 0x1c, 0xb8, 0x39, 0xdf, 0xee,
+	0x56, 0xb7, 0x9a, 0x4b, 0xef, 0xdd, 0xa9, 0xb1, 0xda, 0x78, 0xfb, 0x3a, 0xde, 0x83, 0xfe, 0xbd,
+	0xca, 0xca, 0x24, 0x66, 0x72, 0xd7, 0x5e, 0xfc, 0x5d, 0x7d, 0x6d, 0x0f, 0x9d, 0x9d, 0xf3, 0xb6,
+	0x66, 0xe7, 0xe1, 0x5f, 0x03, 0x00, 0xc1, 0x05, 0x3a, 0x57, 0xa8, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -562,6 +820,9 @@ type DeviceProfileServiceClient interface {
 	Delete(ctx context.Context, in *DeleteDeviceProfileRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// List lists the available device-profiles.
 	List(ctx context.Context, in *ListDeviceProfileRequest, opts ...grpc.CallOption) (*ListDeviceProfileResponse, error)
+	// TestPayloadCodec runs the given payload codec against the given
+	// payload (decode) or object (encode) without storing anything.
+	TestPayloadCodec(ctx context.Context, in *TestPayloadCodecRequest, opts ...grpc.CallOption) (*TestPayloadCodecResponse, error)
 }
 
 type deviceProfileServiceClient struct {
@@ -617,6 +878,15 @@ func (c *deviceProfileServiceClient) List(ctx context.Context, in *ListDevicePro
 	return out, nil
 }
 
+func (c *deviceProfileServiceClient) TestPayloadCodec(ctx context.Context, in *TestPayloadCodecRequest, opts ...grpc.CallOption) (*TestPayloadCodecResponse, error) {
+	out := new(TestPayloadCodecResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceProfileService/TestPayloadCodec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeviceProfileServiceServer is the server API for DeviceProfileService service.
 type DeviceProfileServiceServer interface {
 	// Create creates the given device-profile.
@@ -629,6 +899,9 @@ type DeviceProfileServiceServer interface {
 	Delete(context.Context, *DeleteDeviceProfileRequest) (*empty.Empty, error)
 	// List lists the available device-profiles.
 	List(context.Context, *ListDeviceProfileRequest) (*ListDeviceProfileResponse, error)
+	// TestPayloadCodec runs the given payload codec against the given
+	// payload (decode) or object (encode) without storing anything.
+	TestPayloadCodec(context.Context, *TestPayloadCodecRequest) (*TestPayloadCodecResponse, error)
 }
 
 func RegisterDeviceProfileServiceServer(s *grpc.Server, srv DeviceProfileServiceServer) {
@@ -725,6 +998,24 @@ func _DeviceProfileService_List_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceProfileService_TestPayloadCodec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestPayloadCodecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceProfileServiceServer).TestPayloadCodec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceProfileService/TestPayloadCodec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceProfileServiceServer).TestPayloadCodec(ctx, req.(*TestPayloadCodecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DeviceProfileService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.DeviceProfileService",
 	HandlerType: (*DeviceProfileServiceServer)(nil),
@@ -749,6 +1040,10 @@ var _DeviceProfileService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _DeviceProfileService_List_Handler,
 		},
+		{
+			MethodName: "TestPayloadCodec",
+			Handler:    _DeviceProfileService_TestPayloadCodec_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "deviceProfile.proto",
@@ -21,8 +21,13 @@ protoc -I. -I${LS_PATH} -I${GRPC_GW_PATH} --go_out=plugins=grpc:. \
     deviceProfile.proto \
     gatewayProfile.proto \
     multicastGroup.proto \
+    codec.proto \
     internal.proto
 
+# generate the integration event messages
+protoc -I. --go_out=paths=source_relative:. \
+    integration/integration.proto
+
 # generate the JSON interface code
 protoc -I. -I${LS_PATH} -I${GRPC_GW_PATH} --grpc-gateway_out=logtostderr=true:. \
     device.proto \
@@ -38,6 +43,7 @@ protoc -I. -I${LS_PATH} -I${GRPC_GW_PATH} --grpc-gateway_out=logtostderr=true:.
     deviceProfile.proto \
     gatewayProfile.proto \
     multicastGroup.proto \
+    codec.proto \
     internal.proto
 
 # generate the swagger definitions
@@ -55,6 +61,7 @@ protoc -I. -I${LS_PATH} -I${GRPC_GW_PATH} --swagger_out=json_names_for_fields=tr
     deviceProfile.proto \
     gatewayProfile.proto \
     multicastGroup.proto \
+    codec.proto \
     internal.proto
 
 # merge the swagger code into one file
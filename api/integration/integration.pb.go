@@ -0,0 +1,1226 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: integration/integration.proto
+
+package integration
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Location details.
+type Location struct {
+	// Latitude.
+	Latitude float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude.
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Altitude.
+	Altitude             float64  `protobuf:"fixed64,3,opt,name=altitude,proto3" json:"altitude,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Location) Reset()         { *m = Location{} }
+func (m *Location) String() string { return proto.CompactTextString(m) }
+func (*Location) ProtoMessage()    {}
+func (*Location) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b63cd9a4f1e2667, []int{0}
+}
+
+func (m *Location) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Location.Unmarshal(m, b)
+}
+func (m *Location) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Location.Marshal(b, m, deterministic)
+}
+func (m *Location) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Location.Merge(m, src)
+}
+func (m *Location) XXX_Size() int {
+	return xxx_messageInfo_Location.Size(m)
+}
+func (m *Location) XXX_DiscardUnknown() {
+	xxx_messageInfo_Location.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Location proto.InternalMessageInfo
+
+func (m *Location) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *Location) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *Location) GetAltitude() float64 {
+	if m != nil {
+		return m.Altitude
+	}
+	return 0
+}
+
+// RXInfo contains the RX information.
+type RXInfo struct {
+	// ID of the gateway.
+	GatewayId []byte `protobuf:"bytes,1,opt,name=gateway_id,json=gatewayID,proto3" json:"gateway_id,omitempty"`
+	// Name of the gateway.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Time when the frame was received by the gateway.
+	Time *timestamp.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// RSSI.
+	Rssi int32 `protobuf:"varint,4,opt,name=rssi,proto3" json:"rssi,omitempty"`
+	// LoRa SNR.
+	LoraSnr float64 `protobuf:"fixed64,5,opt,name=lora_snr,json=loRaSNR,proto3" json:"lora_snr,omitempty"`
+	// Location of the gateway.
+	Location *Location `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	// Gateway internal timestamp (32 bit counter, in microseconds).
+	Timestamp uint32 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Fine-timestamp type (ENCRYPTED or PLAIN, empty when not available).
+	FineTimestampType string `protobuf:"bytes,8,opt,name=fine_timestamp_type,json=fineTimestampType,proto3" json:"fine_timestamp_type,omitempty"`
+	// Fine-timestamp (when the fine-timestamp type is PLAIN).
+	FineTimestamp *timestamp.Timestamp `protobuf:"bytes,9,opt,name=fine_timestamp,json=fineTimestamp,proto3" json:"fine_timestamp,omitempty"`
+	// Encrypted fine-timestamp (when the fine-timestamp type is ENCRYPTED).
+	EncryptedFineTimestamp *EncryptedFineTimestamp `protobuf:"bytes,10,opt,name=encrypted_fine_timestamp,json=encryptedFineTimestamp,proto3" json:"encrypted_fine_timestamp,omitempty"`
+	// Channel.
+	Channel uint32 `protobuf:"varint,11,opt,name=channel,proto3" json:"channel,omitempty"`
+	// RF chain.
+	RfChain uint32 `protobuf:"varint,12,opt,name=rf_chain,json=rfChain,proto3" json:"rf_chain,omitempty"`
+	// Board.
+	Board uint32 `protobuf:"varint,13,opt,name=board,proto3" json:"board,omitempty"`
+	// Antenna.
+	Antenna              uint32   `protobuf:"varint,14,opt,name=antenna,proto3" json:"antenna,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RXInfo) Reset()         { *m = RXInfo{} }
+func (m *RXInfo) String() string { return proto.CompactTextString(m) }
+func (*RXInfo) ProtoMessage()    {}
+func (*RXInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b63cd9a4f1e2667, []int{1}
+}
+
+func (m *RXInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RXInfo.Unmarshal(m, b)
+}
+func (m *RXInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RXInfo.Marshal(b, m, deterministic)
+}
+func (m *RXInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RXInfo.Merge(m, src)
+}
+func (m *RXInfo) XXX_Size() int {
+	return xxx_messageInfo_RXInfo.Size(m)
+}
+func (m *RXInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RXInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RXInfo proto.InternalMessageInfo
+
+func (m *RXInfo) GetGatewayId() []byte {
+	if m != nil {
+		return m.GatewayId
+	}
+	return nil
+}
+
+func (m *RXInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RXInfo) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *RXInfo) GetRssi() int32 {
+	if m != nil {
+		return m.Rssi
+	}
+	return 0
+}
+
+func (m *RXInfo) GetLoraSnr() float64 {
+	if m != nil {
+		return m.LoraSnr
+	}
+	return 0
+}
+
+func (m *RXInfo) GetLocation() *Location {
+	if m != nil {
+		return m.Location
+	}
+	return nil
+}
+
+func (m *RXInfo) GetTimestamp() uint32 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *RXInfo) GetFineTimestampType() string {
+	if m != nil {
+		return m.FineTimestampType
+	}
+	return ""
+}
+
+func (m *RXInfo) GetFineTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.FineTimestamp
+	}
+	return nil
+}
+
+func (m *RXInfo) GetEncryptedFineTimestamp() *EncryptedFineTimestamp {
+	if m != nil {
+		return m.EncryptedFineTimestamp
+	}
+	return nil
+}
+
+func (m *RXInfo) GetChannel() uint32 {
+	if m != nil {
+		return m.Channel
+	}
+	return 0
+}
+
+func (m *RXInfo) GetRfChain() uint32 {
+	if m != nil {
+		return m.RfChain
+	}
+	return 0
+}
+
+func (m *RXInfo) GetBoard() uint32 {
+	if m != nil {
+		return m.Board
+	}
+	return 0
+}
+
+func (m *RXInfo) GetAntenna() uint32 {
+	if m != nil {
+		return m.Antenna
+	}
+	return 0
+}
+
+// EncryptedFineTimestamp contains the encrypted fine-timestamp.
+type EncryptedFineTimestamp struct {
+	// AES key index used for encrypting the fine-timestamp.
+	AesKeyIndex uint32 `protobuf:"varint,1,opt,name=aes_key_index,json=aesKeyIndex,proto3" json:"aes_key_index,omitempty"`
+	// Encrypted 'main' fine-timestamp (ns precision part of the timestamp).
+	EncryptedNs []byte `protobuf:"bytes,2,opt,name=encrypted_ns,json=encryptedNS,proto3" json:"encrypted_ns,omitempty"`
+	// FPGA ID.
+	FpgaId               []byte   `protobuf:"bytes,3,opt,name=fpga_id,json=fpgaID,proto3" json:"fpga_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EncryptedFineTimestamp) Reset()         { *m = EncryptedFineTimestamp{} }
+func (m *EncryptedFineTimestamp) String() string { return proto.CompactTextString(m) }
+func (*EncryptedFineTimestamp) ProtoMessage()    {}
+func (*EncryptedFineTimestamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b63cd9a4f1e2667, []int{2}
+}
+
+func (m *EncryptedFineTimestamp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EncryptedFineTimestamp.Unmarshal(m, b)
+}
+func (m *EncryptedFineTimestamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EncryptedFineTimestamp.Marshal(b, m, deterministic)
+}
+func (m *EncryptedFineTimestamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptedFineTimestamp.Merge(m, src)
+}
+func (m *EncryptedFineTimestamp) XXX_Size() int {
+	return xxx_messageInfo_EncryptedFineTimestamp.Size(m)
+}
+func (m *EncryptedFineTimestamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptedFineTimestamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptedFineTimestamp proto.InternalMessageInfo
+
+func (m *EncryptedFineTimestamp) GetAesKeyIndex() uint32 {
+	if m != nil {
+		return m.AesKeyIndex
+	}
+	return 0
+}
+
+func (m *EncryptedFineTimestamp) GetEncryptedNs() []byte {
+	if m != nil {
+		return m.EncryptedNs
+	}
+	return nil
+}
+
+func (m *EncryptedFineTimestamp) GetFpgaId() []byte {
+	if m != nil {
+		return m.FpgaId
+	}
+	return nil
+}
+
+// LoRaModulationInfo contains the LoRa modulation information.
+type LoRaModulationInfo struct {
+	// Bandwidth (kHz).
+	Bandwidth uint32 `protobuf:"varint,1,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	// Spreading-factor.
+	SpreadingFactor uint32 `protobuf:"varint,2,opt,name=spreading_factor,json=spreadingFactor,proto3" json:"spreading_factor,omitempty"`
+	// Code-rate.
+	CodeRate string `protobuf:"bytes,3,opt,name=code_rate,json=codeRate,proto3" json:"code_rate,omitempty"`
+	// Polarization inversion.
+	PolarizationInversion bool     `protobuf:"varint,4,opt,name=polarization_inversion,json=polarizationInversion,proto3" json:"polarization_inversion,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *LoRaModulationInfo) Reset()         { *m = LoRaModulationInfo{} }
+func (m *LoRaModulationInfo) String() string { return proto.CompactTextString(m) }
+func (*LoRaModulationInfo) ProtoMessage()    {}
+func (*LoRaModulationInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b63cd9a4f1e2667, []int{3}
+}
+
+func (m *LoRaModulationInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoRaModulationInfo.Unmarshal(m, b)
+}
+func (m *LoRaModulationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoRaModulationInfo.Marshal(b, m, deterministic)
+}
+func (m *LoRaModulationInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoRaModulationInfo.Merge(m, src)
+}
+func (m *LoRaModulationInfo) XXX_Size() int {
+	return xxx_messageInfo_LoRaModulationInfo.Size(m)
+}
+func (m *LoRaModulationInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoRaModulationInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoRaModulationInfo proto.InternalMessageInfo
+
+func (m *LoRaModulationInfo) GetBandwidth() uint32 {
+	if m != nil {
+		return m.Bandwidth
+	}
+	return 0
+}
+
+func (m *LoRaModulationInfo) GetSpreadingFactor() uint32 {
+	if m != nil {
+		return m.SpreadingFactor
+	}
+	return 0
+}
+
+func (m *LoRaModulationInfo) GetCodeRate() string {
+	if m != nil {
+		return m.CodeRate
+	}
+	return ""
+}
+
+func (m *LoRaModulationInfo) GetPolarizationInversion() bool {
+	if m != nil {
+		return m.PolarizationInversion
+	}
+	return false
+}
+
+// FSKModulationInfo contains the FSK modulation information.
+type FSKModulationInfo struct {
+	// Bandwidth (kHz).
+	Bandwidth uint32 `protobuf:"varint,1,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	// Bitrate.
+	Bitrate              uint32   `protobuf:"varint,2,opt,name=bitrate,proto3" json:"bitrate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FSKModulationInfo) Reset()         { *m = FSKModulationInfo{} }
+func (m *FSKModulationInfo) String() string { return proto.CompactTextString(m) }
+func (*FSKModulationInfo) ProtoMessage()    {}
+func (*FSKModulationInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b63cd9a4f1e2667, []int{4}
+}
+
+func (m *FSKModulationInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FSKModulationInfo.Unmarshal(m, b)
+}
+func (m *FSKModulationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FSKModulationInfo.Marshal(b, m, deterministic)
+}
+func (m *FSKModulationInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FSKModulationInfo.Merge(m, src)
+}
+func (m *FSKModulationInfo) XXX_Size() int {
+	return xxx_messageInfo_FSKModulationInfo.Size(m)
+}
+func (m *FSKModulationInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_FSKModulationInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FSKModulationInfo proto.InternalMessageInfo
+
+func (m *FSKModulationInfo) GetBandwidth() uint32 {
+	if m != nil {
+		return m.Bandwidth
+	}
+	return 0
+}
+
+func (m *FSKModulationInfo) GetBitrate() uint32 {
+	if m != nil {
+		return m.Bitrate
+	}
+	return 0
+}
+
+// TXInfo contains the TX information.
+type TXInfo struct {
+	// Frequency (Hz).
+	Frequency uint32 `protobuf:"varint,1,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	// Data-rate.
+	Dr uint32 `protobuf:"varint,2,opt,name=dr,proto3" json:"dr,omitempty"`
+	// Modulation (LORA or FSK).
+	Modulation string `protobuf:"bytes,3,opt,name=modulation,proto3" json:"modulation,omitempty"`
+	// Types that are valid to be assigned to ModulationInfo:
+	//	*TXInfo_LoraModulationInfo
+	//	*TXInfo_FskModulationInfo
+	ModulationInfo       isTXInfo_ModulationInfo `protobuf_oneof:"modulation_info"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *TXInfo) Reset()         { *m = TXInfo{} }
+func (m *TXInfo) String() string { return proto.CompactTextString(m) }
+func (*TXInfo) ProtoMessage()    {}
+func (*TXInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b63cd9a4f1e2667, []int{5}
+}
+
+func (m *TXInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TXInfo.Unmarshal(m, b)
+}
+func (m *TXInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TXInfo.Marshal(b, m, deterministic)
+}
+func (m *TXInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TXInfo.Merge(m, src)
+}
+func (m *TXInfo) XXX_Size() int {
+	return xxx_messageInfo_TXInfo.Size(m)
+}
+func (m *TXInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TXInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TXInfo proto.InternalMessageInfo
+
+func (m *TXInfo) GetFrequency() uint32 {
+	if m != nil {
+		return m.Frequency
+	}
+	return 0
+}
+
+func (m *TXInfo) GetDr() uint32 {
+	if m != nil {
+		return m.Dr
+	}
+	return 0
+}
+
+func (m *TXInfo) GetModulation() string {
+	if m != nil {
+		return m.Modulation
+	}
+	return ""
+}
+
+type isTXInfo_ModulationInfo interface {
+	isTXInfo_ModulationInfo()
+}
+
+type TXInfo_LoraModulationInfo struct {
+	LoraModulationInfo *LoRaModulationInfo `protobuf:"bytes,4,opt,name=lora_modulation_info,json=loRaModulationInfo,proto3,oneof"`
+}
+
+type TXInfo_FskModulationInfo struct {
+	FskModulationInfo *FSKModulationInfo `protobuf:"bytes,5,opt,name=fsk_modulation_info,json=fskModulationInfo,proto3,oneof"`
+}
+
+func (*TXInfo_LoraModulationInfo) isTXInfo_ModulationInfo() {}
+
+func (*TXInfo_FskModulationInfo) isTXInfo_ModulationInfo() {}
+
+func (m *TXInfo) GetModulationInfo() isTXInfo_ModulationInfo {
+	if m != nil {
+		return m.ModulationInfo
+	}
+	return nil
+}
+
+func (m *TXInfo) GetLoraModulationInfo() *LoRaModulationInfo {
+	if x, ok := m.GetModulationInfo().(*TXInfo_LoraModulationInfo); ok {
+		return x.LoraModulationInfo
+	}
+	return nil
+}
+
+func (m *TXInfo) GetFskModulationInfo() *FSKModulationInfo {
+	if x, ok := m.GetModulationInfo().(*TXInfo_FskModulationInfo); ok {
+		return x.FskModulationInfo
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*TXInfo) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*TXInfo_LoraModulationInfo)(nil),
+		(*TXInfo_FskModulationInfo)(nil),
+	}
+}
+
+// UplinkEvent is the message sent on a received uplink.
+type UplinkEvent struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Application name.
+	ApplicationName string `protobuf:"bytes,2,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	// Device name.
+	DeviceName string `protobuf:"bytes,3,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	// Device EUI.
+	DevEui []byte `protobuf:"bytes,4,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// RX information.
+	RxInfo []*RXInfo `protobuf:"bytes,5,rep,name=rx_info,json=rxInfo,proto3" json:"rx_info,omitempty"`
+	// TX information.
+	TxInfo *TXInfo `protobuf:"bytes,6,opt,name=tx_info,json=txInfo,proto3" json:"tx_info,omitempty"`
+	// ADR enabled.
+	Adr bool `protobuf:"varint,7,opt,name=adr,proto3" json:"adr,omitempty"`
+	// Frame counter.
+	FCnt uint32 `protobuf:"varint,8,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	// Frame port.
+	FPort uint32 `protobuf:"varint,9,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// Raw FRMPayload data.
+	Data []byte `protobuf:"bytes,10,opt,name=data,proto3" json:"data,omitempty"`
+	// Decoded object (JSON encoded).
+	// This is only set when a payload codec has been configured.
+	ObjectJson string `protobuf:"bytes,11,opt,name=object_json,json=objectJSON,proto3" json:"object_json,omitempty"`
+	// Device address.
+	DevAddr []byte `protobuf:"bytes,12,opt,name=dev_addr,json=devAddr,proto3" json:"dev_addr,omitempty"`
+	// Uplink ID (UUID, generated by LoRa App Server).
+	UplinkId []byte `protobuf:"bytes,13,opt,name=uplink_id,json=uplinkID,proto3" json:"uplink_id,omitempty"`
+	// Time when the uplink was received by LoRa App Server.
+	ReceivedAt           *timestamp.Timestamp `protobuf:"bytes,14,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UplinkEvent) Reset()         { *m = UplinkEvent{} }
+func (m *UplinkEvent) String() string { return proto.CompactTextString(m) }
+func (*UplinkEvent) ProtoMessage()    {}
+func (*UplinkEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b63cd9a4f1e2667, []int{6}
+}
+
+func (m *UplinkEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UplinkEvent.Unmarshal(m, b)
+}
+func (m *UplinkEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UplinkEvent.Marshal(b, m, deterministic)
+}
+func (m *UplinkEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UplinkEvent.Merge(m, src)
+}
+func (m *UplinkEvent) XXX_Size() int {
+	return xxx_messageInfo_UplinkEvent.Size(m)
+}
+func (m *UplinkEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_UplinkEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UplinkEvent proto.InternalMessageInfo
+
+func (m *UplinkEvent) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *UplinkEvent) GetApplicationName() string {
+	if m != nil {
+		return m.ApplicationName
+	}
+	return ""
+}
+
+func (m *UplinkEvent) GetDeviceName() string {
+	if m != nil {
+		return m.DeviceName
+	}
+	return ""
+}
+
+func (m *UplinkEvent) GetDevEui() []byte {
+	if m != nil {
+		return m.DevEui
+	}
+	return nil
+}
+
+func (m *UplinkEvent) GetRxInfo() []*RXInfo {
+	if m != nil {
+		return m.RxInfo
+	}
+	return nil
+}
+
+func (m *UplinkEvent) GetTxInfo() *TXInfo {
+	if m != nil {
+		return m.TxInfo
+	}
+	return nil
+}
+
+func (m *UplinkEvent) GetAdr() bool {
+	if m != nil {
+		return m.Adr
+	}
+	return false
+}
+
+func (m *UplinkEvent) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+func (m *UplinkEvent) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *UplinkEvent) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *UplinkEvent) GetObjectJson() string {
+	if m != nil {
+		return m.ObjectJson
+	}
+	return ""
+}
+
+func (m *UplinkEvent) GetDevAddr() []byte {
+	if m != nil {
+		return m.DevAddr
+	}
+	return nil
+}
+
+func (m *UplinkEvent) GetUplinkId() []byte {
+	if m != nil {
+		return m.UplinkId
+	}
+	return nil
+}
+
+func (m *UplinkEvent) GetReceivedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReceivedAt
+	}
+	return nil
+}
+
+// JoinEvent is the message sent when a device joined the network.
+type JoinEvent struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Application name.
+	ApplicationName string `protobuf:"bytes,2,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	// Device name.
+	DeviceName string `protobuf:"bytes,3,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	// Device EUI.
+	DevEui []byte `protobuf:"bytes,4,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Device address.
+	DevAddr              []byte   `protobuf:"bytes,5,opt,name=dev_addr,json=devAddr,proto3" json:"dev_addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JoinEvent) Reset()         { *m = JoinEvent{} }
+func (m *JoinEvent) String() string { return proto.CompactTextString(m) }
+func (*JoinEvent) ProtoMessage()    {}
+func (*JoinEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b63cd9a4f1e2667, []int{7}
+}
+
+func (m *JoinEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JoinEvent.Unmarshal(m, b)
+}
+func (m *JoinEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JoinEvent.Marshal(b, m, deterministic)
+}
+func (m *JoinEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinEvent.Merge(m, src)
+}
+func (m *JoinEvent) XXX_Size() int {
+	return xxx_messageInfo_JoinEvent.Size(m)
+}
+func (m *JoinEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinEvent proto.InternalMessageInfo
+
+func (m *JoinEvent) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *JoinEvent) GetApplicationName() string {
+	if m != nil {
+		return m.ApplicationName
+	}
+	return ""
+}
+
+func (m *JoinEvent) GetDeviceName() string {
+	if m != nil {
+		return m.DeviceName
+	}
+	return ""
+}
+
+func (m *JoinEvent) GetDevEui() []byte {
+	if m != nil {
+		return m.DevEui
+	}
+	return nil
+}
+
+func (m *JoinEvent) GetDevAddr() []byte {
+	if m != nil {
+		return m.DevAddr
+	}
+	return nil
+}
+
+// AckEvent is the message sent when a confirmed downlink was (not)
+// acknowledged.
+type AckEvent struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Application name.
+	ApplicationName string `protobuf:"bytes,2,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	// Device name.
+	DeviceName string `protobuf:"bytes,3,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	// Device EUI.
+	DevEui []byte `protobuf:"bytes,4,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// The frame was acknowledged.
+	Acknowledged bool `protobuf:"varint,5,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	// Downlink frame counter to which the acknowledgement relates.
+	FCnt                 uint32   `protobuf:"varint,6,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AckEvent) Reset()         { *m = AckEvent{} }
+func (m *AckEvent) String() string { return proto.CompactTextString(m) }
+func (*AckEvent) ProtoMessage()    {}
+func (*AckEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b63cd9a4f1e2667, []int{8}
+}
+
+func (m *AckEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AckEvent.Unmarshal(m, b)
+}
+func (m *AckEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AckEvent.Marshal(b, m, deterministic)
+}
+func (m *AckEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AckEvent.Merge(m, src)
+}
+func (m *AckEvent) XXX_Size() int {
+	return xxx_messageInfo_AckEvent.Size(m)
+}
+func (m *AckEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AckEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AckEvent proto.InternalMessageInfo
+
+func (m *AckEvent) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *AckEvent) GetApplicationName() string {
+	if m != nil {
+		return m.ApplicationName
+	}
+	return ""
+}
+
+func (m *AckEvent) GetDeviceName() string {
+	if m != nil {
+		return m.DeviceName
+	}
+	return ""
+}
+
+func (m *AckEvent) GetDevEui() []byte {
+	if m != nil {
+		return m.DevEui
+	}
+	return nil
+}
+
+func (m *AckEvent) GetAcknowledged() bool {
+	if m != nil {
+		return m.Acknowledged
+	}
+	return false
+}
+
+func (m *AckEvent) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+// ErrorEvent is the message sent on an error.
+type ErrorEvent struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Application name.
+	ApplicationName string `protobuf:"bytes,2,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	// Device name.
+	DeviceName string `protobuf:"bytes,3,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	// Device EUI.
+	DevEui []byte `protobuf:"bytes,4,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Error type.
+	Type string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	// Error message.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// Frame counter (if applicable).
+	FCnt                 uint32   `protobuf:"varint,7,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ErrorEvent) Reset()         { *m = ErrorEvent{} }
+func (m *ErrorEvent) String() string { return proto.CompactTextString(m) }
+func (*ErrorEvent) ProtoMessage()    {}
+func (*ErrorEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b63cd9a4f1e2667, []int{9}
+}
+
+func (m *ErrorEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ErrorEvent.Unmarshal(m, b)
+}
+func (m *ErrorEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ErrorEvent.Marshal(b, m, deterministic)
+}
+func (m *ErrorEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorEvent.Merge(m, src)
+}
+func (m *ErrorEvent) XXX_Size() int {
+	return xxx_messageInfo_ErrorEvent.Size(m)
+}
+func (m *ErrorEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorEvent proto.InternalMessageInfo
+
+func (m *ErrorEvent) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *ErrorEvent) GetApplicationName() string {
+	if m != nil {
+		return m.ApplicationName
+	}
+	return ""
+}
+
+func (m *ErrorEvent) GetDeviceName() string {
+	if m != nil {
+		return m.DeviceName
+	}
+	return ""
+}
+
+func (m *ErrorEvent) GetDevEui() []byte {
+	if m != nil {
+		return m.DevEui
+	}
+	return nil
+}
+
+func (m *ErrorEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ErrorEvent) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ErrorEvent) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+// StatusEvent is the message sent on a device-status report.
+type StatusEvent struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Application name.
+	ApplicationName string `protobuf:"bytes,2,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	// Device name.
+	DeviceName string `protobuf:"bytes,3,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	// Device EUI.
+	DevEui []byte `protobuf:"bytes,4,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Battery status (0 - 255, as reported by the device).
+	Battery uint32 `protobuf:"varint,5,opt,name=battery,proto3" json:"battery,omitempty"`
+	// Device link margin.
+	Margin int32 `protobuf:"varint,6,opt,name=margin,proto3" json:"margin,omitempty"`
+	// The device is connected to an external power source.
+	ExternalPowerSource bool `protobuf:"varint,7,opt,name=external_power_source,json=externalPowerSource,proto3" json:"external_power_source,omitempty"`
+	// Battery level (percentage).
+	BatteryLevel float32 `protobuf:"fixed32,8,opt,name=battery_level,json=batteryLevel,proto3" json:"battery_level,omitempty"`
+	// The battery level is not available.
+	BatteryLevelUnavailable bool     `protobuf:"varint,9,opt,name=battery_level_unavailable,json=batteryLevelUnavailable,proto3" json:"battery_level_unavailable,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
+}
+
+func (m *StatusEvent) Reset()         { *m = StatusEvent{} }
+func (m *StatusEvent) String() string { return proto.CompactTextString(m) }
+func (*StatusEvent) ProtoMessage()    {}
+func (*StatusEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b63cd9a4f1e2667, []int{10}
+}
+
+func (m *StatusEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatusEvent.Unmarshal(m, b)
+}
+func (m *StatusEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatusEvent.Marshal(b, m, deterministic)
+}
+func (m *StatusEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusEvent.Merge(m, src)
+}
+func (m *StatusEvent) XXX_Size() int {
+	return xxx_messageInfo_StatusEvent.Size(m)
+}
+func (m *StatusEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusEvent proto.InternalMessageInfo
+
+func (m *StatusEvent) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *StatusEvent) GetApplicationName() string {
+	if m != nil {
+		return m.ApplicationName
+	}
+	return ""
+}
+
+func (m *StatusEvent) GetDeviceName() string {
+	if m != nil {
+		return m.DeviceName
+	}
+	return ""
+}
+
+func (m *StatusEvent) GetDevEui() []byte {
+	if m != nil {
+		return m.DevEui
+	}
+	return nil
+}
+
+func (m *StatusEvent) GetBattery() uint32 {
+	if m != nil {
+		return m.Battery
+	}
+	return 0
+}
+
+func (m *StatusEvent) GetMargin() int32 {
+	if m != nil {
+		return m.Margin
+	}
+	return 0
+}
+
+func (m *StatusEvent) GetExternalPowerSource() bool {
+	if m != nil {
+		return m.ExternalPowerSource
+	}
+	return false
+}
+
+func (m *StatusEvent) GetBatteryLevel() float32 {
+	if m != nil {
+		return m.BatteryLevel
+	}
+	return 0
+}
+
+func (m *StatusEvent) GetBatteryLevelUnavailable() bool {
+	if m != nil {
+		return m.BatteryLevelUnavailable
+	}
+	return false
+}
+
+// LocationEvent is the message sent after the location of a device has
+// been resolved.
+type LocationEvent struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Application name.
+	ApplicationName string `protobuf:"bytes,2,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	// Device name.
+	DeviceName string `protobuf:"bytes,3,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	// Device EUI.
+	DevEui []byte `protobuf:"bytes,4,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Location.
+	Location             *Location `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *LocationEvent) Reset()         { *m = LocationEvent{} }
+func (m *LocationEvent) String() string { return proto.CompactTextString(m) }
+func (*LocationEvent) ProtoMessage()    {}
+func (*LocationEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b63cd9a4f1e2667, []int{11}
+}
+
+func (m *LocationEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LocationEvent.Unmarshal(m, b)
+}
+func (m *LocationEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LocationEvent.Marshal(b, m, deterministic)
+}
+func (m *LocationEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocationEvent.Merge(m, src)
+}
+func (m *LocationEvent) XXX_Size() int {
+	return xxx_messageInfo_LocationEvent.Size(m)
+}
+func (m *LocationEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocationEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocationEvent proto.InternalMessageInfo
+
+func (m *LocationEvent) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *LocationEvent) GetApplicationName() string {
+	if m != nil {
+		return m.ApplicationName
+	}
+	return ""
+}
+
+func (m *LocationEvent) GetDeviceName() string {
+	if m != nil {
+		return m.DeviceName
+	}
+	return ""
+}
+
+func (m *LocationEvent) GetDevEui() []byte {
+	if m != nil {
+		return m.DevEui
+	}
+	return nil
+}
+
+func (m *LocationEvent) GetLocation() *Location {
+	if m != nil {
+		return m.Location
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Location)(nil), "integration.Location")
+	proto.RegisterType((*RXInfo)(nil), "integration.RXInfo")
+	proto.RegisterType((*EncryptedFineTimestamp)(nil), "integration.EncryptedFineTimestamp")
+	proto.RegisterType((*LoRaModulationInfo)(nil), "integration.LoRaModulationInfo")
+	proto.RegisterType((*FSKModulationInfo)(nil), "integration.FSKModulationInfo")
+	proto.RegisterType((*TXInfo)(nil), "integration.TXInfo")
+	proto.RegisterType((*UplinkEvent)(nil), "integration.UplinkEvent")
+	proto.RegisterType((*JoinEvent)(nil), "integration.JoinEvent")
+	proto.RegisterType((*AckEvent)(nil), "integration.AckEvent")
+	proto.RegisterType((*ErrorEvent)(nil), "integration.ErrorEvent")
+	proto.RegisterType((*StatusEvent)(nil), "integration.StatusEvent")
+	proto.RegisterType((*LocationEvent)(nil), "integration.LocationEvent")
+}
+
+func init() { proto.RegisterFile("integration/integration.proto", fileDescriptor_6b63cd9a4f1e2667) }
+
+var fileDescriptor_6b63cd9a4f1e2667 = []byte{
+	// 1146 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6e, 0x1b, 0x37,
+	0x10, 0x8e, 0xfe, 0xa5, 0x91, 0xe4, 0xc4, 0x74, 0xec, 0x6c, 0xd2, 0xfc, 0x75, 0x83, 0x02, 0x2e,
+	0xd0, 0x48, 0xa8, 0xdb, 0x5e, 0xda, 0x93, 0x13, 0x3b, 0x88, 0x62, 0xd7, 0x35, 0x28, 0x1b, 0x08,
+	0x0a, 0x14, 0x5b, 0x6a, 0xc9, 0x95, 0x19, 0xaf, 0xc8, 0x2d, 0x97, 0x92, 0xad, 0x3e, 0x45, 0x9f,
+	0xa1, 0x6f, 0xd0, 0x3e, 0x48, 0x7b, 0xec, 0xa9, 0x40, 0x1f, 0xa5, 0x20, 0xb9, 0x2b, 0xad, 0xec,
+	0x00, 0x69, 0x6f, 0x3e, 0x89, 0xf3, 0xcd, 0xec, 0x70, 0xbe, 0xe1, 0xe8, 0x23, 0xe1, 0x11, 0x17,
+	0x9a, 0x8d, 0x15, 0xd1, 0x5c, 0x8a, 0x7e, 0x61, 0xdd, 0x4b, 0x94, 0xd4, 0x12, 0xb5, 0x0b, 0xd0,
+	0x83, 0x27, 0x63, 0x29, 0xc7, 0x31, 0xeb, 0x5b, 0xd7, 0x68, 0x1a, 0xf5, 0x35, 0x9f, 0xb0, 0x54,
+	0x93, 0x49, 0xe2, 0xa2, 0xfd, 0x1f, 0xa1, 0x79, 0x28, 0x43, 0x1b, 0x8c, 0x1e, 0x40, 0x33, 0x26,
+	0x9a, 0xeb, 0x29, 0x65, 0x5e, 0xe9, 0x69, 0x69, 0xbb, 0x84, 0x17, 0x36, 0x7a, 0x08, 0xad, 0x58,
+	0x8a, 0xb1, 0x73, 0x96, 0xad, 0x73, 0x09, 0x98, 0x2f, 0x49, 0x9c, 0x7d, 0x59, 0x71, 0x5f, 0xe6,
+	0xb6, 0xff, 0x6b, 0x15, 0xea, 0xf8, 0xed, 0x40, 0x44, 0x12, 0x3d, 0x02, 0x18, 0x13, 0xcd, 0x2e,
+	0xc8, 0x3c, 0xe0, 0xd4, 0x6e, 0xd1, 0xc1, 0xad, 0x0c, 0x19, 0xec, 0x21, 0x04, 0x55, 0x41, 0x26,
+	0x2e, 0x7d, 0x0b, 0xdb, 0x35, 0xea, 0x41, 0xd5, 0x94, 0x6c, 0xb3, 0xb6, 0x77, 0x1e, 0xf4, 0x1c,
+	0x9f, 0x5e, 0xce, 0xa7, 0x77, 0x92, 0xf3, 0xc1, 0x36, 0xce, 0xe4, 0x50, 0x69, 0xca, 0xbd, 0xea,
+	0xd3, 0xd2, 0x76, 0x0d, 0xdb, 0x35, 0xba, 0x0f, 0xcd, 0x58, 0x2a, 0x12, 0xa4, 0x42, 0x79, 0x35,
+	0x5b, 0x5d, 0x23, 0x96, 0x98, 0x0c, 0x8f, 0x30, 0xfa, 0xdc, 0xb8, 0x1c, 0x7d, 0xaf, 0x6e, 0xb7,
+	0xd8, 0xec, 0x15, 0x5b, 0x9a, 0xf7, 0x06, 0x2f, 0xc2, 0x4c, 0x27, 0x16, 0x4d, 0xf4, 0x1a, 0x4f,
+	0x4b, 0xdb, 0x5d, 0xbc, 0x04, 0x50, 0x0f, 0x36, 0x22, 0x2e, 0x58, 0xb0, 0x40, 0x02, 0x3d, 0x4f,
+	0x98, 0xd7, 0xb4, 0x94, 0xd6, 0x8d, 0x6b, 0x51, 0xf1, 0xc9, 0x3c, 0x61, 0x68, 0x17, 0xd6, 0x56,
+	0xe3, 0xbd, 0xd6, 0x07, 0x99, 0x76, 0x57, 0xd2, 0xa0, 0x1f, 0xc0, 0x63, 0x22, 0x54, 0xf3, 0x44,
+	0x33, 0x1a, 0x5c, 0x49, 0x06, 0x36, 0xd9, 0xb3, 0x15, 0x4e, 0xfb, 0x79, 0xf0, 0xab, 0x62, 0x1a,
+	0xbc, 0xc5, 0xde, 0x8b, 0x23, 0x0f, 0x1a, 0xe1, 0x19, 0x11, 0x82, 0xc5, 0x5e, 0xdb, 0xb2, 0xcd,
+	0x4d, 0xd3, 0x57, 0x15, 0x05, 0xe1, 0x19, 0xe1, 0xc2, 0xeb, 0x38, 0x97, 0x8a, 0x5e, 0x1a, 0x13,
+	0xdd, 0x85, 0xda, 0x48, 0x12, 0x45, 0xbd, 0xae, 0xc5, 0x9d, 0x61, 0x52, 0x11, 0xa1, 0x99, 0x10,
+	0xc4, 0x5b, 0x73, 0xf1, 0x99, 0xe9, 0x5f, 0xc2, 0xd6, 0xfb, 0xcb, 0x42, 0x3e, 0x74, 0x09, 0x4b,
+	0x83, 0x73, 0x36, 0x0f, 0xb8, 0xa0, 0xec, 0xd2, 0x8e, 0x4d, 0x17, 0xb7, 0x09, 0x4b, 0x0f, 0xd8,
+	0x7c, 0x60, 0x20, 0xf4, 0x31, 0x74, 0x96, 0x1d, 0x10, 0xa9, 0x1d, 0xa0, 0x0e, 0x6e, 0x2f, 0xb0,
+	0xa3, 0x21, 0xba, 0x07, 0x8d, 0x28, 0x19, 0x13, 0x33, 0x77, 0x15, 0xeb, 0xad, 0x1b, 0x73, 0xb0,
+	0xe7, 0xff, 0x56, 0x02, 0x74, 0x28, 0x31, 0xf9, 0x56, 0xd2, 0x69, 0x6c, 0x1b, 0x64, 0x47, 0xf5,
+	0x21, 0xb4, 0x46, 0x44, 0xd0, 0x0b, 0x4e, 0xf5, 0x59, 0xb6, 0xe5, 0x12, 0x40, 0x9f, 0xc2, 0x9d,
+	0x34, 0x51, 0x8c, 0x50, 0x2e, 0xc6, 0x41, 0x44, 0x42, 0x2d, 0x95, 0xdd, 0xb4, 0x8b, 0x6f, 0x2f,
+	0xf0, 0x57, 0x16, 0x46, 0x1f, 0x41, 0x2b, 0x94, 0x94, 0x05, 0x8a, 0x68, 0x37, 0xc5, 0x2d, 0xdc,
+	0x34, 0x00, 0x26, 0x9a, 0xa1, 0xaf, 0x60, 0x2b, 0x91, 0x31, 0x51, 0xfc, 0x67, 0xbb, 0x73, 0xc0,
+	0xc5, 0x8c, 0xa9, 0xd4, 0x0c, 0xa3, 0x99, 0xdf, 0x26, 0xde, 0x2c, 0x7a, 0x07, 0xb9, 0xd3, 0x3f,
+	0x80, 0xf5, 0x57, 0xc3, 0x83, 0xff, 0x55, 0xb1, 0x07, 0x8d, 0x11, 0xd7, 0xb6, 0x08, 0x57, 0x68,
+	0x6e, 0xfa, 0xbf, 0x94, 0xa1, 0x7e, 0xf2, 0x36, 0x4f, 0x11, 0x29, 0xf6, 0xd3, 0x94, 0x89, 0x70,
+	0x9e, 0xa7, 0x58, 0x00, 0x68, 0x0d, 0xca, 0x34, 0xa7, 0x59, 0xa6, 0x0a, 0x3d, 0x06, 0x98, 0x2c,
+	0x4a, 0xc8, 0xa8, 0x15, 0x10, 0x34, 0x84, 0xbb, 0xf6, 0x6f, 0xb7, 0x84, 0x02, 0x2e, 0x22, 0x69,
+	0xa9, 0xb5, 0x77, 0x9e, 0x5c, 0xf9, 0x9f, 0x5d, 0x3d, 0x81, 0xd7, 0xb7, 0x30, 0x8a, 0xaf, 0x9f,
+	0xcb, 0x31, 0x6c, 0x44, 0xe9, 0xf9, 0xb5, 0x9c, 0x35, 0x9b, 0xf3, 0xf1, 0x4a, 0xce, 0x6b, 0x2d,
+	0x7a, 0x7d, 0x0b, 0xaf, 0x47, 0xe9, 0xf9, 0x2a, 0xf8, 0x62, 0x1d, 0x6e, 0x5f, 0xc9, 0xe6, 0xff,
+	0x5d, 0x81, 0xf6, 0x69, 0x12, 0x73, 0x71, 0xbe, 0x3f, 0x63, 0x42, 0xa3, 0x4f, 0x60, 0x8d, 0x24,
+	0x49, 0xcc, 0xc3, 0x2c, 0xc6, 0x69, 0x57, 0x05, 0x77, 0x0b, 0xe8, 0x60, 0xcf, 0x4c, 0x45, 0x31,
+	0xac, 0xa0, 0x65, 0xb7, 0x0b, 0xf8, 0x91, 0x91, 0xb5, 0x27, 0xd0, 0xa6, 0x6c, 0xc6, 0x43, 0xe6,
+	0xa2, 0xb2, 0xe6, 0x39, 0xc8, 0x06, 0xdc, 0x83, 0x06, 0x65, 0xb3, 0x80, 0x4d, 0x9d, 0x94, 0x75,
+	0x70, 0x9d, 0xb2, 0xd9, 0xfe, 0xe9, 0x00, 0x7d, 0x06, 0x0d, 0x75, 0x99, 0x93, 0xae, 0x6c, 0xb7,
+	0x77, 0x36, 0x56, 0x48, 0x3b, 0xa5, 0xc5, 0x75, 0x75, 0x69, 0x7e, 0x4d, 0xb4, 0xce, 0xa2, 0x9d,
+	0xbc, 0xad, 0x46, 0x9f, 0x64, 0xd1, 0xda, 0x45, 0xdf, 0x81, 0x0a, 0xa1, 0xca, 0x8a, 0x5a, 0x13,
+	0x9b, 0x25, 0xda, 0x80, 0x5a, 0x14, 0x84, 0x42, 0x5b, 0x01, 0xeb, 0xe2, 0x6a, 0xf4, 0x52, 0x68,
+	0xb4, 0x09, 0xf5, 0x28, 0x48, 0xa4, 0xd2, 0x56, 0xab, 0xba, 0xb8, 0x16, 0x1d, 0x4b, 0xa5, 0x8d,
+	0xf4, 0x52, 0xa2, 0x89, 0xd5, 0x9c, 0x0e, 0xb6, 0x6b, 0xc3, 0x53, 0x8e, 0xde, 0xb1, 0x50, 0x07,
+	0xef, 0x52, 0x29, 0xac, 0x80, 0xb4, 0x30, 0x38, 0xe8, 0xcd, 0xf0, 0xbb, 0x23, 0xa3, 0x21, 0x86,
+	0x27, 0xa1, 0x54, 0x59, 0x0d, 0xe9, 0x60, 0xc3, 0x7b, 0x97, 0x52, 0xfb, 0xcf, 0x99, 0xda, 0x43,
+	0x08, 0xb8, 0xd3, 0x91, 0x0e, 0x6e, 0x3a, 0x60, 0xb0, 0x87, 0xbe, 0x81, 0xb6, 0x62, 0x21, 0xe3,
+	0x33, 0x46, 0x03, 0xa2, 0xbd, 0xb5, 0x0f, 0x8a, 0x26, 0xe4, 0xe1, 0xbb, 0xda, 0xff, 0xbd, 0x04,
+	0xad, 0x37, 0x92, 0x8b, 0x9b, 0x77, 0xba, 0xc5, 0x76, 0xd4, 0x56, 0xda, 0xe1, 0xff, 0x59, 0x82,
+	0xe6, 0x6e, 0x78, 0x03, 0x27, 0xd2, 0x87, 0x0e, 0x09, 0xcf, 0x85, 0xbc, 0x88, 0x19, 0x1d, 0x33,
+	0x6a, 0xeb, 0x6e, 0xe2, 0x15, 0x6c, 0x39, 0x47, 0xf5, 0xe5, 0x1c, 0xf9, 0x7f, 0x95, 0x00, 0xf6,
+	0x95, 0x92, 0xea, 0xe6, 0x71, 0x42, 0x50, 0xb5, 0xf7, 0x76, 0xcd, 0x3d, 0x45, 0xcc, 0xda, 0xdc,
+	0x69, 0xcc, 0x54, 0x6b, 0x39, 0xb4, 0xb0, 0x33, 0x96, 0xcc, 0x1a, 0x05, 0x66, 0xff, 0x94, 0xa1,
+	0x3d, 0xd4, 0x44, 0x4f, 0xd3, 0x9b, 0x47, 0xcd, 0xdc, 0x04, 0x44, 0x6b, 0xa6, 0xe6, 0x5e, 0x2d,
+	0xbb, 0x09, 0x9c, 0x89, 0xb6, 0xa0, 0x3e, 0x21, 0x6a, 0xcc, 0xdd, 0x53, 0xa8, 0x86, 0x33, 0x0b,
+	0xed, 0xc0, 0x26, 0xbb, 0xd4, 0x4c, 0x09, 0x12, 0x07, 0x89, 0xbc, 0x60, 0x2a, 0x48, 0xe5, 0x54,
+	0x85, 0x2c, 0x13, 0x8a, 0x8d, 0xdc, 0x79, 0x6c, 0x7c, 0x43, 0xeb, 0x42, 0xcf, 0xa0, 0x9b, 0xa5,
+	0x0d, 0x62, 0x36, 0x63, 0xb1, 0x15, 0x90, 0x32, 0xee, 0x64, 0xe0, 0xa1, 0xc1, 0xd0, 0xd7, 0x70,
+	0x7f, 0x25, 0x28, 0x98, 0x0a, 0x32, 0x23, 0x3c, 0x26, 0xa3, 0x98, 0x59, 0x6d, 0x69, 0xe2, 0x7b,
+	0xc5, 0x0f, 0x4e, 0x97, 0x6e, 0xff, 0x8f, 0x12, 0x74, 0xf3, 0xd7, 0xd9, 0xcd, 0x6b, 0x72, 0xf1,
+	0x5d, 0x59, 0xfb, 0x4f, 0xef, 0xca, 0x17, 0x5f, 0x7e, 0xbf, 0x33, 0xe6, 0xfa, 0x6c, 0x3a, 0xea,
+	0x85, 0x72, 0xd2, 0x1f, 0x29, 0x19, 0x12, 0xa2, 0xfa, 0xe6, 0x06, 0x7d, 0x4e, 0x92, 0xe4, 0x79,
+	0xca, 0xd4, 0x8c, 0xa9, 0x3e, 0x49, 0x78, 0xf1, 0xcd, 0x3f, 0xaa, 0x5b, 0xa9, 0xfb, 0xe2, 0xdf,
+	0x01, 0x00, 0xb1, 0x9a, 0x6d, 0x2f, 0x15, 0x0c, 0x00, 0x00,
+}
@@ -508,6 +508,181 @@ func (m *GlobalSearchResult) GetGatewayName() string {
 	return ""
 }
 
+type ListIntegrationStatsRequest struct {
+	// Only return integrations for which the last delivery failed.
+	FailingOnly bool `protobuf:"varint,1,opt,name=failing_only,json=failingOnly,proto3" json:"failing_only,omitempty"`
+	// Max number of results to return.
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset offset of the result-set (for pagination).
+	Offset               int64    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListIntegrationStatsRequest) Reset()         { *m = ListIntegrationStatsRequest{} }
+func (m *ListIntegrationStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListIntegrationStatsRequest) ProtoMessage()    {}
+func (*ListIntegrationStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{8}
+}
+
+func (m *ListIntegrationStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListIntegrationStatsRequest.Unmarshal(m, b)
+}
+func (m *ListIntegrationStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListIntegrationStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListIntegrationStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListIntegrationStatsRequest.Merge(m, src)
+}
+func (m *ListIntegrationStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListIntegrationStatsRequest.Size(m)
+}
+func (m *ListIntegrationStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListIntegrationStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListIntegrationStatsRequest proto.InternalMessageInfo
+
+func (m *ListIntegrationStatsRequest) GetFailingOnly() bool {
+	if m != nil {
+		return m.FailingOnly
+	}
+	return false
+}
+
+func (m *ListIntegrationStatsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListIntegrationStatsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListIntegrationStatsResponse struct {
+	// Total number of integrations available within the result-set.
+	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Integration statistics within the result-set.
+	Result               []*IntegrationStatsListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *ListIntegrationStatsResponse) Reset()         { *m = ListIntegrationStatsResponse{} }
+func (m *ListIntegrationStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListIntegrationStatsResponse) ProtoMessage()    {}
+func (*ListIntegrationStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{9}
+}
+
+func (m *ListIntegrationStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListIntegrationStatsResponse.Unmarshal(m, b)
+}
+func (m *ListIntegrationStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListIntegrationStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListIntegrationStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListIntegrationStatsResponse.Merge(m, src)
+}
+func (m *ListIntegrationStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListIntegrationStatsResponse.Size(m)
+}
+func (m *ListIntegrationStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListIntegrationStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListIntegrationStatsResponse proto.InternalMessageInfo
+
+func (m *ListIntegrationStatsResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListIntegrationStatsResponse) GetResult() []*IntegrationStatsListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type IntegrationStatsListItem struct {
+	// Application id.
+	// This is 0 for the global integrations.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Application name.
+	ApplicationName string `protobuf:"bytes,2,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	// Integration kind (e.g. HTTP, INFLUXDB, MQTT).
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Delivery statistics.
+	Stats                *IntegrationStats `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *IntegrationStatsListItem) Reset()         { *m = IntegrationStatsListItem{} }
+func (m *IntegrationStatsListItem) String() string { return proto.CompactTextString(m) }
+func (*IntegrationStatsListItem) ProtoMessage()    {}
+func (*IntegrationStatsListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{10}
+}
+
+func (m *IntegrationStatsListItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IntegrationStatsListItem.Unmarshal(m, b)
+}
+func (m *IntegrationStatsListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IntegrationStatsListItem.Marshal(b, m, deterministic)
+}
+func (m *IntegrationStatsListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntegrationStatsListItem.Merge(m, src)
+}
+func (m *IntegrationStatsListItem) XXX_Size() int {
+	return xxx_messageInfo_IntegrationStatsListItem.Size(m)
+}
+func (m *IntegrationStatsListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntegrationStatsListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntegrationStatsListItem proto.InternalMessageInfo
+
+func (m *IntegrationStatsListItem) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *IntegrationStatsListItem) GetApplicationName() string {
+	if m != nil {
+		return m.ApplicationName
+	}
+	return ""
+}
+
+func (m *IntegrationStatsListItem) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *IntegrationStatsListItem) GetStats() *IntegrationStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
 type BrandingResponse struct {
 	// Logo html.
 	Logo string `protobuf:"bytes,1,opt,name=logo,proto3" json:"logo,omitempty"`
@@ -524,7 +699,7 @@ func (m *BrandingResponse) Reset()         { *m = BrandingResponse{} }
 func (m *BrandingResponse) String() string { return proto.CompactTextString(m) }
 func (*BrandingResponse) ProtoMessage()    {}
 func (*BrandingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{8}
+	return fileDescriptor_41f4a519b878ee3b, []int{11}
 }
 
 func (m *BrandingResponse) XXX_Unmarshal(b []byte) error {
@@ -575,64 +750,77 @@ func init() {
 	proto.RegisterType((*GlobalSearchRequest)(nil), "api.GlobalSearchRequest")
 	proto.RegisterType((*GlobalSearchResponse)(nil), "api.GlobalSearchResponse")
 	proto.RegisterType((*GlobalSearchResult)(nil), "api.GlobalSearchResult")
+	proto.RegisterType((*ListIntegrationStatsRequest)(nil), "api.ListIntegrationStatsRequest")
+	proto.RegisterType((*ListIntegrationStatsResponse)(nil), "api.ListIntegrationStatsResponse")
+	proto.RegisterType((*IntegrationStatsListItem)(nil), "api.IntegrationStatsListItem")
 	proto.RegisterType((*BrandingResponse)(nil), "api.BrandingResponse")
 }
 
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 810 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xd1, 0x8e, 0xdb, 0x44,
-	0x14, 0x86, 0xe5, 0x38, 0xbb, 0x9b, 0x9c, 0x64, 0x77, 0xd3, 0x69, 0x9a, 0xba, 0xa1, 0xcb, 0xa6,
-	0x16, 0x88, 0x00, 0x52, 0x82, 0xc2, 0x15, 0x70, 0x15, 0xd8, 0xa5, 0x8a, 0x54, 0x0a, 0xf2, 0xb6,
-	0x48, 0x88, 0x0b, 0x6b, 0x12, 0x4f, 0xcc, 0x50, 0x7b, 0xc6, 0x78, 0x26, 0xbb, 0x94, 0x4b, 0x5e,
-	0x81, 0x07, 0xe0, 0xa1, 0x78, 0x05, 0x5e, 0x80, 0x2b, 0xc4, 0x1d, 0x9a, 0xe3, 0xb1, 0x65, 0xa7,
-	0xbb, 0x82, 0x3b, 0x9f, 0x33, 0xdf, 0xfc, 0x3e, 0xf3, 0xcf, 0x39, 0x03, 0x27, 0x5c, 0x68, 0x96,
-	0x0b, 0x9a, 0xcc, 0xb2, 0x5c, 0x6a, 0x49, 0x5c, 0x9a, 0xf1, 0xf1, 0xe3, 0x58, 0xca, 0x38, 0x61,
-	0x73, 0x9a, 0xf1, 0x39, 0x15, 0x42, 0x6a, 0xaa, 0xb9, 0x14, 0xaa, 0x40, 0xc6, 0xe7, 0x76, 0x15,
-	0xa3, 0xf5, 0x6e, 0x3b, 0xd7, 0x3c, 0x65, 0x4a, 0xd3, 0x34, 0xb3, 0xc0, 0x5b, 0xfb, 0x00, 0x4b,
-	0x33, 0xfd, 0xda, 0x2e, 0xc2, 0x4e, 0xb1, 0xbc, 0xf8, 0xf6, 0x5f, 0xc0, 0xe9, 0x37, 0xb9, 0xdc,
-	0xf2, 0x84, 0x5d, 0x31, 0xad, 0xb9, 0x88, 0x15, 0x59, 0xc2, 0x59, 0xc4, 0x15, 0x5d, 0x27, 0x2c,
-	0xa4, 0x4a, 0xf1, 0x58, 0x84, 0xec, 0x67, 0xae, 0xcc, 0x5a, 0x68, 0x36, 0x2a, 0xcf, 0x99, 0x38,
-	0xd3, 0x4e, 0x30, 0xb6, 0xd0, 0x12, 0x99, 0x4b, 0x8b, 0xbc, 0x34, 0x84, 0xff, 0x8f, 0x03, 0x83,
-	0xaf, 0xf3, 0x98, 0x0a, 0xfe, 0x0b, 0xd6, 0xfd, 0x8c, 0x8b, 0x57, 0xe4, 0x3d, 0x38, 0x95, 0xb5,
-	0x5c, 0xc8, 0x23, 0x54, 0x72, 0x83, 0x93, 0x7a, 0x7a, 0x75, 0x41, 0x3e, 0x84, 0x7b, 0x0d, 0x50,
-	0xd0, 0x94, 0x79, 0xad, 0x89, 0x33, 0xed, 0x06, 0x83, 0xfa, 0xc2, 0x73, 0x9a, 0x32, 0xf2, 0x08,
-	0x3a, 0x5c, 0x85, 0x34, 0x4a, 0xb9, 0xf0, 0x5c, 0x2c, 0xec, 0x88, 0xab, 0xa5, 0x09, 0xc9, 0x27,
-	0x00, 0x9b, 0x9c, 0x51, 0xcd, 0xa2, 0x90, 0x6a, 0xaf, 0x3d, 0x71, 0xa6, 0xbd, 0xc5, 0x78, 0x56,
-	0x38, 0x33, 0x2b, 0x9d, 0x99, 0xbd, 0x28, 0xad, 0x0b, 0xba, 0x96, 0x5e, 0x6a, 0xb3, 0x75, 0x97,
-	0x45, 0xe5, 0xd6, 0x83, 0xff, 0xde, 0x6a, 0xe9, 0xa5, 0xf6, 0xbf, 0x84, 0xfe, 0x33, 0x19, 0x73,
-	0x11, 0xb0, 0x9f, 0x76, 0x4c, 0x69, 0x32, 0x86, 0x8e, 0xb1, 0x0d, 0x0f, 0xe1, 0xe0, 0x21, 0xaa,
-	0xd8, 0xac, 0x65, 0x54, 0xa9, 0x1b, 0x99, 0x47, 0xf6, 0x80, 0x55, 0xec, 0x3f, 0x81, 0x63, 0xab,
-	0xa3, 0x32, 0x29, 0x14, 0x23, 0x03, 0x70, 0x7f, 0xbc, 0xd1, 0x56, 0xc3, 0x7c, 0xfa, 0xbf, 0x3b,
-	0xd5, 0xed, 0x55, 0xd4, 0x19, 0xb4, 0x8d, 0x3c, 0x62, 0xbd, 0x45, 0x77, 0x46, 0x33, 0x3e, 0x33,
-	0x97, 0x12, 0x60, 0x9a, 0x7c, 0x06, 0xc7, 0x75, 0x0b, 0x95, 0xe7, 0x4e, 0xdc, 0x69, 0x6f, 0xf1,
-	0x00, 0xb9, 0xfd, 0x2b, 0x0b, 0x9a, 0x2c, 0xf9, 0x08, 0x3a, 0xca, 0x76, 0x89, 0xb5, 0x73, 0x88,
-	0xfb, 0xf6, 0x3a, 0x28, 0xa8, 0x28, 0xff, 0x7b, 0xb8, 0xff, 0x34, 0x91, 0x6b, 0x9a, 0x5c, 0x31,
-	0x9a, 0x6f, 0x7e, 0x28, 0x3d, 0x19, 0xc1, 0xa1, 0xc2, 0x84, 0x3d, 0x8d, 0x8d, 0xc8, 0x10, 0x0e,
-	0x12, 0x9e, 0x72, 0x8d, 0x66, 0xb8, 0x41, 0x11, 0x18, 0x5a, 0x6e, 0xb7, 0x8a, 0x69, 0xbc, 0x60,
-	0x37, 0xb0, 0x91, 0xff, 0x14, 0x86, 0x4d, 0x71, 0x6b, 0xc1, 0x1c, 0x0e, 0x73, 0xa6, 0x76, 0x89,
-	0xf1, 0xca, 0x1c, 0xee, 0x21, 0x16, 0xb9, 0x87, 0xee, 0x12, 0x1d, 0x58, 0xcc, 0xff, 0xab, 0x05,
-	0xe4, 0xcd, 0x65, 0x42, 0xa0, 0xfd, 0x8a, 0x8b, 0xc8, 0xd6, 0x88, 0xdf, 0xa6, 0x42, 0xb5, 0x91,
-	0x79, 0xd1, 0x8f, 0xad, 0xa0, 0x08, 0x6e, 0x6b, 0x6d, 0xf7, 0xff, 0xb7, 0x76, 0xfb, 0x8e, 0xd6,
-	0x7e, 0x17, 0x4e, 0x68, 0x96, 0x25, 0x7c, 0x53, 0x89, 0x1e, 0xa0, 0xe8, 0x71, 0x2d, 0xbb, 0xba,
-	0x20, 0xef, 0xc3, 0xa0, 0x8e, 0xa1, 0xe4, 0x21, 0x4a, 0x9e, 0xd6, 0xf2, 0xa8, 0xf8, 0x0e, 0x9c,
-	0x44, 0xec, 0x9a, 0x6f, 0x58, 0x18, 0xb1, 0xeb, 0x90, 0xed, 0xb8, 0x77, 0x84, 0x60, 0xbf, 0xc8,
-	0x5e, 0xb0, 0xeb, 0xcb, 0x97, 0x2b, 0x72, 0x0e, 0x3d, 0x4b, 0xa1, 0x56, 0x07, 0x11, 0x28, 0x52,
-	0x28, 0x73, 0x0e, 0xbd, 0x98, 0x6a, 0x76, 0x43, 0x5f, 0x87, 0x29, 0xdd, 0x78, 0xdd, 0x02, 0xb0,
-	0xa9, 0xaf, 0x96, 0x5f, 0x90, 0x27, 0xd0, 0x2f, 0x01, 0x94, 0x00, 0x24, 0xca, 0x4d, 0x46, 0xc3,
-	0x5f, 0xc3, 0xe0, 0xf3, 0x9c, 0x8a, 0x88, 0x8b, 0xb8, 0xba, 0x38, 0x02, 0xed, 0x44, 0xc6, 0xb2,
-	0x34, 0xdc, 0x7c, 0x13, 0x1f, 0xfa, 0x39, 0x8b, 0xb9, 0xd2, 0x39, 0x1e, 0xc3, 0x8e, 0x49, 0x23,
-	0x67, 0x1a, 0x64, 0x2b, 0xa5, 0x66, 0x39, 0xba, 0xde, 0x0d, 0x6c, 0xb4, 0xf8, 0xbb, 0x05, 0xa7,
-	0x2b, 0xfb, 0xb8, 0x5e, 0xb1, 0xdc, 0xd4, 0x4f, 0x9e, 0xc3, 0x01, 0x8e, 0x15, 0xb9, 0x87, 0x5d,
-	0x51, 0x1f, 0xd5, 0x31, 0xa9, 0xa7, 0x8a, 0x9a, 0xfc, 0xb7, 0x7f, 0xfd, 0xe3, 0xcf, 0xdf, 0x5a,
-	0x9e, 0x7f, 0x1f, 0x9f, 0xe2, 0xf2, 0xa9, 0x9e, 0x27, 0x06, 0xfa, 0xd4, 0xf9, 0x80, 0x7c, 0x0b,
-	0x47, 0xb6, 0xfd, 0xc9, 0xe8, 0x8d, 0x07, 0xe2, 0xd2, 0xbc, 0xba, 0xe3, 0xc6, 0x90, 0x54, 0xc2,
-	0x67, 0x28, 0xfc, 0x90, 0x3c, 0x68, 0x0a, 0x67, 0x56, 0xec, 0x3b, 0xe8, 0x94, 0xfe, 0xdc, 0x29,
-	0x5c, 0x4c, 0xed, 0xbe, 0x8d, 0x65, 0xc9, 0x64, 0xd4, 0x54, 0x5e, 0x97, 0x72, 0x14, 0xfa, 0xf5,
-	0x6e, 0x27, 0xde, 0x2d, 0xf3, 0x51, 0x18, 0xf2, 0xe8, 0xb6, 0xc9, 0x29, 0x7e, 0xf2, 0x18, 0x7f,
-	0x32, 0x22, 0xc3, 0xe6, 0x4f, 0x8a, 0x41, 0x5e, 0x1f, 0x62, 0xa5, 0x1f, 0xff, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0x59, 0x32, 0x94, 0x5a, 0xdc, 0x06, 0x00, 0x00,
+	// 962 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xd1, 0x6e, 0xe3, 0x44,
+	0x14, 0x95, 0xe3, 0xa6, 0x4d, 0x6f, 0xd2, 0x36, 0x9d, 0x4d, 0xbb, 0xde, 0x6c, 0x4b, 0x53, 0x0b,
+	0x44, 0x60, 0xa5, 0x04, 0x15, 0xf1, 0x00, 0x3c, 0x85, 0x6d, 0x59, 0x45, 0x2a, 0xbb, 0xc8, 0xdd,
+	0x45, 0x42, 0x3c, 0x58, 0x93, 0x78, 0x62, 0x86, 0x75, 0x66, 0x8c, 0x67, 0xd2, 0x52, 0x1e, 0x91,
+	0xf8, 0x02, 0x3e, 0x80, 0x2f, 0xe0, 0x6b, 0xf8, 0x05, 0x7e, 0x80, 0x47, 0x9e, 0x40, 0x73, 0x3d,
+	0x0e, 0x76, 0x36, 0x85, 0xdd, 0x37, 0xdf, 0x3b, 0x67, 0xce, 0xbd, 0x73, 0xe6, 0xcc, 0x35, 0xec,
+	0x72, 0xa1, 0x59, 0x26, 0x68, 0x32, 0x48, 0x33, 0xa9, 0x25, 0x71, 0x69, 0xca, 0xbb, 0x47, 0xb1,
+	0x94, 0x71, 0xc2, 0x86, 0x34, 0xe5, 0x43, 0x2a, 0x84, 0xd4, 0x54, 0x73, 0x29, 0x54, 0x0e, 0xe9,
+	0x9e, 0xd8, 0x55, 0x8c, 0x26, 0x8b, 0xd9, 0x50, 0xf3, 0x39, 0x53, 0x9a, 0xce, 0x53, 0x0b, 0x78,
+	0xb8, 0x0a, 0x60, 0xf3, 0x54, 0xdf, 0xda, 0x45, 0x58, 0x28, 0x96, 0xd9, 0xef, 0x7d, 0x9a, 0xa6,
+	0x09, 0x9f, 0x22, 0x7b, 0x9e, 0xf2, 0x9f, 0xc3, 0xde, 0x97, 0x99, 0x9c, 0xf1, 0x84, 0x5d, 0x31,
+	0xad, 0xb9, 0x88, 0x15, 0x19, 0xc1, 0x71, 0xc4, 0x15, 0x9d, 0x24, 0x2c, 0xa4, 0x4a, 0xf1, 0x58,
+	0x84, 0xec, 0x07, 0xae, 0xcc, 0x5a, 0x68, 0xb8, 0x94, 0xe7, 0xf4, 0x9c, 0x7e, 0x23, 0xe8, 0x5a,
+	0xd0, 0x08, 0x31, 0x17, 0x16, 0xf2, 0xc2, 0x20, 0xfc, 0xbf, 0x1c, 0x68, 0x3f, 0xcb, 0x62, 0x2a,
+	0xf8, 0x8f, 0x58, 0xec, 0x92, 0x8b, 0x97, 0xe4, 0x5d, 0xd8, 0x93, 0xa5, 0x5c, 0xc8, 0x23, 0x64,
+	0x72, 0x83, 0xdd, 0x72, 0x7a, 0x7c, 0x4e, 0x1e, 0xc1, 0x7e, 0x05, 0x28, 0xe8, 0x9c, 0x79, 0xb5,
+	0x9e, 0xd3, 0xdf, 0x0e, 0xda, 0xe5, 0x85, 0xa7, 0x74, 0xce, 0xc8, 0x03, 0x68, 0x70, 0x15, 0xd2,
+	0x68, 0xce, 0x85, 0xe7, 0x62, 0x63, 0x5b, 0x5c, 0x8d, 0x4c, 0x48, 0x3e, 0x06, 0x98, 0x66, 0x8c,
+	0x6a, 0x16, 0x85, 0x54, 0x7b, 0x1b, 0x3d, 0xa7, 0xdf, 0x3c, 0xeb, 0x0e, 0x72, 0xb1, 0x06, 0x85,
+	0x58, 0x83, 0xe7, 0x85, 0x9a, 0xc1, 0xb6, 0x45, 0x8f, 0xb4, 0xd9, 0xba, 0x48, 0xa3, 0x62, 0x6b,
+	0xfd, 0xff, 0xb7, 0x5a, 0xf4, 0x48, 0xfb, 0x9f, 0x43, 0xeb, 0x52, 0xc6, 0x5c, 0x04, 0xec, 0xfb,
+	0x05, 0x53, 0x9a, 0x74, 0xa1, 0x61, 0x64, 0xc3, 0x43, 0x38, 0x78, 0x88, 0x65, 0x6c, 0xd6, 0x52,
+	0xaa, 0xd4, 0x8d, 0xcc, 0x22, 0x7b, 0xc0, 0x65, 0xec, 0x9f, 0xc2, 0x8e, 0xe5, 0x51, 0xa9, 0x14,
+	0x8a, 0x91, 0x36, 0xb8, 0xdf, 0xdd, 0x68, 0xcb, 0x61, 0x3e, 0xfd, 0x5f, 0x9d, 0xe5, 0xed, 0x2d,
+	0x51, 0xc7, 0xb0, 0x61, 0xe8, 0x11, 0xd6, 0x3c, 0xdb, 0x1e, 0xd0, 0x94, 0x0f, 0xcc, 0xa5, 0x04,
+	0x98, 0x26, 0x9f, 0xc2, 0x4e, 0x59, 0x42, 0xe5, 0xb9, 0x3d, 0xb7, 0xdf, 0x3c, 0x3b, 0x40, 0xdc,
+	0xea, 0x95, 0x05, 0x55, 0x2c, 0xf9, 0x00, 0x1a, 0xca, 0xba, 0xc4, 0xca, 0xd9, 0xc1, 0x7d, 0x2b,
+	0x0e, 0x0a, 0x96, 0x28, 0xff, 0x1b, 0xb8, 0xf7, 0x24, 0x91, 0x13, 0x9a, 0x5c, 0x31, 0x9a, 0x4d,
+	0xbf, 0x2d, 0x34, 0x39, 0x84, 0x4d, 0x85, 0x09, 0x7b, 0x1a, 0x1b, 0x91, 0x0e, 0xd4, 0x13, 0x3e,
+	0xe7, 0x1a, 0xc5, 0x70, 0x83, 0x3c, 0x30, 0x68, 0x39, 0x9b, 0x29, 0xa6, 0xf1, 0x82, 0xdd, 0xc0,
+	0x46, 0xfe, 0x13, 0xe8, 0x54, 0xc9, 0xad, 0x04, 0x43, 0xd8, 0xcc, 0x98, 0x5a, 0x24, 0x46, 0x2b,
+	0x73, 0xb8, 0xfb, 0xd8, 0xe4, 0x0a, 0x74, 0x91, 0xe8, 0xc0, 0xc2, 0xfc, 0x3f, 0x6b, 0x40, 0x5e,
+	0x5d, 0x26, 0x04, 0x36, 0x5e, 0x72, 0x11, 0xd9, 0x1e, 0xf1, 0xdb, 0x74, 0xa8, 0xa6, 0x32, 0xcb,
+	0xfd, 0x58, 0x0b, 0xf2, 0x60, 0x9d, 0xb5, 0xdd, 0xd7, 0xb7, 0xf6, 0xc6, 0x1d, 0xd6, 0x7e, 0x07,
+	0x76, 0x4b, 0x0f, 0xd6, 0x90, 0xd6, 0x91, 0x74, 0xa7, 0x94, 0x1d, 0x9f, 0x93, 0xf7, 0xa0, 0x5d,
+	0x86, 0x21, 0xe5, 0x26, 0x52, 0xee, 0x95, 0xf2, 0xc8, 0xf8, 0x36, 0xec, 0x46, 0xec, 0x9a, 0x4f,
+	0x59, 0x18, 0xb1, 0xeb, 0x90, 0x2d, 0xb8, 0xb7, 0x85, 0xc0, 0x56, 0x9e, 0x3d, 0x67, 0xd7, 0x17,
+	0x2f, 0xc6, 0xe4, 0x04, 0x9a, 0x16, 0x85, 0x5c, 0x0d, 0x84, 0x40, 0x9e, 0x42, 0x9a, 0x13, 0x68,
+	0xc6, 0x54, 0xb3, 0x1b, 0x7a, 0x1b, 0xce, 0xe9, 0xd4, 0xdb, 0xce, 0x01, 0x36, 0xf5, 0xc5, 0xe8,
+	0x31, 0x39, 0x85, 0x56, 0x01, 0x40, 0x0a, 0x40, 0x44, 0xb1, 0xc9, 0x70, 0xf8, 0x02, 0x1e, 0x5e,
+	0x72, 0xa5, 0xc7, 0x42, 0xb3, 0x38, 0xc3, 0x0e, 0xaf, 0x34, 0xd5, 0xaa, 0x70, 0xc8, 0x29, 0xb4,
+	0x66, 0x94, 0x27, 0x66, 0xe8, 0x48, 0x91, 0xdc, 0xda, 0x99, 0xd3, 0xb4, 0xb9, 0x67, 0x22, 0xb9,
+	0x7d, 0x43, 0xb3, 0x5c, 0xc3, 0xd1, 0xfa, 0x7a, 0xd6, 0x34, 0x27, 0xd0, 0xd4, 0x52, 0xd3, 0x24,
+	0x9c, 0xca, 0x85, 0xd0, 0x76, 0x32, 0x01, 0xa6, 0x1e, 0x9b, 0x0c, 0xf9, 0x68, 0xe9, 0xaa, 0x1a,
+	0xba, 0xea, 0x18, 0x5d, 0xb5, 0xca, 0x87, 0x35, 0x34, 0x9b, 0x2f, 0xbd, 0xf5, 0x9b, 0x03, 0xde,
+	0x5d, 0xa0, 0x35, 0x37, 0xec, 0xbc, 0xee, 0x0d, 0xd7, 0xd6, 0xdf, 0x70, 0xe1, 0x59, 0xb7, 0xe4,
+	0xd9, 0x47, 0x50, 0x57, 0xa6, 0xac, 0x7d, 0xb3, 0x07, 0x6b, 0x1b, 0x0f, 0x72, 0x8c, 0x3f, 0x81,
+	0xf6, 0x67, 0x19, 0x15, 0x11, 0x17, 0xf1, 0x52, 0x1b, 0x02, 0x1b, 0x89, 0x8c, 0x65, 0xf1, 0x10,
+	0xcc, 0x37, 0xf1, 0xa1, 0x95, 0xb1, 0x98, 0x2b, 0x9d, 0x73, 0xd8, 0x7e, 0x2a, 0x39, 0x73, 0x17,
+	0x33, 0x29, 0x35, 0xcb, 0x6c, 0x3b, 0x36, 0x3a, 0xfb, 0xdb, 0x85, 0xbd, 0xb1, 0xfd, 0x0f, 0x5e,
+	0xb1, 0xcc, 0xf8, 0x8a, 0x3c, 0x85, 0x3a, 0x8e, 0x3b, 0xb2, 0x8f, 0xed, 0x95, 0x47, 0x68, 0x97,
+	0x94, 0x53, 0x79, 0x4f, 0xfe, 0x5b, 0x3f, 0xfd, 0xfe, 0xc7, 0x2f, 0x35, 0xcf, 0xbf, 0x87, 0x7f,
+	0xcd, 0xe2, 0xaf, 0x3a, 0x4c, 0x0c, 0xe8, 0x13, 0xe7, 0x7d, 0xf2, 0x15, 0x6c, 0xd9, 0xb1, 0x44,
+	0x0e, 0x5f, 0x19, 0xdc, 0x17, 0xe6, 0x07, 0xd9, 0xad, 0x0c, 0xaf, 0x25, 0xf1, 0x31, 0x12, 0xdf,
+	0x27, 0x07, 0x55, 0xe2, 0xd4, 0x92, 0x7d, 0x0d, 0x8d, 0x42, 0x9f, 0x3b, 0x89, 0x73, 0x85, 0x57,
+	0x65, 0x2c, 0x5a, 0x26, 0x87, 0x55, 0xe6, 0x49, 0x41, 0x47, 0xa1, 0x55, 0x9e, 0x42, 0xc4, 0x5b,
+	0x33, 0xb7, 0x72, 0x41, 0x1e, 0xac, 0x59, 0xb1, 0x45, 0x8e, 0xb0, 0xc8, 0x21, 0xe9, 0x54, 0x8b,
+	0xd8, 0x01, 0xfb, 0xb3, 0x03, 0x9d, 0x75, 0xcf, 0x80, 0xf4, 0x72, 0x89, 0xef, 0x7e, 0x91, 0xdd,
+	0xd3, 0xff, 0x40, 0xd8, 0xda, 0x7d, 0xac, 0xed, 0x93, 0x5e, 0xb5, 0x36, 0xff, 0x17, 0xaf, 0x86,
+	0xe8, 0xb2, 0xc9, 0x26, 0x2a, 0xf6, 0xe1, 0x3f, 0x03, 0x00, 0x1d, 0x6f, 0xd0, 0x35, 0x0f, 0x09,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -655,6 +843,8 @@ type InternalServiceClient interface {
 	Branding(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BrandingResponse, error)
 	// Perform a global search.
 	GlobalSearch(ctx context.Context, in *GlobalSearchRequest, opts ...grpc.CallOption) (*GlobalSearchResponse, error)
+	// List the delivery statistics of all integrations (global admin only).
+	ListIntegrationStats(ctx context.Context, in *ListIntegrationStatsRequest, opts ...grpc.CallOption) (*ListIntegrationStatsResponse, error)
 }
 
 type internalServiceClient struct {
@@ -701,6 +891,15 @@ func (c *internalServiceClient) GlobalSearch(ctx context.Context, in *GlobalSear
 	return out, nil
 }
 
+func (c *internalServiceClient) ListIntegrationStats(ctx context.Context, in *ListIntegrationStatsRequest, opts ...grpc.CallOption) (*ListIntegrationStatsResponse, error) {
+	out := new(ListIntegrationStatsResponse)
+	err := c.cc.Invoke(ctx, "/api.InternalService/ListIntegrationStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InternalServiceServer is the server API for InternalService service.
 type InternalServiceServer interface {
 	// Log in a user
@@ -711,6 +910,8 @@ type InternalServiceServer interface {
 	Branding(context.Context, *empty.Empty) (*BrandingResponse, error)
 	// Perform a global search.
 	GlobalSearch(context.Context, *GlobalSearchRequest) (*GlobalSearchResponse, error)
+	// List the delivery statistics of all integrations (global admin only).
+	ListIntegrationStats(context.Context, *ListIntegrationStatsRequest) (*ListIntegrationStatsResponse, error)
 }
 
 func RegisterInternalServiceServer(s *grpc.Server, srv InternalServiceServer) {
@@ -789,6 +990,24 @@ func _InternalService_GlobalSearch_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InternalService_ListIntegrationStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIntegrationStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServiceServer).ListIntegrationStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.InternalService/ListIntegrationStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServiceServer).ListIntegrationStats(ctx, req.(*ListIntegrationStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _InternalService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.InternalService",
 	HandlerType: (*InternalServiceServer)(nil),
@@ -809,6 +1028,10 @@ var _InternalService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GlobalSearch",
 			Handler:    _InternalService_GlobalSearch_Handler,
 		},
+		{
+			MethodName: "ListIntegrationStats",
+			Handler:    _InternalService_ListIntegrationStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal.proto",
@@ -343,10 +343,39 @@ type DeviceProfile struct {
 	// Payload decoder script.
 	// Depending the codec, it is possible to provide a script which implements
 	// the decoder function.
-	PayloadDecoderScript string   `protobuf:"bytes,26,opt,name=payload_decoder_script,json=payloadDecoderScript,proto3" json:"payload_decoder_script,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	PayloadDecoderScript string `protobuf:"bytes,26,opt,name=payload_decoder_script,json=payloadDecoderScript,proto3" json:"payload_decoder_script,omitempty"`
+	// Payload codecs per fPort range.
+	// When the fPort of a payload is within one of these ranges, the codec
+	// of this range is used instead of the payload codec defined above.
+	PayloadCodecFPorts []*DeviceProfilePayloadCodec `protobuf:"bytes,27,rep,name=payload_codec_f_ports,json=payloadCodecFPorts,proto3" json:"payload_codec_f_ports,omitempty"`
+	// Payload codec protobuf descriptor set.
+	// Binary encoded FileDescriptorSet (e.g. generated using
+	// protoc --descriptor_set_out --include_imports) defining the messages
+	// used by the PROTOBUF payload codec.
+	PayloadCodecDescriptorSet []byte `protobuf:"bytes,28,opt,name=payload_codec_descriptor_set,json=payloadCodecDescriptorSet,proto3" json:"payload_codec_descriptor_set,omitempty"`
+	// Codec ID.
+	// When set, the codec (of the codec library) is used instead of the
+	// payload codec defined above. The payload codecs per fPort range still
+	// take precedence.
+	CodecId int64 `protobuf:"varint,29,opt,name=codec_id,json=codecID,proto3" json:"codec_id,omitempty"`
+	// Codec version.
+	// Set to 0 to always use the latest published version of the codec.
+	CodecVersion uint32 `protobuf:"varint,30,opt,name=codec_version,json=codecVersion,proto3" json:"codec_version,omitempty"`
+	// Payload JSON Schema.
+	// When set, the decoded objects are validated against this JSON Schema.
+	// On a validation error, an error notification of type SCHEMA is sent.
+	PayloadJsonSchema string `protobuf:"bytes,31,opt,name=payload_json_schema,json=payloadJSONSchema,proto3" json:"payload_json_schema,omitempty"`
+	// Drop invalid payloads.
+	// When set, uplinks of which the decoded object is not valid against the
+	// payload JSON Schema are dropped. Else, they are forwarded with the
+	// validation error attached.
+	DropInvalidPayloads bool `protobuf:"varint,32,opt,name=drop_invalid_payloads,json=dropInvalidPayloads,proto3" json:"drop_invalid_payloads,omitempty"`
+	// Payload codec WebAssembly module.
+	// Binary WebAssembly module, used by the WASM payload codec.
+	PayloadCodecWasmModule []byte   `protobuf:"bytes,33,opt,name=payload_codec_wasm_module,json=payloadCodecWASMModule,proto3" json:"payload_codec_wasm_module,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
 }
 
 func (m *DeviceProfile) Reset()         { *m = DeviceProfile{} }
@@ -556,75 +585,215 @@ func (m *DeviceProfile) GetPayloadDecoderScript() string {
 	return ""
 }
 
+func (m *DeviceProfile) GetPayloadCodecFPorts() []*DeviceProfilePayloadCodec {
+	if m != nil {
+		return m.PayloadCodecFPorts
+	}
+	return nil
+}
+
+func (m *DeviceProfile) GetPayloadCodecDescriptorSet() []byte {
+	if m != nil {
+		return m.PayloadCodecDescriptorSet
+	}
+	return nil
+}
+
+func (m *DeviceProfile) GetCodecId() int64 {
+	if m != nil {
+		return m.CodecId
+	}
+	return 0
+}
+
+func (m *DeviceProfile) GetCodecVersion() uint32 {
+	if m != nil {
+		return m.CodecVersion
+	}
+	return 0
+}
+
+func (m *DeviceProfile) GetPayloadJsonSchema() string {
+	if m != nil {
+		return m.PayloadJsonSchema
+	}
+	return ""
+}
+
+func (m *DeviceProfile) GetDropInvalidPayloads() bool {
+	if m != nil {
+		return m.DropInvalidPayloads
+	}
+	return false
+}
+
+func (m *DeviceProfile) GetPayloadCodecWasmModule() []byte {
+	if m != nil {
+		return m.PayloadCodecWasmModule
+	}
+	return nil
+}
+
+type DeviceProfilePayloadCodec struct {
+	// First fPort of the range (inclusive).
+	FPortFrom uint32 `protobuf:"varint,1,opt,name=f_port_from,json=fPortFrom,proto3" json:"f_port_from,omitempty"`
+	// Last fPort of the range (inclusive).
+	FPortTo uint32 `protobuf:"varint,2,opt,name=f_port_to,json=fPortTo,proto3" json:"f_port_to,omitempty"`
+	// Payload codec.
+	PayloadCodec string `protobuf:"bytes,3,opt,name=payload_codec,json=payloadCodec,proto3" json:"payload_codec,omitempty"`
+	// Payload encoder script.
+	PayloadEncoderScript string `protobuf:"bytes,4,opt,name=payload_encoder_script,json=payloadEncoderScript,proto3" json:"payload_encoder_script,omitempty"`
+	// Payload decoder script.
+	PayloadDecoderScript string   `protobuf:"bytes,5,opt,name=payload_decoder_script,json=payloadDecoderScript,proto3" json:"payload_decoder_script,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceProfilePayloadCodec) Reset()         { *m = DeviceProfilePayloadCodec{} }
+func (m *DeviceProfilePayloadCodec) String() string { return proto.CompactTextString(m) }
+func (*DeviceProfilePayloadCodec) ProtoMessage()    {}
+func (*DeviceProfilePayloadCodec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610db3cccb08234, []int{2}
+}
+
+func (m *DeviceProfilePayloadCodec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceProfilePayloadCodec.Unmarshal(m, b)
+}
+func (m *DeviceProfilePayloadCodec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceProfilePayloadCodec.Marshal(b, m, deterministic)
+}
+func (m *DeviceProfilePayloadCodec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceProfilePayloadCodec.Merge(m, src)
+}
+func (m *DeviceProfilePayloadCodec) XXX_Size() int {
+	return xxx_messageInfo_DeviceProfilePayloadCodec.Size(m)
+}
+func (m *DeviceProfilePayloadCodec) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceProfilePayloadCodec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceProfilePayloadCodec proto.InternalMessageInfo
+
+func (m *DeviceProfilePayloadCodec) GetFPortFrom() uint32 {
+	if m != nil {
+		return m.FPortFrom
+	}
+	return 0
+}
+
+func (m *DeviceProfilePayloadCodec) GetFPortTo() uint32 {
+	if m != nil {
+		return m.FPortTo
+	}
+	return 0
+}
+
+func (m *DeviceProfilePayloadCodec) GetPayloadCodec() string {
+	if m != nil {
+		return m.PayloadCodec
+	}
+	return ""
+}
+
+func (m *DeviceProfilePayloadCodec) GetPayloadEncoderScript() string {
+	if m != nil {
+		return m.PayloadEncoderScript
+	}
+	return ""
+}
+
+func (m *DeviceProfilePayloadCodec) GetPayloadDecoderScript() string {
+	if m != nil {
+		return m.PayloadDecoderScript
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("api.RatePolicy", RatePolicy_name, RatePolicy_value)
 	proto.RegisterType((*ServiceProfile)(nil), "api.ServiceProfile")
 	proto.RegisterType((*DeviceProfile)(nil), "api.DeviceProfile")
+	proto.RegisterType((*DeviceProfilePayloadCodec)(nil), "api.DeviceProfilePayloadCodec")
 }
 
 func init() { proto.RegisterFile("profiles.proto", fileDescriptor_9610db3cccb08234) }
 
 var fileDescriptor_9610db3cccb08234 = []byte{
-	// 971 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xdb, 0x52, 0xe3, 0x36,
-	0x18, 0x6e, 0x80, 0x85, 0x44, 0xc4, 0x49, 0x10, 0x87, 0x15, 0xdb, 0x53, 0xca, 0x76, 0xda, 0xcc,
-	0xce, 0x94, 0x96, 0xd0, 0x4e, 0xa7, 0x97, 0x4b, 0xcc, 0x32, 0xb4, 0x65, 0x36, 0xa3, 0x74, 0xba,
-	0x97, 0x1a, 0x61, 0xfd, 0x09, 0x6a, 0x6c, 0xcb, 0xc8, 0x4a, 0x48, 0x78, 0xd1, 0x3e, 0x43, 0xdf,
-	0xa2, 0xa3, 0xdf, 0xce, 0x01, 0x76, 0x7a, 0xdf, 0xbb, 0xf8, 0x3b, 0xe8, 0xf3, 0x2f, 0xf9, 0x53,
-	0x48, 0x23, 0xb3, 0x66, 0xa8, 0x63, 0xc8, 0x4f, 0x33, 0x6b, 0x9c, 0xa1, 0x9b, 0x32, 0xd3, 0x27,
-	0x7f, 0x6f, 0x93, 0xc6, 0x00, 0xec, 0x54, 0x47, 0xd0, 0x2f, 0x68, 0xda, 0x20, 0x1b, 0x5a, 0xb1,
-	0x4a, 0xbb, 0xd2, 0xa9, 0xf1, 0x0d, 0xad, 0x28, 0x25, 0x5b, 0xa9, 0x4c, 0x80, 0x1d, 0x22, 0x82,
-	0xbf, 0xe9, 0xb7, 0xa4, 0x69, 0xec, 0x48, 0xa6, 0xfa, 0x51, 0x3a, 0x6d, 0x52, 0xa1, 0x15, 0x3b,
-	0x6a, 0x57, 0x3a, 0x9b, 0xbc, 0xb1, 0x0e, 0x5f, 0x87, 0xf4, 0x0d, 0xd9, 0x4b, 0xc1, 0x3d, 0x18,
-	0x3b, 0x16, 0x39, 0xd8, 0x29, 0x58, 0x2f, 0x7d, 0x89, 0xd2, 0x66, 0x49, 0x0c, 0x10, 0xbf, 0x0e,
-	0xe9, 0x4b, 0xb2, 0x33, 0x89, 0x85, 0x95, 0x0e, 0xd8, 0x46, 0xbb, 0xd2, 0x09, 0xf8, 0xf6, 0x24,
-	0xe6, 0xd2, 0x01, 0xfd, 0x9a, 0x34, 0x26, 0xb1, 0xb8, 0x9d, 0x44, 0x63, 0x70, 0x22, 0xd7, 0x8f,
-	0xc0, 0x36, 0x91, 0xaf, 0x4f, 0xe2, 0x0b, 0x04, 0x07, 0xfa, 0x11, 0xe8, 0x4f, 0xa8, 0xf2, 0x76,
-	0x91, 0x99, 0x58, 0x47, 0x73, 0xb6, 0xd5, 0xae, 0x74, 0x1a, 0xdd, 0xe6, 0xa9, 0xcc, 0xf4, 0xa9,
-	0x5f, 0xa8, 0x8f, 0xb0, 0xb7, 0xad, 0x9e, 0x7c, 0xaa, 0x2a, 0x53, 0x5f, 0x14, 0xa9, 0x6a, 0x99,
-	0xaa, 0x9e, 0xa6, 0x6e, 0x17, 0xa9, 0xea, 0x59, 0xaa, 0x7a, 0x9a, 0xba, 0xf3, 0x1f, 0xa9, 0x6a,
-	0x3d, 0xf5, 0x1b, 0xd2, 0x94, 0x4a, 0x89, 0xd1, 0x83, 0x48, 0xc0, 0x49, 0x25, 0x9d, 0x64, 0xd5,
-	0x76, 0xa5, 0x53, 0xe5, 0x81, 0x54, 0xea, 0xea, 0xc3, 0x0d, 0x38, 0x19, 0x4a, 0x27, 0xe9, 0x77,
-	0x64, 0x5f, 0xc1, 0x54, 0xe4, 0x4e, 0xba, 0x49, 0x2e, 0x2c, 0xdc, 0x8b, 0xa1, 0x85, 0x7b, 0x56,
-	0xc3, 0x37, 0x69, 0x29, 0x98, 0x0e, 0x90, 0xe1, 0x70, 0xff, 0xce, 0xc2, 0x3d, 0xfd, 0x85, 0x1c,
-	0x5b, 0xc8, 0x8c, 0x75, 0x62, 0xcd, 0x75, 0x2b, 0x9d, 0x03, 0x3b, 0x67, 0x04, 0x03, 0x8e, 0x0a,
-	0x41, 0xb8, 0xb0, 0x5e, 0x14, 0x2c, 0xfd, 0x99, 0xb0, 0x8f, 0xad, 0x89, 0xb4, 0x23, 0x9d, 0xb2,
-	0x5d, 0x74, 0x1e, 0x3e, 0x73, 0xde, 0x20, 0x49, 0x0f, 0xc9, 0xb6, 0xb2, 0x22, 0xd1, 0x29, 0xab,
-	0xe3, 0x5b, 0xbd, 0x50, 0xf6, 0x66, 0x05, 0xcb, 0x19, 0x0b, 0x96, 0xb0, 0x9c, 0xd1, 0xaf, 0x48,
-	0x3d, 0xba, 0x93, 0x69, 0x0a, 0xb1, 0x48, 0x64, 0x3e, 0x66, 0x8d, 0x76, 0xa5, 0x53, 0xe7, 0xbb,
-	0x25, 0x76, 0x23, 0xf3, 0x31, 0xfd, 0x9c, 0x90, 0xcc, 0x0a, 0x19, 0xc7, 0xe6, 0x01, 0x14, 0x6b,
-	0x62, 0x76, 0x2d, 0xb3, 0x6f, 0x0b, 0xc0, 0xd3, 0x77, 0x2b, 0xba, 0x55, 0xd0, 0x77, 0xeb, 0xb4,
-	0x95, 0x4b, 0x7a, 0xaf, 0xa0, 0xad, 0x5c, 0xd0, 0x5f, 0x90, 0xdd, 0xf4, 0x61, 0x2c, 0x46, 0x60,
-	0x44, 0x6c, 0x22, 0x46, 0x0b, 0x3e, 0x7d, 0x18, 0x5f, 0x81, 0xf9, 0xdd, 0x44, 0xde, 0xee, 0xa4,
-	0x1d, 0x81, 0x13, 0x19, 0x58, 0xb6, 0x8f, 0xaf, 0x5e, 0x2b, 0x90, 0xfe, 0x25, 0xa7, 0x1d, 0xd2,
-	0x4a, 0x74, 0xea, 0xcf, 0x4d, 0xe9, 0x29, 0xd8, 0x5c, 0xbb, 0x39, 0x3b, 0x40, 0x51, 0x23, 0xd1,
-	0xe9, 0xd5, 0x87, 0x70, 0x81, 0x9e, 0xfc, 0xb3, 0x43, 0x82, 0x10, 0xfe, 0x17, 0xc5, 0xea, 0x90,
-	0x56, 0x3e, 0xc9, 0xfc, 0xd9, 0xe5, 0x22, 0x8a, 0x65, 0x9e, 0x8b, 0x5b, 0x6c, 0x58, 0x95, 0x37,
-	0x16, 0x78, 0xcf, 0xc3, 0x17, 0xfe, 0xb3, 0x2c, 0x05, 0xc2, 0xe9, 0x04, 0xcc, 0xc4, 0x95, 0x55,
-	0x0b, 0x10, 0xbe, 0xf8, 0xa3, 0x00, 0xfd, 0x8a, 0x99, 0x4e, 0x47, 0x22, 0x8f, 0x0d, 0x6e, 0x94,
-	0x36, 0x0a, 0xdb, 0x16, 0xf0, 0x86, 0xc7, 0x07, 0xb1, 0x71, 0x7d, 0x44, 0x69, 0x9b, 0xd4, 0x57,
-	0x4a, 0x65, 0xcb, 0x8e, 0x91, 0x85, 0x2a, 0xe4, 0xbe, 0x67, 0x2b, 0x05, 0x7e, 0xdd, 0x65, 0xcf,
-	0x16, 0x1a, 0xfc, 0xb2, 0x3f, 0x9e, 0x21, 0xc2, 0xa6, 0x3d, 0x9f, 0xa1, 0xb7, 0x9a, 0x21, 0x5a,
-	0xce, 0x50, 0x5d, 0x9b, 0xa1, 0xb7, 0x98, 0xe1, 0x4b, 0xb2, 0x9b, 0xc8, 0x48, 0xe0, 0x79, 0x99,
-	0x14, 0x2b, 0x55, 0xe3, 0x24, 0x91, 0xd1, 0x9f, 0x05, 0x42, 0x4f, 0xc9, 0xbe, 0x85, 0x91, 0xc8,
-	0xa4, 0x95, 0x89, 0xef, 0xde, 0x54, 0xa3, 0x90, 0xa0, 0x70, 0xcf, 0xc2, 0xa8, 0x8f, 0x0c, 0x2f,
-	0x09, 0xfa, 0x19, 0x21, 0x76, 0x26, 0x14, 0xc4, 0x72, 0x2e, 0xce, 0xb0, 0x33, 0x01, 0xaf, 0xda,
-	0x59, 0xe8, 0x81, 0x33, 0xfa, 0x9a, 0x34, 0x3c, 0x6b, 0x85, 0x19, 0x0e, 0x73, 0x70, 0xe2, 0xac,
-	0xac, 0xcb, 0xae, 0x9d, 0x85, 0xfc, 0x3d, 0x62, 0x67, 0xf4, 0x84, 0x04, 0x5e, 0x24, 0x9d, 0xc4,
-	0x1b, 0xa5, 0x5b, 0x76, 0xc7, 0x6b, 0xa4, 0x93, 0xfe, 0xfe, 0xe8, 0xd2, 0x57, 0xa4, 0x66, 0x67,
-	0xb8, 0x51, 0xa2, 0x8b, 0xf5, 0x09, 0xf8, 0x8e, 0x9d, 0xf9, 0x4d, 0xea, 0xd2, 0x1f, 0xc8, 0xc1,
-	0x50, 0x46, 0xce, 0xd8, 0xb9, 0xc8, 0x2c, 0xf8, 0x18, 0xaf, 0xcb, 0x59, 0xb3, 0xbd, 0xd9, 0x09,
-	0x38, 0x2d, 0xb9, 0x3e, 0x52, 0xde, 0x91, 0xd3, 0x63, 0x52, 0x4d, 0xe4, 0x4c, 0x80, 0xb6, 0x19,
-	0x76, 0x29, 0xe0, 0x3b, 0x89, 0x9c, 0x5d, 0x5e, 0xf3, 0xbe, 0x3f, 0x18, 0x4f, 0xa9, 0x89, 0x9b,
-	0x8b, 0x68, 0x1e, 0xc5, 0x80, 0x6d, 0x0a, 0x78, 0x3d, 0x91, 0xb3, 0x70, 0xe2, 0xe6, 0x3d, 0x8f,
-	0xd1, 0xd7, 0x24, 0x58, 0x1e, 0xcc, 0x5f, 0x46, 0xa7, 0x65, 0xa5, 0xea, 0x0b, 0xf0, 0x57, 0xa3,
-	0x53, 0xfa, 0x29, 0xa9, 0xd9, 0xa1, 0xb0, 0x30, 0xf2, 0x1b, 0xb8, 0x8f, 0x1b, 0x58, 0xb5, 0x43,
-	0x8e, 0xcf, 0xf4, 0x7b, 0x72, 0xb0, 0x5c, 0xe1, 0xbc, 0x7b, 0xab, 0x9d, 0x18, 0x8a, 0x28, 0x75,
-	0xd8, 0xab, 0x2a, 0xdf, 0x5b, 0x70, 0xe7, 0xdd, 0x0b, 0xed, 0xde, 0xf5, 0x52, 0xe7, 0x23, 0x33,
-	0x39, 0x8f, 0x8d, 0x54, 0x22, 0x32, 0x0a, 0x22, 0xc6, 0x70, 0xc5, 0x7a, 0x09, 0xf6, 0x3c, 0x46,
-	0x7f, 0x24, 0x47, 0x0b, 0x11, 0xa4, 0x5e, 0x66, 0x45, 0x1e, 0x59, 0x9d, 0x39, 0x76, 0x8c, 0xea,
-	0x83, 0x92, 0xbd, 0x2c, 0xc8, 0x01, 0x72, 0xeb, 0x2e, 0x05, 0x4f, 0x5c, 0xaf, 0x9e, 0xb8, 0x42,
-	0x58, 0x73, 0xbd, 0x69, 0x13, 0xb2, 0x76, 0xb7, 0x57, 0xc9, 0x56, 0xc8, 0xdf, 0xf7, 0x5b, 0x9f,
-	0xf8, 0x5f, 0x37, 0x6f, 0xf9, 0x6f, 0xad, 0xca, 0xed, 0x36, 0xfe, 0xe7, 0x9e, 0xff, 0x1b, 0x00,
-	0x00, 0xff, 0xff, 0x71, 0xae, 0x73, 0xce, 0x85, 0x07, 0x00, 0x00,
+	// 1186 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xdd, 0x72, 0xdb, 0x36,
+	0x13, 0xfd, 0x14, 0x3b, 0x96, 0x04, 0x8b, 0xb4, 0x0d, 0xff, 0x04, 0xce, 0xaf, 0xe2, 0x7c, 0xd3,
+	0x6a, 0x32, 0x53, 0xb7, 0x56, 0xda, 0xe9, 0xf4, 0xaa, 0x13, 0x9b, 0x71, 0xc6, 0x69, 0xd5, 0xa8,
+	0x50, 0xa6, 0xb9, 0xc4, 0xc0, 0x04, 0x24, 0x23, 0x26, 0x09, 0x1a, 0x84, 0xfe, 0xf2, 0xa2, 0xbd,
+	0xe9, 0x03, 0xf4, 0x31, 0x3a, 0x58, 0x92, 0x12, 0x95, 0xd4, 0x17, 0xed, 0x55, 0xef, 0xc4, 0x73,
+	0xce, 0x72, 0x77, 0xb1, 0x7b, 0x20, 0x22, 0x3f, 0x35, 0x7a, 0xa8, 0x22, 0x99, 0x1d, 0xa7, 0x46,
+	0x5b, 0x8d, 0xd7, 0x78, 0xaa, 0x8e, 0x7e, 0xdf, 0x40, 0xfe, 0x40, 0x9a, 0x89, 0x0a, 0x65, 0x3f,
+	0xa7, 0xb1, 0x8f, 0xee, 0x28, 0x41, 0x6a, 0xed, 0x5a, 0xa7, 0x49, 0xef, 0x28, 0x81, 0x31, 0x5a,
+	0x4f, 0x78, 0x2c, 0xc9, 0x3e, 0x20, 0xf0, 0x1b, 0x7f, 0x89, 0xb6, 0xb4, 0x19, 0xf1, 0x44, 0x7d,
+	0xe4, 0x56, 0xe9, 0x84, 0x29, 0x41, 0x0e, 0xda, 0xb5, 0xce, 0x1a, 0xf5, 0xab, 0xf0, 0x45, 0x80,
+	0x9f, 0xa3, 0x9d, 0x44, 0xda, 0xa9, 0x36, 0xd7, 0x2c, 0x93, 0x66, 0x22, 0x8d, 0x93, 0xde, 0x03,
+	0xe9, 0x56, 0x41, 0x0c, 0x00, 0xbf, 0x08, 0xf0, 0x3d, 0x54, 0x1f, 0x47, 0xcc, 0x70, 0x2b, 0xc9,
+	0x9d, 0x76, 0xad, 0xe3, 0xd1, 0x8d, 0x71, 0x44, 0xb9, 0x95, 0xf8, 0xff, 0xc8, 0x1f, 0x47, 0xec,
+	0x72, 0x1c, 0x5e, 0x4b, 0xcb, 0x32, 0xf5, 0x51, 0x92, 0x35, 0xe0, 0x5b, 0xe3, 0xe8, 0x14, 0xc0,
+	0x81, 0xfa, 0x28, 0xf1, 0x77, 0xc8, 0x2f, 0xc2, 0x59, 0xaa, 0x23, 0x15, 0xce, 0xc9, 0x7a, 0xbb,
+	0xd6, 0xf1, 0xbb, 0x5b, 0xc7, 0x3c, 0x55, 0xc7, 0xee, 0x45, 0x7d, 0x80, 0x5d, 0xd8, 0xf2, 0xc9,
+	0x65, 0x15, 0x45, 0xd6, 0xbb, 0x79, 0x56, 0xb1, 0xc8, 0x2a, 0x56, 0xb3, 0x6e, 0xe4, 0x59, 0xc5,
+	0x27, 0x59, 0xc5, 0x6a, 0xd6, 0xfa, 0x2d, 0x59, 0x45, 0x35, 0xeb, 0x17, 0x68, 0x8b, 0x0b, 0xc1,
+	0x46, 0x53, 0x16, 0x4b, 0xcb, 0x05, 0xb7, 0x9c, 0x34, 0xda, 0xb5, 0x4e, 0x83, 0x7a, 0x5c, 0x88,
+	0xd7, 0xef, 0x7b, 0xd2, 0xf2, 0x80, 0x5b, 0x8e, 0xbf, 0x42, 0xbb, 0x42, 0x4e, 0x58, 0x66, 0xb9,
+	0x1d, 0x67, 0xcc, 0xc8, 0x1b, 0x36, 0x34, 0xf2, 0x86, 0x34, 0xa1, 0x92, 0x6d, 0x21, 0x27, 0x03,
+	0x60, 0xa8, 0xbc, 0x39, 0x37, 0xf2, 0x06, 0xff, 0x80, 0x0e, 0x8d, 0x4c, 0xb5, 0xb1, 0xac, 0x12,
+	0x75, 0xc9, 0xad, 0x95, 0x66, 0x4e, 0x10, 0x24, 0x38, 0xc8, 0x05, 0x41, 0x19, 0x7a, 0x9a, 0xb3,
+	0xf8, 0x7b, 0x44, 0x3e, 0x0f, 0x8d, 0xb9, 0x19, 0xa9, 0x84, 0x6c, 0x42, 0xe4, 0xfe, 0x27, 0x91,
+	0x3d, 0x20, 0xf1, 0x3e, 0xda, 0x10, 0x86, 0xc5, 0x2a, 0x21, 0x2d, 0xa8, 0xea, 0xae, 0x30, 0xbd,
+	0x25, 0xcc, 0x67, 0xc4, 0x5b, 0xc0, 0x7c, 0x86, 0x9f, 0xa2, 0x56, 0x78, 0xc5, 0x93, 0x44, 0x46,
+	0x2c, 0xe6, 0xd9, 0x35, 0xf1, 0xdb, 0xb5, 0x4e, 0x8b, 0x6e, 0x16, 0x58, 0x8f, 0x67, 0xd7, 0xf8,
+	0x11, 0x42, 0xa9, 0x61, 0x3c, 0x8a, 0xf4, 0x54, 0x0a, 0xb2, 0x05, 0xb9, 0x9b, 0xa9, 0x79, 0x99,
+	0x03, 0x8e, 0xbe, 0x5a, 0xd2, 0xdb, 0x39, 0x7d, 0x55, 0xa5, 0x0d, 0x5f, 0xd0, 0x3b, 0x39, 0x6d,
+	0x78, 0x49, 0x3f, 0x46, 0x9b, 0xc9, 0xf4, 0x9a, 0x8d, 0xa4, 0x66, 0x91, 0x0e, 0x09, 0xce, 0xf9,
+	0x64, 0x7a, 0xfd, 0x5a, 0xea, 0x9f, 0x75, 0xe8, 0xc2, 0x2d, 0x37, 0x23, 0x69, 0x59, 0x2a, 0x0d,
+	0xd9, 0x85, 0xd2, 0x9b, 0x39, 0xd2, 0x7f, 0x45, 0x71, 0x07, 0x6d, 0xc7, 0x2a, 0x71, 0x73, 0x13,
+	0x6a, 0x22, 0x4d, 0xa6, 0xec, 0x9c, 0xec, 0x81, 0xc8, 0x8f, 0x55, 0xf2, 0xfa, 0x7d, 0x50, 0xa2,
+	0x47, 0x7f, 0x20, 0xe4, 0x05, 0xf2, 0x3f, 0x61, 0xac, 0x0e, 0xda, 0xce, 0xc6, 0xa9, 0x9b, 0x5d,
+	0xc6, 0xc2, 0x88, 0x67, 0x19, 0xbb, 0x04, 0x87, 0x35, 0xa8, 0x5f, 0xe2, 0x67, 0x0e, 0x3e, 0x75,
+	0x6b, 0x59, 0x08, 0x98, 0x55, 0xb1, 0xd4, 0x63, 0x5b, 0x58, 0xcd, 0x03, 0xf8, 0xf4, 0x5d, 0x0e,
+	0xba, 0x37, 0xa6, 0x2a, 0x19, 0xb1, 0x2c, 0xd2, 0x70, 0x50, 0x4a, 0x0b, 0x70, 0x9b, 0x47, 0x7d,
+	0x87, 0x0f, 0x22, 0x6d, 0xfb, 0x80, 0xe2, 0x36, 0x6a, 0x2d, 0x95, 0xc2, 0x14, 0x1e, 0x43, 0xa5,
+	0x2a, 0xa0, 0xce, 0x67, 0x4b, 0x05, 0x6c, 0x77, 0xe1, 0xb3, 0x52, 0x03, 0x9b, 0xfd, 0x79, 0x0f,
+	0x21, 0xa9, 0xff, 0x4d, 0x0f, 0x67, 0xcb, 0x1e, 0xc2, 0x45, 0x0f, 0x8d, 0x4a, 0x0f, 0x67, 0x65,
+	0x0f, 0x4f, 0xd0, 0x66, 0xcc, 0x43, 0x06, 0xf3, 0xd2, 0x09, 0x58, 0xaa, 0x49, 0x51, 0xcc, 0xc3,
+	0xdf, 0x72, 0x04, 0x1f, 0xa3, 0x5d, 0x23, 0x47, 0x2c, 0xe5, 0x86, 0xc7, 0xce, 0x7b, 0x13, 0x05,
+	0x42, 0x04, 0xc2, 0x1d, 0x23, 0x47, 0x7d, 0x60, 0x68, 0x41, 0xe0, 0x87, 0x08, 0x99, 0x19, 0x13,
+	0x32, 0xe2, 0x73, 0x76, 0x02, 0x9e, 0xf1, 0x68, 0xc3, 0xcc, 0x02, 0x07, 0x9c, 0xe0, 0x67, 0xc8,
+	0x77, 0xac, 0x61, 0x7a, 0x38, 0xcc, 0xa4, 0x65, 0x27, 0x85, 0x5d, 0x36, 0xcd, 0x2c, 0xa0, 0x6f,
+	0x01, 0x3b, 0xc1, 0x47, 0xc8, 0x73, 0x22, 0x6e, 0x39, 0xdc, 0x28, 0x5d, 0xe2, 0x2d, 0x34, 0xdc,
+	0x72, 0x77, 0x7f, 0x74, 0xf1, 0x7d, 0xd4, 0x34, 0x33, 0x38, 0x28, 0xd6, 0x05, 0xfb, 0x78, 0xb4,
+	0x6e, 0x66, 0xee, 0x90, 0xba, 0xf8, 0x1b, 0xb4, 0x37, 0xe4, 0xa1, 0xd5, 0x66, 0xce, 0x52, 0x23,
+	0x5d, 0x1a, 0xa7, 0xcb, 0xc8, 0x56, 0x7b, 0xad, 0xe3, 0x51, 0x5c, 0x70, 0x7d, 0xa0, 0x5c, 0x44,
+	0x86, 0x0f, 0x51, 0x23, 0xe6, 0x33, 0x26, 0x95, 0x49, 0xc1, 0x4b, 0x1e, 0xad, 0xc7, 0x7c, 0xf6,
+	0xea, 0x82, 0xf6, 0xdd, 0x60, 0x1c, 0x25, 0xc6, 0x76, 0xce, 0xc2, 0x79, 0x18, 0x49, 0x70, 0x93,
+	0x47, 0x5b, 0x31, 0x9f, 0x05, 0x63, 0x3b, 0x3f, 0x73, 0x18, 0x7e, 0x86, 0xbc, 0xc5, 0x60, 0x3e,
+	0x68, 0x95, 0x14, 0x96, 0x6a, 0x95, 0xe0, 0x1b, 0xad, 0x12, 0xfc, 0x00, 0x35, 0xcd, 0x90, 0x19,
+	0x39, 0x72, 0x07, 0xb8, 0x0b, 0x07, 0xd8, 0x30, 0x43, 0x0a, 0xcf, 0xf8, 0x6b, 0xb4, 0xb7, 0x78,
+	0xc3, 0x8b, 0xee, 0xa5, 0xb2, 0x6c, 0xc8, 0xc2, 0xc4, 0x82, 0xaf, 0x1a, 0x74, 0xa7, 0xe4, 0x5e,
+	0x74, 0x4f, 0x95, 0x3d, 0x3f, 0x4b, 0xac, 0x4b, 0x99, 0xf2, 0x79, 0xa4, 0xb9, 0x60, 0xa1, 0x16,
+	0x32, 0x24, 0x04, 0xde, 0xd8, 0x2a, 0xc0, 0x33, 0x87, 0xe1, 0x6f, 0xd1, 0x41, 0x29, 0x92, 0x89,
+	0x93, 0x19, 0x96, 0x85, 0x46, 0xa5, 0x96, 0x1c, 0x82, 0x7a, 0xaf, 0x60, 0x5f, 0xe5, 0xe4, 0x00,
+	0xb8, 0x6a, 0x94, 0x90, 0x2b, 0x51, 0xf7, 0x57, 0xa2, 0x02, 0x59, 0x8d, 0xfa, 0x15, 0xed, 0xaf,
+	0x14, 0xc4, 0x86, 0x0c, 0x4a, 0x26, 0x0f, 0xda, 0x6b, 0x9d, 0xcd, 0xee, 0x63, 0xf8, 0x2f, 0x58,
+	0xb9, 0x0c, 0xfa, 0x95, 0x52, 0x29, 0xae, 0x16, 0x7e, 0xde, 0x77, 0x91, 0xf8, 0x47, 0xf4, 0x70,
+	0xf5, 0x95, 0x42, 0xe6, 0x85, 0x68, 0xc3, 0x32, 0x69, 0xc9, 0x43, 0xb8, 0x37, 0x0f, 0xab, 0x91,
+	0xc1, 0x42, 0x31, 0x90, 0xd6, 0x0d, 0x36, 0x0f, 0x54, 0x82, 0x3c, 0x82, 0x7b, 0xa1, 0x0e, 0xcf,
+	0x17, 0x81, 0x3b, 0xbf, 0x9c, 0x2a, 0x77, 0xff, 0x71, 0x3e, 0x57, 0x00, 0x2b, 0xdb, 0x5f, 0x16,
+	0xf0, 0x21, 0xd3, 0x09, 0xcb, 0xc2, 0x2b, 0x19, 0x73, 0xf2, 0x24, 0xdf, 0xfe, 0x82, 0x7a, 0x33,
+	0x78, 0xfb, 0xcb, 0x00, 0x08, 0xdc, 0x45, 0xfb, 0xc2, 0xe8, 0x94, 0xa9, 0x64, 0xc2, 0x23, 0x25,
+	0x58, 0xa1, 0xc8, 0x48, 0x1b, 0xc6, 0xb8, 0xeb, 0xc8, 0x8b, 0x9c, 0x2b, 0x9a, 0xcf, 0xdc, 0xdf,
+	0xd5, 0x6a, 0x93, 0x53, 0x9e, 0xc5, 0x2c, 0xd6, 0x62, 0x1c, 0x49, 0xf2, 0x14, 0x3a, 0x3c, 0xa8,
+	0x76, 0xf8, 0xfe, 0xe5, 0xa0, 0xd7, 0x03, 0xf6, 0xe8, 0xcf, 0x1a, 0x3a, 0xbc, 0xf5, 0x44, 0xdd,
+	0x2d, 0x9f, 0x8f, 0x80, 0x0d, 0x8d, 0x8e, 0xe1, 0xce, 0xf5, 0x68, 0x73, 0xe8, 0x8e, 0xf6, 0xdc,
+	0xe8, 0xd8, 0x79, 0xa8, 0xe0, 0xad, 0x2e, 0x3e, 0x36, 0xea, 0xc0, 0xbe, 0xd3, 0x9f, 0x6f, 0xd7,
+	0xda, 0x3f, 0xda, 0xae, 0xf5, 0x7f, 0xb5, 0x5d, 0x77, 0x6f, 0xdf, 0xae, 0xe7, 0x6d, 0x84, 0x2a,
+	0x5f, 0x0e, 0x0d, 0xb4, 0x1e, 0xd0, 0xb7, 0xfd, 0xed, 0xff, 0xb9, 0x5f, 0xbd, 0x97, 0xf4, 0xa7,
+	0xed, 0xda, 0xe5, 0x06, 0x7c, 0xd1, 0xbd, 0xf8, 0x6b, 0x00, 0xcb, 0x12, 0x15, 0xae, 0xe3, 0x09,
+	0x00, 0x00,
 }
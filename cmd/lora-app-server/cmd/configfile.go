@@ -99,6 +99,76 @@ id="{{ .ApplicationServer.ID }}"
   # Maximum execution time.
   max_execution_time="{{ .ApplicationServer.Codec.JS.MaxExecutionTime }}"
 
+  # Maximum memory usage (in bytes).
+  #
+  # The script is interrupted when the memory usage exceeds this limit. The
+  # memory usage is approximated by the heap growth of the whole process
+  # during the execution of the script, thus concurrent script executions and
+  # other allocations count towards this limit as well. Set it well above the
+  # memory usage of your scripts. Set this to 0 to disable the limit.
+  max_memory={{ .ApplicationServer.Codec.JS.MaxMemory }}
+
+  # Number of compiled scripts to cache.
+  #
+  # Compiled scripts are cached by the hash of the script so that a script is
+  # not re-compiled on every uplink or downlink.
+  program_cache_size={{ .ApplicationServer.Codec.JS.ProgramCacheSize }}
+
+  # Maximum size of the per-device codec state (in bytes).
+  #
+  # The state (context.state within the Decode function) is stored JSON
+  # encoded in Redis. Decoding fails when the encoded state exceeds this size.
+  max_state_size={{ .ApplicationServer.Codec.JS.MaxStateSize }}
+
+  # WebAssembly codec settings.
+  [application_server.codec.wasm]
+  # Maximum execution time.
+  #
+  # This limits the execution of a single Decode or Encode call, including
+  # the instantiation of the module. Instruction (fuel) metering is not
+  # supported, the execution time is the only limit on the CPU usage.
+  max_execution_time="{{ .ApplicationServer.Codec.WASM.MaxExecutionTime }}"
+
+  # Maximum memory size (in bytes).
+  #
+  # The linear memory of the module can not grow beyond this size. It is
+  # rounded down to a multiple of the WebAssembly page size (64KiB).
+  max_memory={{ .ApplicationServer.Codec.WASM.MaxMemory }}
+
+  # Number of compiled modules to cache.
+  #
+  # Compiled modules are cached by the hash of the module so that a module
+  # is not re-compiled on every uplink or downlink.
+  module_cache_size={{ .ApplicationServer.Codec.WASM.ModuleCacheSize }}
+
+
+  # Device-status settings.
+  [application_server.device_status]
+  # Number of days to keep the device-status history.
+  #
+  # Every device-status report is stored to provide the device-status
+  # history. Reports which are older than the given number of days are
+  # periodically removed. Set this to 0 to keep all reports.
+  history_days={{ .ApplicationServer.DeviceStatus.HistoryDays }}
+
+
+  # Application event stream settings.
+  [application_server.event_stream]
+  # Enable the application event stream.
+  #
+  # When enabled, the events of all applications are published to a Redis
+  # backed event stream, which can be consumed using the StreamEvents API
+  # method. When disabled, the StreamEvents API method returns an error.
+  enabled={{ .ApplicationServer.EventStream.Enabled }}
+
+  # Number of events kept in the backlog (per application).
+  #
+  # Subscribers are able to resume from the backlog after a disconnect.
+  backlog_size={{ .ApplicationServer.EventStream.BacklogSize }}
+
+  # Duration for which the backlog is kept after the last event.
+  backlog_ttl="{{ .ApplicationServer.EventStream.BacklogTTL }}"
+
 
   # Integration configures the data integration.
   #
@@ -152,6 +222,14 @@ id="{{ .ApplicationServer.ID }}"
   status_retained_message={{ .ApplicationServer.Integration.MQTT.StatusRetainedMessage }}
   location_retained_message={{ .ApplicationServer.Integration.MQTT.LocationRetainedMessage }}
 
+  # Payload marshaler.
+  #
+  # This defines how the MQTT payloads are encoded. Valid options are:
+  # * json:        JSON objects (default)
+  # * protobuf:    Protobuf messages (see api/integration/integration.proto)
+  # * cloudevents: CloudEvents 1.0 JSON envelope (structured content mode)
+  marshaler="{{ .ApplicationServer.Integration.MQTT.Marshaler }}"
+
   # MQTT server (e.g. scheme://host:port where scheme is tcp, ssl or ws)
   server="{{ .ApplicationServer.Integration.MQTT.Server }}"
 
@@ -216,6 +294,14 @@ id="{{ .ApplicationServer.ID }}"
   # Topic ARN (SNS).
   topic_arn="{{ .ApplicationServer.Integration.AWSSNS.TopicARN }}"
 
+  # Payload marshaler.
+  #
+  # This defines how the payloads are encoded. Valid options are:
+  # * json:        JSON objects (default)
+  # * protobuf:    Protobuf messages, base64 encoded (see api/integration/integration.proto)
+  # * cloudevents: CloudEvents 1.0 JSON envelope (structured content mode)
+  marshaler="{{ .ApplicationServer.Integration.AWSSNS.Marshaler }}"
+
 
   # Azure Service-Bus integration.
   [application_server.integration.azure_service_bus]
@@ -235,6 +321,21 @@ id="{{ .ApplicationServer.ID }}"
   # The name of the topic or queue.
   publish_name="{{ .ApplicationServer.Integration.AzureServiceBus.PublishName }}"
 
+  # Payload marshaler.
+  #
+  # This defines how the payloads are encoded. Valid options are:
+  # * json:               JSON objects (default)
+  # * protobuf:           Protobuf messages (see api/integration/integration.proto)
+  # * cloudevents:        CloudEvents 1.0 JSON envelope (structured content mode)
+  # * cloudevents_binary: CloudEvents 1.0 binary content mode (attributes are set as "cloudEvents:" prefixed user properties)
+  marshaler="{{ .ApplicationServer.Integration.AzureServiceBus.Marshaler }}"
+
+  # Downlink queue name (optional).
+  #
+  # When set, downlink payloads are received from this queue. The queue will
+  # be created when it does not exist. The policy must then also contain Listen.
+  downlink_queue_name="{{ .ApplicationServer.Integration.AzureServiceBus.DownlinkQueueName }}"
+
 
   # Google Cloud Pub/Sub integration.
   [application_server.integration.gcp_pub_sub]
@@ -250,6 +351,21 @@ id="{{ .ApplicationServer.ID }}"
   # Pub/Sub topic name.
   topic_name="{{ .ApplicationServer.Integration.GCPPubSub.TopicName }}"
 
+  # Payload marshaler.
+  #
+  # This defines how the payloads are encoded. Valid options are:
+  # * json:               JSON objects (default)
+  # * protobuf:           Protobuf messages (see api/integration/integration.proto)
+  # * cloudevents:        CloudEvents 1.0 JSON envelope (structured content mode)
+  # * cloudevents_binary: CloudEvents 1.0 binary content mode (attributes are set as "ce-" prefixed message attributes)
+  marshaler="{{ .ApplicationServer.Integration.GCPPubSub.Marshaler }}"
+
+  # Pub/Sub subscription name (optional).
+  #
+  # When set, downlink payloads are received from this subscription. The
+  # subscription must already exist.
+  subscription_name="{{ .ApplicationServer.Integration.GCPPubSub.SubscriptionName }}"
+
 
   # Settings for the "internal api"
   #
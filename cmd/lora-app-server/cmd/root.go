@@ -55,8 +55,21 @@ func init() {
 	viper.SetDefault("application_server.integration.mqtt.status_topic_template", "application/{{ .ApplicationID }}/device/{{ .DevEUI }}/status")
 	viper.SetDefault("application_server.integration.mqtt.location_topic_template", "application/{{ .ApplicationID }}/device/{{ .DevEUI }}/location")
 	viper.SetDefault("application_server.integration.mqtt.clean_session", true)
+	viper.SetDefault("application_server.integration.mqtt.marshaler", "json")
+	viper.SetDefault("application_server.integration.aws_sns.marshaler", "json")
+	viper.SetDefault("application_server.integration.azure_service_bus.marshaler", "json")
+	viper.SetDefault("application_server.integration.gcp_pub_sub.marshaler", "json")
 	viper.SetDefault("application_server.integration.enabled", []string{"mqtt"})
 	viper.SetDefault("application_server.codec.js.max_execution_time", 100*time.Millisecond)
+	viper.SetDefault("application_server.codec.js.max_memory", 64*1024*1024)
+	viper.SetDefault("application_server.codec.js.program_cache_size", 1000)
+	viper.SetDefault("application_server.codec.js.max_state_size", 4096)
+	viper.SetDefault("application_server.codec.wasm.max_execution_time", 100*time.Millisecond)
+	viper.SetDefault("application_server.codec.wasm.max_memory", 16*1024*1024)
+	viper.SetDefault("application_server.codec.wasm.module_cache_size", 100)
+	viper.SetDefault("application_server.device_status.history_days", 90)
+	viper.SetDefault("application_server.event_stream.backlog_size", 1000)
+	viper.SetDefault("application_server.event_stream.backlog_ttl", 24*time.Hour)
 
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(configCmd)
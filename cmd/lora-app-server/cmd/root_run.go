@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -20,6 +21,7 @@ import (
 	"github.com/brocaar/lora-app-server/internal/integration"
 	"github.com/brocaar/lora-app-server/internal/integration/application"
 	"github.com/brocaar/lora-app-server/internal/integration/multi"
+	"github.com/brocaar/lora-app-server/internal/integration/stream"
 	"github.com/brocaar/lora-app-server/internal/storage"
 )
 
@@ -37,6 +39,8 @@ func run(cmd *cobra.Command, args []string) error {
 		setupCodec,
 		handleDataDownPayloads,
 		startGatewayPing,
+		startDeviceUplinkCleanup,
+		startDeviceStatusCleanup,
 		setupAPI,
 	}
 
@@ -107,7 +111,18 @@ func setupIntegration() error {
 	if err != nil {
 		return errors.Wrap(err, "setup integrations error")
 	}
-	mi.Add(application.New())
+
+	ai := application.New()
+	go ai.HandleCacheInvalidationLoop()
+
+	mi.Add(ai)
+
+	if err := stream.Setup(config.C); err != nil {
+		return errors.Wrap(err, "setup event stream error")
+	}
+	if stream.Enabled() {
+		mi.Add(stream.New())
+	}
 	integration.SetIntegration(mi)
 
 	return nil
@@ -144,3 +159,40 @@ func startGatewayPing() error {
 
 	return nil
 }
+
+func startDeviceUplinkCleanup() error {
+	go func() {
+		for {
+			n, err := storage.DeleteExpiredDeviceUplinks(storage.DB())
+			if err != nil {
+				log.WithError(err).Error("delete expired device uplinks error")
+			} else if n != 0 {
+				log.WithField("count", n).Info("expired device uplinks deleted")
+			}
+			time.Sleep(time.Hour)
+		}
+	}()
+
+	return nil
+}
+
+func startDeviceStatusCleanup() error {
+	days := config.C.ApplicationServer.DeviceStatus.HistoryDays
+	if days == 0 {
+		return nil
+	}
+
+	go func() {
+		for {
+			n, err := storage.DeleteExpiredDeviceStatus(storage.DB(), days)
+			if err != nil {
+				log.WithError(err).Error("delete expired device-status history error")
+			} else if n != 0 {
+				log.WithField("count", n).Info("expired device-status history deleted")
+			}
+			time.Sleep(time.Hour)
+		}
+	}()
+
+	return nil
+}
@@ -4,12 +4,14 @@ import (
 	"crypto/aes"
 	"crypto/rand"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math"
 	"net"
 	"time"
 
 	keywrap "github.com/NickBall/go-aes-key-wrap"
+	"github.com/gofrs/uuid"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jmoiron/sqlx"
@@ -28,6 +30,7 @@ import (
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/loraserver/api/as"
 	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/loraserver/api/gw"
 	"github.com/brocaar/lorawan"
 )
 
@@ -93,15 +96,15 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 	copy(appEUI[:], req.JoinEui)
 	copy(devEUI[:], req.DevEui)
 
+	receivedAt := time.Now()
+
 	err = storage.Transaction(func(tx sqlx.Ext) error {
 		d, err = storage.GetDevice(tx, devEUI, true, true)
 		if err != nil {
 			grpc.Errorf(codes.Internal, "get device error: %s", err)
 		}
 
-		now := time.Now()
-
-		d.LastSeenAt = &now
+		d.LastSeenAt = &receivedAt
 		err = storage.UpdateDevice(tx, &d, true)
 		if err != nil {
 			return grpc.Errorf(codes.Internal, "update device error: %s", err)
@@ -152,73 +155,167 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 
 	// TODO: in the next major release, remove this and always use the
 	// device-profile codec fields.
-	payloadCodec := app.PayloadCodec
-	payloadEncoderScript := app.PayloadEncoderScript
-	payloadDecoderScript := app.PayloadDecoderScript
-
-	if dp.PayloadCodec != "" {
-		payloadCodec = dp.PayloadCodec
-		payloadEncoderScript = dp.PayloadEncoderScript
-		payloadDecoderScript = dp.PayloadDecoderScript
-	}
+	payloadCodec, err := storage.GetDeviceProfilePayloadCodec(storage.DB(), dp, uint8(req.FPort))
+	if err != nil {
+		if errors.Cause(err) != storage.ErrDoesNotExist {
+			log.WithError(err).WithField("id", d.DeviceProfileID).Error("get device-profile payload codec error")
+			return nil, grpc.Errorf(codes.Internal, "get device-profile payload codec error: %s", err)
+		}
 
-	codecPL := codec.NewPayload(payloadCodec, uint8(req.FPort), payloadEncoderScript, payloadDecoderScript)
+		// the (published) codec version does not exist, the application
+		// codec is used instead
+		log.WithFields(log.Fields{
+			"device_profile_id": d.DeviceProfileID,
+			"application_id":    app.ID,
+			"f_port":            req.FPort,
+			"f_cnt":             req.FCnt,
+			"dev_eui":           d.DevEUI,
+		}).WithError(err).Error("get device-profile payload codec error")
+
+		sendErrorNotification(integration.ErrorNotification{
+			ApplicationID:   d.ApplicationID,
+			ApplicationName: app.Name,
+			DeviceName:      d.Name,
+			Variables:       d.Variables,
+			DevEUI:          d.DevEUI,
+			Type:            "CODEC",
+			Error:           fmt.Sprintf("get device-profile payload codec error: %s", err),
+			FCnt:            req.FCnt,
+		})
+
+		payloadCodec = storage.CodecVersion{}
+	}
+	if payloadCodec.PayloadCodec == "" {
+		payloadCodec.PayloadCodec = app.PayloadCodec
+		payloadCodec.PayloadEncoderScript = app.PayloadEncoderScript
+		payloadCodec.PayloadDecoderScript = app.PayloadDecoderScript
+	}
+
+	codecPL := codec.NewPayload(payloadCodec.PayloadCodec, uint8(req.FPort), payloadCodec.PayloadEncoderScript, payloadCodec.PayloadDecoderScript, payloadCodec.DescriptorSet, payloadCodec.WASMModule)
 	if codecPL != nil {
+		if c, ok := codecPL.(interface{ SetContext(codec.Context) }); ok {
+			c.SetContext(uplinkCodecContext(d, req, receivedAt))
+		}
+
 		start := time.Now()
-		if err := codecPL.DecodeBytes(b); err != nil {
+		if err := decodePayload(d.DevEUI, codecPL, b); err != nil {
 			log.WithFields(log.Fields{
-				"codec":          app.PayloadCodec,
+				"codec":          payloadCodec.PayloadCodec,
 				"application_id": app.ID,
 				"f_port":         req.FPort,
 				"f_cnt":          req.FCnt,
 				"dev_eui":        d.DevEUI,
 			}).WithError(err).Error("decode payload error")
 
-			errNotification := integration.ErrorNotification{
+			sendErrorNotification(integration.ErrorNotification{
 				ApplicationID:   d.ApplicationID,
 				ApplicationName: app.Name,
 				DeviceName:      d.Name,
+				Variables:       d.Variables,
 				DevEUI:          d.DevEUI,
 				Type:            "CODEC",
 				Error:           err.Error(),
 				FCnt:            req.FCnt,
-			}
+			})
+		} else {
+			log.WithFields(log.Fields{
+				"application_id": app.ID,
+				"codec":          payloadCodec.PayloadCodec,
+				"duration":       time.Since(start),
+			}).Debug("payload codec completed Decode execution")
+			object = codecPL.Object()
+		}
 
-			if err := eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
-				Type:    eventlog.Error,
-				Payload: errNotification,
+		if l, ok := codecPL.(interface{ Logs() []string }); ok && len(l.Logs()) != 0 {
+			if err := eventlog.LogEventForDevice(d.ApplicationID, d.DevEUI, eventlog.EventLog{
+				Type: eventlog.Log,
+				Payload: eventlog.CodecLog{
+					ApplicationID:   d.ApplicationID,
+					ApplicationName: app.Name,
+					DeviceName:      d.Name,
+					DevEUI:          d.DevEUI,
+					Function:        "Decode",
+					FPort:           uint8(req.FPort),
+					FCnt:            req.FCnt,
+					Lines:           l.Logs(),
+				},
 			}); err != nil {
 				log.WithError(err).Error("log event for device error")
 			}
+		}
+	}
 
-			if err := integration.Integration().SendErrorNotification(errNotification); err != nil {
-				log.WithError(err).Error("send error notification to integration error")
-			}
-		} else {
+	var objectSchemaError string
+	if object != nil && dp.PayloadJSONSchema != "" {
+		if err := codec.ValidateJSONSchema(dp.PayloadJSONSchema, object); err != nil {
 			log.WithFields(log.Fields{
 				"application_id": app.ID,
-				"codec":          app.PayloadCodec,
-				"duration":       time.Since(start),
-			}).Debug("payload codec completed Decode execution")
-			object = codecPL.Object()
+				"f_port":         req.FPort,
+				"f_cnt":          req.FCnt,
+				"dev_eui":        d.DevEUI,
+				"drop":           dp.DropInvalidPayloads,
+			}).WithError(err).Warning("payload json schema validation error")
+
+			sendErrorNotification(integration.ErrorNotification{
+				ApplicationID:   d.ApplicationID,
+				ApplicationName: app.Name,
+				DeviceName:      d.Name,
+				Variables:       d.Variables,
+				DevEUI:          d.DevEUI,
+				Type:            "SCHEMA",
+				Error:           err.Error(),
+				FCnt:            req.FCnt,
+			})
+
+			if dp.DropInvalidPayloads {
+				return &empty.Empty{}, nil
+			}
+			objectSchemaError = err.Error()
 		}
 	}
 
+	uplinkID, err := uuid.NewV4()
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "new uuid error: %s", err)
+	}
+
 	pl := integration.DataUpPayload{
+		UplinkID:        uplinkID,
 		ApplicationID:   app.ID,
 		ApplicationName: app.Name,
 		DeviceName:      d.Name,
+		Variables:       d.Variables,
 		DevEUI:          devEUI,
+		DevAddr:         da.DevAddr,
 		RXInfo:          []integration.RXInfo{},
 		TXInfo: integration.TXInfo{
-			Frequency: int(req.TxInfo.Frequency),
-			DR:        int(req.Dr),
+			Frequency:  int(req.TxInfo.Frequency),
+			DR:         int(req.Dr),
+			Modulation: req.TxInfo.Modulation.String(),
 		},
-		ADR:    req.Adr,
-		FCnt:   req.FCnt,
-		FPort:  uint8(req.FPort),
-		Data:   b,
-		Object: object,
+		ADR:               req.Adr,
+		FCnt:              req.FCnt,
+		FPort:             uint8(req.FPort),
+		Data:              b,
+		Object:            object,
+		ObjectSchemaError: objectSchemaError,
+		ReceivedAt:        receivedAt,
+	}
+
+	if modInfo := req.TxInfo.GetLoraModulationInfo(); modInfo != nil {
+		pl.TXInfo.LoRaModulationInfo = &integration.LoRaModulationInfo{
+			Bandwidth:             int(modInfo.Bandwidth),
+			SpreadingFactor:       int(modInfo.SpreadingFactor),
+			CodeRate:              modInfo.CodeRate,
+			PolarizationInversion: modInfo.PolarizationInversion,
+		}
+	}
+
+	if modInfo := req.TxInfo.GetFskModulationInfo(); modInfo != nil {
+		pl.TXInfo.FSKModulationInfo = &integration.FSKModulationInfo{
+			Bandwidth: int(modInfo.Bandwidth),
+			Bitrate:   int(modInfo.Bitrate),
+		}
 	}
 
 	// collect gateway data of receiving gateways (e.g. gateway name)
@@ -239,8 +336,34 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 
 		row := integration.RXInfo{
 			GatewayID: mac,
+			Timestamp: rxInfo.Timestamp,
 			RSSI:      int(rxInfo.Rssi),
 			LoRaSNR:   rxInfo.LoraSnr,
+			Channel:   int(rxInfo.Channel),
+			RFChain:   int(rxInfo.RfChain),
+			Board:     int(rxInfo.Board),
+			Antenna:   int(rxInfo.Antenna),
+		}
+
+		if rxInfo.FineTimestampType != gw.FineTimestampType_NONE {
+			row.FineTimestampType = rxInfo.FineTimestampType.String()
+		}
+
+		if fts := rxInfo.GetEncryptedFineTimestamp(); fts != nil {
+			row.EncryptedFineTimestamp = &integration.EncryptedFineTimestamp{
+				AESKeyIndex: int(fts.AesKeyIndex),
+				EncryptedNS: fts.EncryptedNs,
+				FPGAID:      fts.FpgaId,
+			}
+		}
+
+		if fts := rxInfo.GetPlainFineTimestamp(); fts != nil && fts.Time != nil {
+			ts, err := ptypes.Timestamp(fts.Time)
+			if err != nil {
+				log.WithField("dev_eui", devEUI).WithError(err).Error("parse fine-timestamp error")
+			} else {
+				row.FineTimestamp = &ts
+			}
 		}
 
 		if rxInfo.Location != nil {
@@ -267,7 +390,7 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 		pl.RXInfo = append(pl.RXInfo, row)
 	}
 
-	err = eventlog.LogEventForDevice(devEUI, eventlog.EventLog{
+	err = eventlog.LogEventForDevice(app.ID, devEUI, eventlog.EventLog{
 		Type:    eventlog.Uplink,
 		Payload: pl,
 	})
@@ -275,6 +398,12 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 		log.WithError(err).Error("log event for device error")
 	}
 
+	if app.UplinkHistoryDays > 0 {
+		if err := storeDeviceUplink(pl); err != nil {
+			log.WithError(err).WithField("dev_eui", devEUI).Error("store device uplink error")
+		}
+	}
+
 	err = integration.Integration().SendDataUp(pl)
 	if err != nil {
 		log.WithError(err).Error("send uplink data to integration error")
@@ -284,6 +413,33 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 	return &empty.Empty{}, nil
 }
 
+// storeDeviceUplink stores the given uplink in the uplink history.
+func storeDeviceUplink(pl integration.DataUpPayload) error {
+	u := storage.DeviceUplink{
+		DevEUI:     pl.DevEUI,
+		ReceivedAt: pl.ReceivedAt,
+		FCnt:       pl.FCnt,
+		FPort:      pl.FPort,
+		DR:         pl.TXInfo.DR,
+		Data:       pl.Data,
+	}
+
+	var err error
+	if pl.Object != nil {
+		u.Object, err = json.Marshal(pl.Object)
+		if err != nil {
+			return errors.Wrap(err, "marshal object error")
+		}
+	}
+
+	u.RXInfo, err = json.Marshal(pl.RXInfo)
+	if err != nil {
+		return errors.Wrap(err, "marshal rx-info error")
+	}
+
+	return storage.CreateDeviceUplink(storage.DB(), &u)
+}
+
 // HandleDownlinkACK handles an ack on a downlink transmission.
 func (a *ApplicationServerAPI) HandleDownlinkACK(ctx context.Context, req *as.HandleDownlinkACKRequest) (*empty.Empty, error) {
 	var devEUI lorawan.EUI64
@@ -310,12 +466,13 @@ func (a *ApplicationServerAPI) HandleDownlinkACK(ctx context.Context, req *as.Ha
 		ApplicationID:   app.ID,
 		ApplicationName: app.Name,
 		DeviceName:      d.Name,
+		Variables:       d.Variables,
 		DevEUI:          devEUI,
 		Acknowledged:    req.Acknowledged,
 		FCnt:            req.FCnt,
 	}
 
-	err = eventlog.LogEventForDevice(devEUI, eventlog.EventLog{
+	err = eventlog.LogEventForDevice(app.ID, devEUI, eventlog.EventLog{
 		Type:    eventlog.ACK,
 		Payload: pl,
 	})
@@ -358,13 +515,14 @@ func (a *ApplicationServerAPI) HandleError(ctx context.Context, req *as.HandleEr
 		ApplicationID:   app.ID,
 		ApplicationName: app.Name,
 		DeviceName:      d.Name,
+		Variables:       d.Variables,
 		DevEUI:          devEUI,
 		Type:            req.Type.String(),
 		Error:           req.Error,
 		FCnt:            req.FCnt,
 	}
 
-	err = eventlog.LogEventForDevice(devEUI, eventlog.EventLog{
+	err = eventlog.LogEventForDevice(app.ID, devEUI, eventlog.EventLog{
 		Type:    eventlog.Error,
 		Payload: pl,
 	})
@@ -430,6 +588,17 @@ func (a *ApplicationServerAPI) SetDeviceStatus(ctx context.Context, req *as.SetD
 			return helpers.ErrToRPCError(errors.Wrap(err, "update device error"))
 		}
 
+		err = storage.CreateDeviceStatus(tx, &storage.DeviceStatus{
+			DevEUI:              d.DevEUI,
+			CreatedAt:           time.Now(),
+			Margin:              marg,
+			BatteryLevel:        d.DeviceStatusBattery,
+			ExternalPowerSource: d.DeviceStatusExternalPower,
+		})
+		if err != nil {
+			return helpers.ErrToRPCError(errors.Wrap(err, "create device-status error"))
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -445,6 +614,7 @@ func (a *ApplicationServerAPI) SetDeviceStatus(ctx context.Context, req *as.SetD
 		ApplicationID:           app.ID,
 		ApplicationName:         app.Name,
 		DeviceName:              d.Name,
+		Variables:               d.Variables,
 		DevEUI:                  d.DevEUI,
 		Battery:                 int(req.Battery),
 		Margin:                  int(req.Margin),
@@ -452,7 +622,7 @@ func (a *ApplicationServerAPI) SetDeviceStatus(ctx context.Context, req *as.SetD
 		BatteryLevel:            float32(math.Round(float64(req.BatteryLevel*100))) / 100,
 		BatteryLevelUnavailable: req.BatteryLevelUnavailable,
 	}
-	err = eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
+	err = eventlog.LogEventForDevice(app.ID, d.DevEUI, eventlog.EventLog{
 		Type:    eventlog.Status,
 		Payload: pl,
 	})
@@ -509,6 +679,7 @@ func (a *ApplicationServerAPI) SetDeviceLocation(ctx context.Context, req *as.Se
 		ApplicationID:   app.ID,
 		ApplicationName: app.Name,
 		DeviceName:      d.Name,
+		Variables:       d.Variables,
 		DevEUI:          d.DevEUI,
 		Location: integration.Location{
 			Latitude:  req.Location.Latitude,
@@ -517,7 +688,7 @@ func (a *ApplicationServerAPI) SetDeviceLocation(ctx context.Context, req *as.Se
 		},
 	}
 
-	err = eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
+	err = eventlog.LogEventForDevice(app.ID, d.DevEUI, eventlog.EventLog{
 		Type:    eventlog.Location,
 		Payload: pl,
 	})
@@ -606,10 +777,11 @@ func handleDeviceActivation(d storage.Device, app storage.Application, daCtx *as
 		ApplicationName: app.Name,
 		DevEUI:          d.DevEUI,
 		DeviceName:      d.Name,
+		Variables:       d.Variables,
 		DevAddr:         da.DevAddr,
 	}
 
-	err = eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
+	err = eventlog.LogEventForDevice(app.ID, d.DevEUI, eventlog.EventLog{
 		Type:    eventlog.Join,
 		Payload: pl,
 	})
@@ -657,3 +829,62 @@ func unwrapASKey(ke *common.KeyEnvelope) (lorawan.AES128Key, error) {
 
 	return key, fmt.Errorf("unknown kek label: %s", ke.KekLabel)
 }
+
+// sendErrorNotification logs the given error notification as error event
+// and sends it to the integration.
+func sendErrorNotification(errNotification integration.ErrorNotification) {
+	if err := eventlog.LogEventForDevice(errNotification.ApplicationID, errNotification.DevEUI, eventlog.EventLog{
+		Type:    eventlog.Error,
+		Payload: errNotification,
+	}); err != nil {
+		log.WithError(err).Error("log event for device error")
+	}
+
+	if err := integration.Integration().SendErrorNotification(errNotification); err != nil {
+		log.WithError(err).Error("send error notification to integration error")
+	}
+}
+
+// decodePayload decodes the given bytes using the given codec. For codecs
+// supporting a state, the codec state of the device is updated atomically
+// with the decoding of the payload. The state is not updated when the
+// decoding fails.
+func decodePayload(devEUI lorawan.EUI64, codecPL codec.Payload, b []byte) error {
+	c, ok := codecPL.(interface {
+		SetState([]byte)
+		State() []byte
+	})
+	if !ok {
+		return codecPL.DecodeBytes(b)
+	}
+
+	return storage.UpdateDeviceCodecState(storage.RedisPool(), devEUI, func(state []byte) ([]byte, error) {
+		c.SetState(state)
+		if err := codecPL.DecodeBytes(b); err != nil {
+			return nil, err
+		}
+		return c.State(), nil
+	})
+}
+
+// uplinkCodecContext returns the codec context for the given uplink. The
+// RSSI and SNR are those of the gateway with the best RSSI.
+func uplinkCodecContext(d storage.Device, req *as.HandleUplinkDataRequest, receivedAt time.Time) codec.Context {
+	ctx := codec.Context{
+		DevEUI:     d.DevEUI,
+		FCnt:       &req.FCnt,
+		ReceivedAt: &receivedAt,
+		Variables:  d.Variables,
+	}
+
+	for _, rxInfo := range req.RxInfo {
+		rssi := int(rxInfo.Rssi)
+		snr := rxInfo.LoraSnr
+		if ctx.RSSI == nil || rssi > *ctx.RSSI {
+			ctx.RSSI = &rssi
+			ctx.LoRaSNR = &snr
+		}
+	}
+
+	return ctx
+}
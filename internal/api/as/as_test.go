@@ -322,6 +322,165 @@ func (ts *ASTestSuite) TestApplicationServer() {
 				assert.NoError(err)
 				assert.Equal(`{"fPort":4,"firstByte":68}`, string(b))
 			})
+
+			t.Run("JS codec with context and state", func(t *testing.T) {
+				assert := require.New(t)
+
+				dp.PayloadCodec = codec.CustomJSType
+				dp.PayloadDecoderScript = `
+					function Decode(fPort, bytes, context) {
+						var previous = context.state.firstByte;
+						context.state.firstByte = bytes[0];
+						return {
+							"fCnt": context.fCnt,
+							"rssi": context.rssi,
+							"previous": previous === undefined ? null : previous
+						}
+					}
+				`
+				assert.NoError(storage.UpdateDeviceProfile(storage.DB(), &dp))
+
+				for _, expected := range []string{
+					`{"fCnt":10,"previous":null,"rssi":-60}`,
+					`{"fCnt":10,"previous":67,"rssi":-60}`,
+				} {
+					_, err := api.HandleUplinkData(ctx, &req)
+					assert.NoError(err)
+
+					pl := <-h.SendDataUpChan
+					b, err := json.Marshal(pl.Object)
+					assert.NoError(err)
+					assert.Equal(expected, string(b))
+				}
+
+				state, err := storage.GetDeviceCodecState(storage.RedisPool(), d.DevEUI)
+				assert.NoError(err)
+				assert.Equal(`{"firstByte":67}`, string(state))
+			})
+
+			t.Run("JS codec with JSON Schema", func(t *testing.T) {
+				assert := require.New(t)
+
+				dp.PayloadCodec = codec.CustomJSType
+				dp.PayloadDecoderScript = `
+					function Decode(fPort, bytes) {
+						return {"firstByte": "" + bytes[0]};
+					}
+				`
+				dp.PayloadJSONSchema = `{
+					"type": "object",
+					"properties": {"firstByte": {"type": "integer"}}
+				}`
+				assert.NoError(storage.UpdateDeviceProfile(storage.DB(), &dp))
+
+				errNotification := integration.ErrorNotification{
+					ApplicationID:   app.ID,
+					ApplicationName: app.Name,
+					DeviceName:      d.Name,
+					DevEUI:          d.DevEUI,
+					Type:            "SCHEMA",
+					Error:           "json schema validation error: firstByte: Invalid type. Expected: integer, given: string",
+					FCnt:            10,
+				}
+
+				t.Run("Forward invalid payload", func(t *testing.T) {
+					assert := require.New(t)
+
+					_, err := api.HandleUplinkData(ctx, &req)
+					assert.NoError(err)
+
+					assert.Equal(errNotification, <-h.SendErrorNotificationChan)
+
+					pl := <-h.SendDataUpChan
+					assert.Equal(map[string]interface{}{"firstByte": "67"}, pl.Object)
+					assert.Equal(errNotification.Error, pl.ObjectSchemaError)
+				})
+
+				t.Run("Drop invalid payload", func(t *testing.T) {
+					assert := require.New(t)
+
+					dp.DropInvalidPayloads = true
+					assert.NoError(storage.UpdateDeviceProfile(storage.DB(), &dp))
+
+					_, err := api.HandleUplinkData(ctx, &req)
+					assert.NoError(err)
+
+					assert.Equal(errNotification, <-h.SendErrorNotificationChan)
+					select {
+					case <-h.SendDataUpChan:
+						t.Fatal("uplink must be dropped")
+					default:
+					}
+				})
+
+				dp.PayloadJSONSchema = ""
+				dp.DropInvalidPayloads = false
+				assert.NoError(storage.UpdateDeviceProfile(storage.DB(), &dp))
+			})
+
+			t.Run("Codec version not found", func(t *testing.T) {
+				assert := require.New(t)
+
+				c := storage.Codec{
+					OrganizationID: &dp.OrganizationID,
+					Name:           "test-codec",
+				}
+				assert.NoError(storage.CreateCodec(storage.DB(), &c))
+				assert.NoError(storage.CreateCodecVersion(storage.DB(), &storage.CodecVersion{
+					CodecID:      c.ID,
+					PayloadCodec: codec.CayenneLPPType,
+					Published:    true,
+				}))
+
+				dp.CodecID = &c.ID
+				assert.NoError(storage.UpdateDeviceProfile(storage.DB(), &dp))
+
+				// versions can not be removed through the API
+				_, err := storage.DB().Exec("delete from codec_version where codec_id = $1", c.ID)
+				assert.NoError(err)
+
+				_, err = api.HandleUplinkData(ctx, &req)
+				assert.NoError(err)
+
+				errNotification := <-h.SendErrorNotificationChan
+				assert.Equal("CODEC", errNotification.Type)
+				assert.Equal(d.DevEUI, errNotification.DevEUI)
+
+				// the application codec is used instead
+				pl := <-h.SendDataUpChan
+				assert.NotNil(pl.Object)
+				b, err := json.Marshal(pl.Object)
+				assert.NoError(err)
+				assert.Equal(`{"fPort":3,"firstByte":67}`, string(b))
+
+				dp.CodecID = nil
+				assert.NoError(storage.UpdateDeviceProfile(storage.DB(), &dp))
+			})
+
+			t.Run("Uplink history", func(t *testing.T) {
+				assert := require.New(t)
+
+				app.UplinkHistoryDays = 7
+				assert.NoError(storage.UpdateApplication(storage.DB(), app))
+
+				_, err := api.HandleUplinkData(ctx, &req)
+				assert.NoError(err)
+				<-h.SendDataUpChan
+
+				uplinks, err := storage.GetDeviceUplinks(storage.DB(), storage.DeviceUplinkFilters{
+					DevEUI: d.DevEUI,
+					Limit:  10,
+				})
+				assert.NoError(err)
+				assert.Len(uplinks, 1)
+				assert.EqualValues(10, uplinks[0].FCnt)
+				assert.EqualValues(3, uplinks[0].FPort)
+				assert.Equal(6, uplinks[0].DR)
+				assert.Equal([]byte{67, 216, 236, 205}, uplinks[0].Data)
+
+				app.UplinkHistoryDays = 0
+				assert.NoError(storage.UpdateApplication(storage.DB(), app))
+			})
 		})
 	})
 
@@ -404,6 +563,19 @@ func (ts *ASTestSuite) TestApplicationServer() {
 				}
 			})
 		}
+
+		t.Run("History", func(t *testing.T) {
+			assert := require.New(t)
+
+			items, err := storage.GetDeviceStatusHistory(storage.DB(), d.DevEUI, "year", time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+			assert.NoError(err)
+
+			var count int
+			for _, item := range items {
+				count += item.Count
+			}
+			assert.Equal(len(tests), count)
+		})
 	})
 
 	ts.T().Run("SetDeviceLocation", func(t *testing.T) {
@@ -456,6 +628,28 @@ func (ts *ASTestSuite) TestApplicationServer() {
 			FCnt:            10,
 		}, <-h.SendACKNotificationChan)
 	})
+
+	ts.T().Run("Device variables", func(t *testing.T) {
+		assert := require.New(t)
+
+		dv := d
+		dv.Variables = storage.Variables{"site": "north"}
+		assert.NoError(storage.UpdateDevice(storage.DB(), &dv, true))
+		defer func() {
+			dv.Variables = nil
+			assert.NoError(storage.UpdateDevice(storage.DB(), &dv, true))
+		}()
+
+		_, err := api.HandleDownlinkACK(ctx, &as.HandleDownlinkACKRequest{
+			DevEui:       d.DevEUI[:],
+			FCnt:         11,
+			Acknowledged: true,
+		})
+		assert.NoError(err)
+
+		pl := <-h.SendACKNotificationChan
+		assert.Equal(map[string]string{"site": "north"}, pl.Variables)
+	})
 }
 
 func TestAS(t *testing.T) {
@@ -2,12 +2,16 @@ package external
 
 import (
 	"encoding/json"
+	"io"
+	"sort"
 	"strings"
 
 	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 
 	"github.com/jmoiron/sqlx"
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -15,11 +19,20 @@ import (
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/api/external/auth"
 	"github.com/brocaar/lora-app-server/internal/api/helpers"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
 	"github.com/brocaar/lora-app-server/internal/codec"
+	"github.com/brocaar/lora-app-server/internal/eventlog"
 	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/integration/application"
 	"github.com/brocaar/lora-app-server/internal/integration/http"
 	"github.com/brocaar/lora-app-server/internal/integration/influxdb"
+	"github.com/brocaar/lora-app-server/internal/integration/marshaler"
+	"github.com/brocaar/lora-app-server/internal/integration/stats"
+	"github.com/brocaar/lora-app-server/internal/integration/stream"
+	"github.com/brocaar/lora-app-server/internal/integration/tester"
 	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
 )
 
 // ApplicationAPI exports the Application related functions.
@@ -59,6 +72,7 @@ func (a *ApplicationAPI) Create(ctx context.Context, req *pb.CreateApplicationRe
 		PayloadCodec:         codec.Type(req.Application.PayloadCodec),
 		PayloadEncoderScript: req.Application.PayloadEncoderScript,
 		PayloadDecoderScript: req.Application.PayloadDecoderScript,
+		UplinkHistoryDays:    int(req.Application.UplinkHistoryDays),
 	}
 
 	if err := storage.CreateApplication(storage.DB(), &app); err != nil {
@@ -92,6 +106,7 @@ func (a *ApplicationAPI) Get(ctx context.Context, req *pb.GetApplicationRequest)
 			PayloadCodec:         string(app.PayloadCodec),
 			PayloadEncoderScript: app.PayloadEncoderScript,
 			PayloadDecoderScript: app.PayloadDecoderScript,
+			UplinkHistoryDays:    uint32(app.UplinkHistoryDays),
 		},
 	}
 
@@ -127,6 +142,7 @@ func (a *ApplicationAPI) Update(ctx context.Context, req *pb.UpdateApplicationRe
 	app.PayloadCodec = codec.Type(req.Application.PayloadCodec)
 	app.PayloadEncoderScript = req.Application.PayloadEncoderScript
 	app.PayloadDecoderScript = req.Application.PayloadDecoderScript
+	app.UplinkHistoryDays = int(req.Application.UplinkHistoryDays)
 
 	err = storage.UpdateApplication(storage.DB(), app)
 	if err != nil {
@@ -155,9 +171,28 @@ func (a *ApplicationAPI) Delete(ctx context.Context, req *pb.DeleteApplicationRe
 		return nil, err
 	}
 
+	if err := application.InvalidateCache(req.Id); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	if err := deleteApplicationStats(req.Id); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
 	return &empty.Empty{}, nil
 }
 
+// deleteApplicationStats deletes the integration statistics of the given
+// application.
+func deleteApplicationStats(applicationID int64) error {
+	for _, kind := range []string{integration.HTTP, integration.InfluxDB} {
+		if err := stats.Delete(applicationID, kind); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // List lists the available applications.
 func (a *ApplicationAPI) List(ctx context.Context, req *pb.ListApplicationRequest) (*pb.ListApplicationResponse, error) {
 	if err := a.validator.Validate(ctx,
@@ -252,19 +287,15 @@ func (a *ApplicationAPI) CreateHTTPIntegration(ctx context.Context, in *pb.Creat
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	headers := make(map[string]string)
-	for _, h := range in.Integration.Headers {
-		headers[h.Key] = h.Value
+	conf, err := httpIntegrationToConfig(in.Integration)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
 	}
-
-	conf := http.Config{
-		Headers:                 headers,
-		DataUpURL:               in.Integration.UplinkDataUrl,
-		JoinNotificationURL:     in.Integration.JoinNotificationUrl,
-		ACKNotificationURL:      in.Integration.AckNotificationUrl,
-		ErrorNotificationURL:    in.Integration.ErrorNotificationUrl,
-		StatusNotificationURL:   in.Integration.StatusNotificationUrl,
-		LocationNotificationURL: in.Integration.LocationNotificationUrl,
+	if in.Integration.DownlinkEnabled {
+		conf.DownlinkToken, err = http.NewDownlinkToken()
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
 	}
 	if err := conf.Validate(); err != nil {
 		return nil, helpers.ErrToRPCError(err)
@@ -284,6 +315,10 @@ func (a *ApplicationAPI) CreateHTTPIntegration(ctx context.Context, in *pb.Creat
 		return nil, helpers.ErrToRPCError(err)
 	}
 
+	if err := application.InvalidateCache(in.Integration.ApplicationId); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
 	return &empty.Empty{}, nil
 }
 
@@ -305,26 +340,14 @@ func (a *ApplicationAPI) GetHTTPIntegration(ctx context.Context, in *pb.GetHTTPI
 		return nil, helpers.ErrToRPCError(err)
 	}
 
-	var headers []*pb.HTTPIntegrationHeader
-	for k, v := range conf.Headers {
-		headers = append(headers, &pb.HTTPIntegrationHeader{
-			Key:   k,
-			Value: v,
-		})
-
+	out, err := httpConfigToIntegration(conf)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
 	}
+	out.ApplicationId = integration.ApplicationID
 
 	return &pb.GetHTTPIntegrationResponse{
-		Integration: &pb.HTTPIntegration{
-			ApplicationId:           integration.ApplicationID,
-			Headers:                 headers,
-			UplinkDataUrl:           conf.DataUpURL,
-			JoinNotificationUrl:     conf.JoinNotificationURL,
-			AckNotificationUrl:      conf.ACKNotificationURL,
-			ErrorNotificationUrl:    conf.ErrorNotificationURL,
-			StatusNotificationUrl:   conf.StatusNotificationURL,
-			LocationNotificationUrl: conf.LocationNotificationURL,
-		},
+		Integration: out,
 	}, nil
 }
 
@@ -345,19 +368,23 @@ func (a *ApplicationAPI) UpdateHTTPIntegration(ctx context.Context, in *pb.Updat
 		return nil, helpers.ErrToRPCError(err)
 	}
 
-	headers := make(map[string]string)
-	for _, h := range in.Integration.Headers {
-		headers[h.Key] = h.Value
+	conf, err := httpIntegrationToConfig(in.Integration)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
 	}
+	if in.Integration.DownlinkEnabled {
+		var current http.Config
+		if err := json.Unmarshal(integration.Settings, &current); err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
 
-	conf := http.Config{
-		Headers:                 headers,
-		DataUpURL:               in.Integration.UplinkDataUrl,
-		JoinNotificationURL:     in.Integration.JoinNotificationUrl,
-		ACKNotificationURL:      in.Integration.AckNotificationUrl,
-		ErrorNotificationURL:    in.Integration.ErrorNotificationUrl,
-		StatusNotificationURL:   in.Integration.StatusNotificationUrl,
-		LocationNotificationURL: in.Integration.LocationNotificationUrl,
+		conf.DownlinkToken = current.DownlinkToken
+		if conf.DownlinkToken == "" {
+			conf.DownlinkToken, err = http.NewDownlinkToken()
+			if err != nil {
+				return nil, helpers.ErrToRPCError(err)
+			}
+		}
 	}
 	if err := conf.Validate(); err != nil {
 		return nil, helpers.ErrToRPCError(err)
@@ -373,6 +400,10 @@ func (a *ApplicationAPI) UpdateHTTPIntegration(ctx context.Context, in *pb.Updat
 		return nil, helpers.ErrToRPCError(err)
 	}
 
+	if err := application.InvalidateCache(in.Integration.ApplicationId); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
 	return &empty.Empty{}, nil
 }
 
@@ -393,6 +424,14 @@ func (a *ApplicationAPI) DeleteHTTPIntegration(ctx context.Context, in *pb.Delet
 		return nil, helpers.ErrToRPCError(err)
 	}
 
+	if err := application.InvalidateCache(in.ApplicationId); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	if err := stats.Delete(in.ApplicationId, integration.Kind); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
 	return &empty.Empty{}, nil
 }
 
@@ -408,13 +447,9 @@ func (a *ApplicationAPI) CreateInfluxDBIntegration(ctx context.Context, in *pb.C
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	conf := influxdb.Config{
-		Endpoint:            in.Integration.Endpoint,
-		DB:                  in.Integration.Db,
-		Username:            in.Integration.Username,
-		Password:            in.Integration.Password,
-		RetentionPolicyName: in.Integration.RetentionPolicyName,
-		Precision:           strings.ToLower(in.Integration.Precision.String()),
+	conf, err := influxDBIntegrationToConfig(in.Integration)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
 	}
 	if err := conf.Validate(); err != nil {
 		return nil, helpers.ErrToRPCError(err)
@@ -434,6 +469,10 @@ func (a *ApplicationAPI) CreateInfluxDBIntegration(ctx context.Context, in *pb.C
 		return nil, helpers.ErrToRPCError(err)
 	}
 
+	if err := application.InvalidateCache(in.Integration.ApplicationId); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
 	return &empty.Empty{}, nil
 }
 
@@ -455,18 +494,11 @@ func (a *ApplicationAPI) GetInfluxDBIntegration(ctx context.Context, in *pb.GetI
 		return nil, helpers.ErrToRPCError(err)
 	}
 
-	prec, _ := pb.InfluxDBPrecision_value[strings.ToUpper(conf.Precision)]
+	out := influxDBConfigToIntegration(conf)
+	out.ApplicationId = in.ApplicationId
 
 	return &pb.GetInfluxDBIntegrationResponse{
-		Integration: &pb.InfluxDBIntegration{
-			ApplicationId:       in.ApplicationId,
-			Endpoint:            conf.Endpoint,
-			Db:                  conf.DB,
-			Username:            conf.Username,
-			Password:            conf.Password,
-			RetentionPolicyName: conf.RetentionPolicyName,
-			Precision:           pb.InfluxDBPrecision(prec),
-		},
+		Integration: out,
 	}, nil
 }
 
@@ -487,13 +519,9 @@ func (a *ApplicationAPI) UpdateInfluxDBIntegration(ctx context.Context, in *pb.U
 		return nil, helpers.ErrToRPCError(err)
 	}
 
-	conf := influxdb.Config{
-		Endpoint:            in.Integration.Endpoint,
-		DB:                  in.Integration.Db,
-		Username:            in.Integration.Username,
-		Password:            in.Integration.Password,
-		RetentionPolicyName: in.Integration.RetentionPolicyName,
-		Precision:           strings.ToLower(in.Integration.Precision.String()),
+	conf, err := influxDBIntegrationToConfig(in.Integration)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
 	}
 	if err := conf.Validate(); err != nil {
 		return nil, helpers.ErrToRPCError(err)
@@ -509,6 +537,10 @@ func (a *ApplicationAPI) UpdateInfluxDBIntegration(ctx context.Context, in *pb.U
 		return nil, helpers.ErrToRPCError(err)
 	}
 
+	if err := application.InvalidateCache(in.Integration.ApplicationId); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
 	return &empty.Empty{}, nil
 }
 
@@ -529,6 +561,14 @@ func (a *ApplicationAPI) DeleteInfluxDBIntegration(ctx context.Context, in *pb.D
 		return nil, helpers.ErrToRPCError(err)
 	}
 
+	if err := application.InvalidateCache(in.ApplicationId); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	if err := stats.Delete(in.ApplicationId, integration.Kind); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
 	return &empty.Empty{}, nil
 }
 
@@ -550,15 +590,668 @@ func (a *ApplicationAPI) ListIntegrations(ctx context.Context, in *pb.ListIntegr
 	}
 
 	for _, intgr := range integrations {
+		var item pb.IntegrationListItem
+
 		switch intgr.Kind {
 		case integration.HTTP:
-			out.Result = append(out.Result, &pb.IntegrationListItem{Kind: pb.IntegrationKind_HTTP})
+			item.Kind = pb.IntegrationKind_HTTP
 		case integration.InfluxDB:
-			out.Result = append(out.Result, &pb.IntegrationListItem{Kind: pb.IntegrationKind_INFLUXDB})
+			item.Kind = pb.IntegrationKind_INFLUXDB
 		default:
 			return nil, grpc.Errorf(codes.Internal, "unknown integration kind: %s", intgr.Kind)
 		}
+
+		st, err := stats.Get(in.ApplicationId, intgr.Kind)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		item.Stats, err = integrationStatsToPB(st)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		out.Result = append(out.Result, &item)
+	}
+
+	return &out, nil
+}
+
+// TestIntegration sends a synthetic event through the configured integration
+// and returns the result.
+func (a *ApplicationAPI) TestIntegration(ctx context.Context, in *pb.TestIntegrationRequest) (*pb.TestIntegrationResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	event, ok := integrationEvents[in.Event]
+	if !ok {
+		return nil, grpc.Errorf(codes.InvalidArgument, "unknown event: %s", in.Event)
+	}
+
+	app, err := storage.GetApplication(storage.DB(), in.ApplicationId)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	var kind string
+	switch in.Kind {
+	case pb.IntegrationKind_HTTP:
+		kind = integration.HTTP
+	case pb.IntegrationKind_INFLUXDB:
+		kind = integration.InfluxDB
+	default:
+		return nil, grpc.Errorf(codes.InvalidArgument, "unknown integration kind: %s", in.Kind)
+	}
+
+	intgr, err := storage.GetIntegrationByApplicationID(storage.DB(), in.ApplicationId, kind)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	var i integration.Integrator
+	switch intgr.Kind {
+	case integration.HTTP:
+		var conf http.Config
+		if err := json.Unmarshal(intgr.Settings, &conf); err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+		i, err = http.New(conf)
+	case integration.InfluxDB:
+		var conf influxdb.Config
+		if err := json.Unmarshal(intgr.Settings, &conf); err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+		// the test measurements must be written synchronously
+		conf.BatchSize = 0
+		conf.BatchInterval = 0
+		i, err = influxdb.New(conf)
+	}
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	defer i.Close()
+
+	result, err := tester.Test(i, event, app.ID, app.Name)
+	out := pb.TestIntegrationResponse{
+		StatusCode: uint32(result.StatusCode),
+		Body:       result.Body,
+		Latency:    ptypes.DurationProto(result.Latency),
+	}
+	if err != nil {
+		out.Error = err.Error()
+	}
+
+	return &out, nil
+}
+
+// StreamEvents streams the integration events of the given application.
+func (a *ApplicationAPI) StreamEvents(in *pb.StreamEventsRequest, srv pb.ApplicationService_StreamEventsServer) error {
+	if err := a.validator.Validate(srv.Context(),
+		auth.ValidateApplicationAccess(in.ApplicationId, auth.Read)); err != nil {
+		return grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if !stream.Enabled() {
+		return grpc.Errorf(codes.FailedPrecondition, "the application event stream is disabled")
+	}
+
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+
+	eventChan := make(chan stream.Event)
+	go func() {
+		err := stream.Subscribe(ctx, in.ApplicationId, in.LastSeq, eventChan)
+		if err != nil {
+			log.WithError(err).Error("subscribe to application events error")
+		}
+		close(eventChan)
+	}()
+
+	for el := range eventChan {
+		resp := pb.StreamEventsResponse{
+			Seq:         el.Seq,
+			Type:        el.Type,
+			PayloadJson: string(el.Payload),
+		}
+
+		if err := srv.Send(&resp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SendDownlinks enqueues the streamed downlink payloads. All payloads are
+// validated before the first payload is enqueued, so that an invalid payload
+// does not result in a partially enqueued stream.
+func (a *ApplicationAPI) SendDownlinks(srv pb.ApplicationService_SendDownlinksServer) error {
+	type downlink struct {
+		devEUI lorawan.EUI64
+		item   *pb.DeviceQueueItem
+	}
+	var downlinks []downlink
+
+	for {
+		req, err := srv.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(downlinks) == maxSendDownlinksItems {
+			return grpc.Errorf(codes.InvalidArgument, "max. %d downlinks can be sent per stream", maxSendDownlinksItems)
+		}
+
+		if req.DeviceQueueItem == nil {
+			return grpc.Errorf(codes.InvalidArgument, "downlink %d: device_queue_item must not be nil", len(downlinks))
+		}
+
+		if req.DeviceQueueItem.FPort == 0 {
+			return grpc.Errorf(codes.InvalidArgument, "downlink %d: f_port must be > 0", len(downlinks))
+		}
+
+		var devEUI lorawan.EUI64
+		if err := devEUI.UnmarshalText([]byte(req.DeviceQueueItem.DevEui)); err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "downlink %d: devEUI: %s", len(downlinks), err)
+		}
+
+		if err := a.validator.Validate(srv.Context(),
+			auth.ValidateDeviceQueueAccess(devEUI, auth.Create)); err != nil {
+			return grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+		}
+
+		d, err := storage.GetDevice(storage.DB(), devEUI, false, true)
+		if err != nil {
+			return helpers.ErrToRPCError(err)
+		}
+
+		if d.ApplicationID != req.ApplicationId {
+			return grpc.Errorf(codes.InvalidArgument, "downlink %d: device %s does not belong to application %d", len(downlinks), devEUI, req.ApplicationId)
+		}
+
+		downlinks = append(downlinks, downlink{devEUI: devEUI, item: req.DeviceQueueItem})
+	}
+
+	var resp pb.SendDownlinksResponse
+	for i, dl := range downlinks {
+		fCnt, err := enqueueDeviceQueueItem(dl.devEUI, dl.item)
+		if err != nil {
+			if i == 0 {
+				return err
+			}
+
+			// the downlinks before this one have been enqueued
+			return grpc.Errorf(grpc.Code(err), "downlink %d: %s (downlinks 0-%d have been enqueued with frame-counters %v)", i, grpc.ErrorDesc(err), i-1, resp.FCnt)
+		}
+
+		resp.FCnt = append(resp.FCnt, fCnt)
+	}
+
+	return srv.SendAndClose(&resp)
+}
+
+// StreamFrameLogs streams the uplink and downlink frame-logs of the devices
+// of the given application.
+// Note: this endpoint is intended for debugging and should not be used for
+// building integrations.
+func (a *ApplicationAPI) StreamFrameLogs(in *pb.StreamApplicationFrameLogsRequest, srv pb.ApplicationService_StreamFrameLogsServer) error {
+	if err := a.validator.Validate(srv.Context(),
+		auth.ValidateApplicationAccess(in.ApplicationId, auth.Read)); err != nil {
+		return grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	devEUIs, err := applicationDevEUIs(in.ApplicationId, in.DevEuis)
+	if err != nil {
+		return err
+	}
+
+	// LoRa Server only provides frame-log streams per device, therefore
+	// a stream is opened for each device.
+	var truncated bool
+	if len(devEUIs) == 0 {
+		filters := storage.DeviceFilters{
+			ApplicationID: in.ApplicationId,
+			Limit:         maxApplicationFrameLogDevices,
+		}
+
+		count, err := storage.GetDeviceCount(storage.DB(), filters)
+		if err != nil {
+			return helpers.ErrToRPCError(err)
+		}
+		truncated = count > maxApplicationFrameLogDevices
+
+		devices, err := storage.GetDevices(storage.DB(), filters)
+		if err != nil {
+			return helpers.ErrToRPCError(err)
+		}
+
+		for _, d := range devices {
+			devEUIs = append(devEUIs, d.DevEUI)
+		}
+	}
+
+	app, err := storage.GetApplication(storage.DB(), in.ApplicationId)
+	if err != nil {
+		return helpers.ErrToRPCError(err)
+	}
+
+	n, err := storage.GetNetworkServerForServiceProfileID(storage.DB(), app.ServiceProfileID)
+	if err != nil {
+		return helpers.ErrToRPCError(err)
+	}
+
+	nsClient, err := networkserver.GetPool().Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return helpers.ErrToRPCError(err)
+	}
+
+	if truncated {
+		if err := srv.Send(&pb.StreamApplicationFrameLogsResponse{Truncated: true}); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+
+	frameChan := make(chan *pb.StreamApplicationFrameLogsResponse)
+	errChan := make(chan deviceStreamError, len(devEUIs))
+
+	for _, devEUI := range devEUIs {
+		go func(devEUI lorawan.EUI64) {
+			errChan <- deviceStreamError{
+				devEUI: devEUI,
+				err:    streamFrameLogsForDevice(ctx, nsClient, devEUI, frameChan),
+			}
+		}(devEUI)
+	}
+
+	// the stream of a single device failing does not affect the streams
+	// of the other devices, only when all streams have failed the error of
+	// the last stream is returned
+	running := len(devEUIs)
+	for {
+		select {
+		case resp := <-frameChan:
+			if err := srv.Send(resp); err != nil {
+				return err
+			}
+		case streamErr := <-errChan:
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			log.WithError(streamErr.err).WithFields(log.Fields{
+				"application_id": in.ApplicationId,
+				"dev_eui":        streamErr.devEUI,
+			}).Error("stream frame-logs for device error")
+
+			running--
+			if running == 0 {
+				return streamErr.err
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// StreamEventLogs streams the device events (uplink payloads, ACKs, joins,
+// errors) of the devices of the given application.
+// Note: this endpoint is intended for debugging and should not be used for
+// building integrations.
+func (a *ApplicationAPI) StreamEventLogs(in *pb.StreamApplicationEventLogsRequest, srv pb.ApplicationService_StreamEventLogsServer) error {
+	if err := a.validator.Validate(srv.Context(),
+		auth.ValidateApplicationAccess(in.ApplicationId, auth.Read)); err != nil {
+		return grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	devEUIs, err := applicationDevEUIs(in.ApplicationId, in.DevEuis)
+	if err != nil {
+		return err
+	}
+
+	devEUIFilter := make(map[lorawan.EUI64]struct{})
+	for _, devEUI := range devEUIs {
+		devEUIFilter[devEUI] = struct{}{}
+	}
+
+	typeFilter := make(map[string]struct{})
+	for _, t := range in.Types {
+		typeFilter[t] = struct{}{}
+	}
+
+	eventLogChan := make(chan eventlog.ApplicationEventLog)
+	go func() {
+		err := eventlog.GetEventLogForApplication(srv.Context(), in.ApplicationId, eventLogChan)
+		if err != nil {
+			log.WithError(err).Error("get event-log for application error")
+		}
+		close(eventLogChan)
+	}()
+
+	for el := range eventLogChan {
+		if _, ok := devEUIFilter[el.DevEUI]; len(devEUIFilter) != 0 && !ok {
+			continue
+		}
+
+		if _, ok := typeFilter[el.Type]; len(typeFilter) != 0 && !ok {
+			continue
+		}
+
+		b, err := json.Marshal(el.Payload)
+		if err != nil {
+			return grpc.Errorf(codes.Internal, "marshal json error: %s", err)
+		}
+
+		resp := pb.StreamApplicationEventLogsResponse{
+			DevEui:      el.DevEUI.String(),
+			Type:        el.Type,
+			PayloadJson: string(b),
+		}
+
+		err = srv.Send(&resp)
+		if err != nil {
+			log.WithError(err).Error("error sending event-log response")
+		}
+	}
+
+	return nil
+}
+
+// maxSendDownlinksItems defines the max. number of downlinks that can be sent
+// using a single SendDownlinks stream.
+const maxSendDownlinksItems = 1000
+
+// maxApplicationFrameLogDevices defines the max. number of devices for which
+// the frame-logs are streamed when no DevEUI filter is given.
+const maxApplicationFrameLogDevices = 1000
+
+// applicationDevEUIs parses the given DevEUIs and validates that these
+// belong to the given application.
+func applicationDevEUIs(applicationID int64, devEUIStrs []string) ([]lorawan.EUI64, error) {
+	var out []lorawan.EUI64
+
+	for _, s := range devEUIStrs {
+		var devEUI lorawan.EUI64
+		if err := devEUI.UnmarshalText([]byte(s)); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+		}
+
+		d, err := storage.GetDevice(storage.DB(), devEUI, false, true)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		if d.ApplicationID != applicationID {
+			return nil, grpc.Errorf(codes.InvalidArgument, "device %s does not belong to application %d", devEUI, applicationID)
+		}
+
+		out = append(out, devEUI)
+	}
+
+	return out, nil
+}
+
+// deviceStreamError contains the error returned by the stream of a device.
+type deviceStreamError struct {
+	devEUI lorawan.EUI64
+	err    error
+}
+
+// streamFrameLogsForDevice streams the frame-logs of the given device to the
+// given channel until the given context is cancelled.
+func streamFrameLogsForDevice(ctx context.Context, nsClient ns.NetworkServerServiceClient, devEUI lorawan.EUI64, frameChan chan *pb.StreamApplicationFrameLogsResponse) error {
+	streamClient, err := nsClient.StreamFrameLogsForDevice(ctx, &ns.StreamFrameLogsForDeviceRequest{
+		DevEui: devEUI[:],
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := streamClient.Recv()
+		if err != nil {
+			return err
+		}
+
+		up, down, err := convertUplinkAndDownlinkFrames(resp.GetUplinkFrameSet(), resp.GetDownlinkFrame(), true)
+		if err != nil {
+			return helpers.ErrToRPCError(err)
+		}
+
+		frameResp := pb.StreamApplicationFrameLogsResponse{
+			DevEui: devEUI.String(),
+		}
+
+		if up != nil {
+			frameResp.Frame = &pb.StreamApplicationFrameLogsResponse_UplinkFrame{
+				UplinkFrame: up,
+			}
+		}
+
+		if down != nil {
+			frameResp.Frame = &pb.StreamApplicationFrameLogsResponse_DownlinkFrame{
+				DownlinkFrame: down,
+			}
+		}
+
+		select {
+		case frameChan <- &frameResp:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func integrationStatsToPB(s stats.Stats) (*pb.IntegrationStats, error) {
+	out := pb.IntegrationStats{
+		SuccessCount:   s.SuccessCount,
+		ErrorCount:     s.ErrorCount,
+		LastError:      s.LastError,
+		AverageLatency: ptypes.DurationProto(s.AverageLatency),
+	}
+
+	var err error
+	if s.LastSuccessAt != nil {
+		out.LastSuccessAt, err = ptypes.TimestampProto(*s.LastSuccessAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if s.LastErrorAt != nil {
+		out.LastErrorAt, err = ptypes.TimestampProto(*s.LastErrorAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &out, nil
+}
+
+// integrationEvents maps the API event types to the integration event types.
+var integrationEvents = map[pb.IntegrationEvent]string{
+	pb.IntegrationEvent_UPLINK:   marshaler.Uplink,
+	pb.IntegrationEvent_JOIN:     marshaler.Join,
+	pb.IntegrationEvent_ACK:      marshaler.ACK,
+	pb.IntegrationEvent_ERROR:    marshaler.Error,
+	pb.IntegrationEvent_STATUS:   marshaler.Status,
+	pb.IntegrationEvent_LOCATION: marshaler.Location,
+}
+
+func influxDBIntegrationToConfig(in *pb.InfluxDBIntegration) (influxdb.Config, error) {
+	conf := influxdb.Config{
+		Endpoint:            in.Endpoint,
+		DB:                  in.Db,
+		Username:            in.Username,
+		Password:            in.Password,
+		RetentionPolicyName: in.RetentionPolicyName,
+		Precision:           strings.ToLower(in.Precision.String()),
+		Version:             influxdb.Version1,
+		Organization:        in.Organization,
+		Bucket:              in.Bucket,
+		Token:               in.Token,
+		BatchSize:           int(in.BatchSize),
+		RetryBufferSize:     int(in.RetryBufferSize),
+		Gzip:                in.Gzip,
+		MeasurementTemplate: in.MeasurementTemplate,
+	}
+
+	if in.Version == pb.InfluxDBVersion_INFLUXDB_2 {
+		conf.Version = influxdb.Version2
+	}
+
+	if len(in.Tags) != 0 {
+		conf.Tags = make(map[string]string)
+	}
+	for _, t := range in.Tags {
+		conf.Tags[t.Key] = t.Value
+	}
+
+	if in.BatchInterval != nil {
+		var err error
+		conf.BatchInterval, err = ptypes.Duration(in.BatchInterval)
+		if err != nil {
+			return conf, err
+		}
+	}
+
+	return conf, nil
+}
+
+func influxDBConfigToIntegration(conf influxdb.Config) *pb.InfluxDBIntegration {
+	prec, _ := pb.InfluxDBPrecision_value[strings.ToUpper(conf.Precision)]
+
+	out := pb.InfluxDBIntegration{
+		Endpoint:            conf.Endpoint,
+		Db:                  conf.DB,
+		Username:            conf.Username,
+		Password:            conf.Password,
+		RetentionPolicyName: conf.RetentionPolicyName,
+		Precision:           pb.InfluxDBPrecision(prec),
+		Organization:        conf.Organization,
+		Bucket:              conf.Bucket,
+		Token:               conf.Token,
+		BatchSize:           uint32(conf.BatchSize),
+		RetryBufferSize:     uint32(conf.RetryBufferSize),
+		Gzip:                conf.Gzip,
+		MeasurementTemplate: conf.MeasurementTemplate,
+	}
+
+	for k, v := range conf.Tags {
+		out.Tags = append(out.Tags, &pb.InfluxDBIntegrationTag{
+			Key:   k,
+			Value: v,
+		})
+	}
+	sort.Slice(out.Tags, func(i, j int) bool {
+		return out.Tags[i].Key < out.Tags[j].Key
+	})
+
+	if conf.Version == influxdb.Version2 {
+		out.Version = pb.InfluxDBVersion_INFLUXDB_2
+	}
+
+	if conf.BatchInterval != 0 {
+		out.BatchInterval = ptypes.DurationProto(conf.BatchInterval)
+	}
+
+	return &out
+}
+
+func httpIntegrationToConfig(in *pb.HTTPIntegration) (http.Config, error) {
+	headers := make(map[string]string)
+	for _, h := range in.Headers {
+		headers[h.Key] = h.Value
+	}
+
+	conf := http.Config{
+		Headers:                 headers,
+		DataUpURL:               in.UplinkDataUrl,
+		JoinNotificationURL:     in.JoinNotificationUrl,
+		ACKNotificationURL:      in.AckNotificationUrl,
+		ErrorNotificationURL:    in.ErrorNotificationUrl,
+		StatusNotificationURL:   in.StatusNotificationUrl,
+		LocationNotificationURL: in.LocationNotificationUrl,
+		Marshaler:               marshaler.Type(strings.ToLower(in.Marshaler.String())),
+		Method:                  strings.ToUpper(in.Method),
+		ContentType:             in.ContentType,
+		TemplateEngine:          http.TemplateEngine(strings.ToLower(in.TemplateEngine.String())),
+	}
+
+	if in.Timeout != nil {
+		var err error
+		conf.Timeout, err = ptypes.Duration(in.Timeout)
+		if err != nil {
+			return conf, err
+		}
 	}
 
+	if len(in.BodyTemplates) != 0 {
+		conf.BodyTemplates = make(map[string]string)
+	}
+	for _, t := range in.BodyTemplates {
+		event, ok := integrationEvents[t.Event]
+		if !ok {
+			return conf, grpc.Errorf(codes.InvalidArgument, "unknown event: %s", t.Event)
+		}
+		conf.BodyTemplates[event] = t.Template
+	}
+
+	return conf, nil
+}
+
+func httpConfigToIntegration(conf http.Config) (*pb.HTTPIntegration, error) {
+	var headers []*pb.HTTPIntegrationHeader
+	for k, v := range conf.Headers {
+		headers = append(headers, &pb.HTTPIntegrationHeader{
+			Key:   k,
+			Value: v,
+		})
+
+	}
+
+	m, _ := pb.IntegrationMarshaler_value[strings.ToUpper(string(conf.Marshaler))]
+	te, _ := pb.HTTPIntegrationTemplateEngine_value[strings.ToUpper(string(conf.TemplateEngine))]
+
+	out := pb.HTTPIntegration{
+		Headers:                 headers,
+		UplinkDataUrl:           conf.DataUpURL,
+		JoinNotificationUrl:     conf.JoinNotificationURL,
+		AckNotificationUrl:      conf.ACKNotificationURL,
+		ErrorNotificationUrl:    conf.ErrorNotificationURL,
+		StatusNotificationUrl:   conf.StatusNotificationURL,
+		LocationNotificationUrl: conf.LocationNotificationURL,
+		Marshaler:               pb.IntegrationMarshaler(m),
+		Method:                  conf.Method,
+		ContentType:             conf.ContentType,
+		TemplateEngine:          pb.HTTPIntegrationTemplateEngine(te),
+		DownlinkEnabled:         conf.DownlinkToken != "",
+		DownlinkToken:           conf.DownlinkToken,
+	}
+
+	if conf.Timeout != 0 {
+		out.Timeout = ptypes.DurationProto(conf.Timeout)
+	}
+
+	for e, event := range integrationEvents {
+		if t, ok := conf.BodyTemplates[event]; ok {
+			out.BodyTemplates = append(out.BodyTemplates, &pb.HTTPIntegrationBodyTemplate{
+				Event:    e,
+				Template: t,
+			})
+		}
+	}
+	sort.Slice(out.BodyTemplates, func(i, j int) bool {
+		return out.BodyTemplates[i].Event < out.BodyTemplates[j].Event
+	})
+
 	return &out, nil
 }
@@ -0,0 +1,172 @@
+package external
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lora-app-server/internal/eventlog"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *APITestSuite) TestApplicationStreams() {
+	assert := require.New(ts.T())
+
+	nsClient := mock.NewClient()
+	networkserver.SetPool(mock.NewPool(nsClient))
+
+	validator := &TestValidator{}
+
+	grpcServer := grpc.NewServer()
+	pb.RegisterApplicationServiceServer(grpcServer, NewApplicationAPI(validator))
+
+	ln, err := net.Listen("tcp", "localhost:0")
+	assert.NoError(err)
+	go grpcServer.Serve(ln)
+	defer func() {
+		grpcServer.Stop()
+		ln.Close()
+	}()
+
+	apiClient, err := grpc.Dial(ln.Addr().String(), grpc.WithInsecure(), grpc.WithBlock())
+	assert.NoError(err)
+	defer apiClient.Close()
+
+	api := pb.NewApplicationServiceClient(apiClient)
+
+	org := storage.Organization{
+		Name: "test-org",
+	}
+	assert.NoError(storage.CreateOrganization(storage.DB(), &org))
+
+	n := storage.NetworkServer{
+		Name:   "test-ns",
+		Server: "test-ns:1234",
+	}
+	assert.NoError(storage.CreateNetworkServer(storage.DB(), &n))
+
+	sp := storage.ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(storage.CreateServiceProfile(storage.DB(), &sp))
+	spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+	assert.NoError(err)
+
+	app := storage.Application{
+		OrganizationID:   org.ID,
+		Name:             "test-app",
+		ServiceProfileID: spID,
+	}
+	assert.NoError(storage.CreateApplication(storage.DB(), &app))
+
+	dp := storage.DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(storage.CreateDeviceProfile(storage.DB(), &dp))
+	dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+	assert.NoError(err)
+
+	devices := []storage.Device{
+		{
+			DevEUI:          lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dpID,
+			Name:            "device-1",
+		},
+		{
+			DevEUI:          lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dpID,
+			Name:            "device-2",
+		},
+	}
+	for i := range devices {
+		assert.NoError(storage.CreateDevice(storage.DB(), &devices[i]))
+	}
+
+	ts.T().Run("StreamEventLogs", func(t *testing.T) {
+		tests := []struct {
+			Name         string
+			Request      pb.StreamApplicationEventLogsRequest
+			ExpectedEUIs []string
+		}{
+			{
+				Name: "all devices and types",
+				Request: pb.StreamApplicationEventLogsRequest{
+					ApplicationId: app.ID,
+				},
+				ExpectedEUIs: []string{"0101010101010101", "0202020202020202", "0202020202020202"},
+			},
+			{
+				Name: "filter on DevEUI",
+				Request: pb.StreamApplicationEventLogsRequest{
+					ApplicationId: app.ID,
+					DevEuis:       []string{"0202020202020202"},
+				},
+				ExpectedEUIs: []string{"0202020202020202", "0202020202020202"},
+			},
+			{
+				Name: "filter on type",
+				Request: pb.StreamApplicationEventLogsRequest{
+					ApplicationId: app.ID,
+					Types:         []string{eventlog.Uplink},
+				},
+				ExpectedEUIs: []string{"0101010101010101", "0202020202020202"},
+			},
+		}
+
+		for _, tst := range tests {
+			t.Run(tst.Name, func(t *testing.T) {
+				assert := require.New(t)
+
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+
+				client, err := api.StreamEventLogs(ctx, &tst.Request)
+				assert.NoError(err)
+
+				// some time for subscribing
+				time.Sleep(100 * time.Millisecond)
+
+				respChan := make(chan *pb.StreamApplicationEventLogsResponse, 10)
+				go func() {
+					for {
+						resp, err := client.Recv()
+						if err != nil {
+							return
+						}
+						respChan <- resp
+					}
+				}()
+
+				assert.NoError(eventlog.LogEventForDevice(app.ID, devices[0].DevEUI, eventlog.EventLog{Type: eventlog.Uplink}))
+				assert.NoError(eventlog.LogEventForDevice(app.ID, devices[1].DevEUI, eventlog.EventLog{Type: eventlog.Uplink}))
+				assert.NoError(eventlog.LogEventForDevice(app.ID, devices[1].DevEUI, eventlog.EventLog{Type: eventlog.Join}))
+
+				var euis []string
+				for range tst.ExpectedEUIs {
+					select {
+					case resp := <-respChan:
+						euis = append(euis, resp.DevEui)
+					case <-time.After(time.Second):
+						t.Fatal("timeout waiting for event-log")
+					}
+				}
+				assert.Equal(tst.ExpectedEUIs, euis)
+			})
+		}
+	})
+}
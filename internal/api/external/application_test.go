@@ -2,8 +2,10 @@ package external
 
 import (
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -14,6 +16,8 @@ import (
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/integration/stats"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lora-app-server/internal/test"
 )
@@ -203,6 +207,22 @@ func TestApplicationAPI(t *testing.T) {
 				})
 			})
 
+			Convey("When deleting the organization of the application", func() {
+				test.MustFlushRedis(storage.RedisPool())
+				So(stats.Record(createResp.Id, integration.HTTP, time.Millisecond, nil), ShouldBeNil)
+
+				_, err := NewOrganizationAPI(validator).Delete(ctx, &pb.DeleteOrganizationRequest{
+					Id: org.ID,
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then the integration statistics of the application have been deleted", func() {
+					items, err := stats.List()
+					So(err, ShouldBeNil)
+					So(items, ShouldHaveLength, 0)
+				})
+			})
+
 			Convey("When creating a HTTP integration", func() {
 				req := pb.CreateHTTPIntegrationRequest{
 					Integration: &pb.HTTPIntegration{
@@ -233,9 +253,20 @@ func TestApplicationAPI(t *testing.T) {
 					resp, err := api.ListIntegrations(ctx, &pb.ListIntegrationRequest{ApplicationId: createResp.Id})
 					So(err, ShouldBeNil)
 					So(resp.TotalCount, ShouldEqual, 1)
-					So(resp.Result[0], ShouldResemble, &pb.IntegrationListItem{
-						Kind: pb.IntegrationKind_HTTP,
+					So(resp.Result[0].Kind, ShouldEqual, pb.IntegrationKind_HTTP)
+					So(resp.Result[0].Stats.SuccessCount, ShouldEqual, 0)
+					So(resp.Result[0].Stats.ErrorCount, ShouldEqual, 0)
+				})
+
+				Convey("Then the integration can be tested", func() {
+					resp, err := api.TestIntegration(ctx, &pb.TestIntegrationRequest{
+						ApplicationId: createResp.Id,
+						Kind:          pb.IntegrationKind_HTTP,
+						Event:         pb.IntegrationEvent_JOIN,
 					})
+					So(err, ShouldBeNil)
+					So(resp.StatusCode, ShouldEqual, 0)
+					So(resp.Error, ShouldNotEqual, "")
 				})
 
 				Convey("Then the integration can be updated", func() {
@@ -247,7 +278,16 @@ func TestApplicationAPI(t *testing.T) {
 							AckNotificationUrl:      "http://ack2",
 							ErrorNotificationUrl:    "http://error",
 							StatusNotificationUrl:   "http://status2",
-							LocationNotificationUrl: "http://location2",
+							LocationNotificationUrl: "http://location2/{{ .DevEUI }}",
+							Marshaler:               pb.IntegrationMarshaler_CLOUDEVENTS_BINARY,
+							Method:                  "PUT",
+							Timeout:                 ptypes.DurationProto(5 * time.Second),
+							ContentType:             "text/plain",
+							TemplateEngine:          pb.HTTPIntegrationTemplateEngine_GO_TEMPLATE,
+							BodyTemplates: []*pb.HTTPIntegrationBodyTemplate{
+								{Event: pb.IntegrationEvent_UPLINK, Template: "{{ .FCnt }}"},
+								{Event: pb.IntegrationEvent_STATUS, Template: "{{ .Battery }}"},
+							},
 						},
 					}
 					_, err := api.UpdateHTTPIntegration(ctx, &req)
@@ -259,6 +299,43 @@ func TestApplicationAPI(t *testing.T) {
 					So(i.Integration, ShouldResemble, req.Integration)
 				})
 
+				Convey("Then the downlink endpoint can be enabled", func() {
+					req := pb.UpdateHTTPIntegrationRequest{
+						Integration: &pb.HTTPIntegration{
+							ApplicationId:   createResp.Id,
+							UplinkDataUrl:   "http://up",
+							DownlinkEnabled: true,
+						},
+					}
+					_, err := api.UpdateHTTPIntegration(ctx, &req)
+					So(err, ShouldBeNil)
+
+					i, err := api.GetHTTPIntegration(ctx, &pb.GetHTTPIntegrationRequest{ApplicationId: createResp.Id})
+					So(err, ShouldBeNil)
+					So(i.Integration.DownlinkEnabled, ShouldBeTrue)
+					So(i.Integration.DownlinkToken, ShouldHaveLength, 64)
+
+					Convey("Then updating the integration keeps the token", func() {
+						_, err := api.UpdateHTTPIntegration(ctx, &req)
+						So(err, ShouldBeNil)
+
+						i2, err := api.GetHTTPIntegration(ctx, &pb.GetHTTPIntegrationRequest{ApplicationId: createResp.Id})
+						So(err, ShouldBeNil)
+						So(i2.Integration.DownlinkToken, ShouldEqual, i.Integration.DownlinkToken)
+					})
+
+					Convey("Then disabling the downlink endpoint removes the token", func() {
+						req.Integration.DownlinkEnabled = false
+						_, err := api.UpdateHTTPIntegration(ctx, &req)
+						So(err, ShouldBeNil)
+
+						i2, err := api.GetHTTPIntegration(ctx, &pb.GetHTTPIntegrationRequest{ApplicationId: createResp.Id})
+						So(err, ShouldBeNil)
+						So(i2.Integration.DownlinkEnabled, ShouldBeFalse)
+						So(i2.Integration.DownlinkToken, ShouldEqual, "")
+					})
+				})
+
 				Convey("Then the integration can be deleted", func() {
 					_, err := api.DeleteHTTPIntegration(ctx, &pb.DeleteHTTPIntegrationRequest{ApplicationId: createResp.Id})
 					So(err, ShouldBeNil)
@@ -303,13 +380,22 @@ func TestApplicationAPI(t *testing.T) {
 				Convey("Then the integration can be updated", func() {
 					updateReq := pb.UpdateInfluxDBIntegrationRequest{
 						Integration: &pb.InfluxDBIntegration{
-							ApplicationId:       createResp.Id,
-							Endpoint:            "http://localhost:8086/write2",
-							Db:                  "loraserver2",
-							Username:            "username2",
-							Password:            "password2",
-							RetentionPolicyName: "CUSTOM",
-							Precision:           pb.InfluxDBPrecision_S,
+							ApplicationId:   createResp.Id,
+							Endpoint:        "http://localhost:8086/api/v2/write",
+							Precision:       pb.InfluxDBPrecision_S,
+							Version:         pb.InfluxDBVersion_INFLUXDB_2,
+							Organization:    "test-org",
+							Bucket:          "test-bucket",
+							Token:           "test-token",
+							BatchSize:       100,
+							BatchInterval:   ptypes.DurationProto(10 * time.Second),
+							RetryBufferSize: 1000,
+							Gzip:            true,
+							Tags: []*pb.InfluxDBIntegrationTag{
+								{Key: "application_id", Value: "{{.ApplicationID}}"},
+								{Key: "site", Value: "north"},
+							},
+							MeasurementTemplate: "{{.ApplicationName}}_{{.Key}}",
 						},
 					}
 					_, err := api.UpdateInfluxDBIntegration(ctx, &updateReq)
@@ -192,6 +192,16 @@ func TestValidators(t *testing.T) {
 		}
 	}
 
+	codecs := []storage.Codec{
+		{Name: "org-codec", OrganizationID: &organizations[0].ID},
+		{Name: "global-codec"},
+	}
+	for i := range codecs {
+		if err := storage.CreateCodec(storage.DB(), &codecs[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
 	Convey("Given a set of test users, applications and devices", t, func() {
 
 		Convey("When testing ValidateUsersAccess (DisableAssignExistingUsers=false)", func() {
@@ -1185,6 +1195,104 @@ func TestValidators(t *testing.T) {
 
 			runTests(tests, storage.DB())
 		})
+
+		Convey("When testing ValidateCodecsAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can create and list",
+					Validators: []ValidatorFunc{ValidateCodecsAccess(Create, organizations[0].ID), ValidateCodecsAccess(List, organizations[0].ID), ValidateCodecsAccess(Create, 0), ValidateCodecsAccess(List, 0)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can create and list",
+					Validators: []ValidatorFunc{ValidateCodecsAccess(Create, organizations[0].ID), ValidateCodecsAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can not create global codecs",
+					Validators: []ValidatorFunc{ValidateCodecsAccess(Create, 0)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "organization users can list",
+					Validators: []ValidatorFunc{ValidateCodecsAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not create",
+					Validators: []ValidatorFunc{ValidateCodecsAccess(Create, organizations[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "any active user can list global codecs",
+					Validators: []ValidatorFunc{ValidateCodecsAccess(List, 0)},
+					Claims:     Claims{Username: "user4"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "non-organization users can not create or list",
+					Validators: []ValidatorFunc{ValidateCodecsAccess(Create, organizations[0].ID), ValidateCodecsAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, storage.DB())
+		})
+
+		Convey("When testing ValidateCodecAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateCodecAccess(Read, codecs[0].ID), ValidateCodecAccess(Update, codecs[0].ID), ValidateCodecAccess(Delete, codecs[0].ID), ValidateCodecAccess(Update, codecs[1].ID), ValidateCodecAccess(Delete, codecs[1].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateCodecAccess(Read, codecs[0].ID), ValidateCodecAccess(Update, codecs[0].ID), ValidateCodecAccess(Delete, codecs[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can not update or delete global codecs",
+					Validators: []ValidatorFunc{ValidateCodecAccess(Update, codecs[1].ID), ValidateCodecAccess(Delete, codecs[1].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "organization users can read",
+					Validators: []ValidatorFunc{ValidateCodecAccess(Read, codecs[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not update or delete",
+					Validators: []ValidatorFunc{ValidateCodecAccess(Update, codecs[0].ID), ValidateCodecAccess(Delete, codecs[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "any active user can read global codecs",
+					Validators: []ValidatorFunc{ValidateCodecAccess(Read, codecs[1].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "non-organization users can not read, update or delete",
+					Validators: []ValidatorFunc{ValidateCodecAccess(Read, codecs[0].ID), ValidateCodecAccess(Update, codecs[0].ID), ValidateCodecAccess(Delete, codecs[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, storage.DB())
+		})
 	})
 }
 
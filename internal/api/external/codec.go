@@ -0,0 +1,396 @@
+package external
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/external/auth"
+	"github.com/brocaar/lora-app-server/internal/api/helpers"
+	"github.com/brocaar/lora-app-server/internal/codec"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// CodecAPI exports the codec library related functions.
+type CodecAPI struct {
+	validator auth.Validator
+}
+
+// NewCodecAPI creates a new CodecAPI.
+func NewCodecAPI(validator auth.Validator) *CodecAPI {
+	return &CodecAPI{
+		validator: validator,
+	}
+}
+
+// Create creates the given codec.
+func (a *CodecAPI) Create(ctx context.Context, req *pb.CreateCodecRequest) (*pb.CreateCodecResponse, error) {
+	if req.Codec == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "codec must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateCodecsAccess(auth.Create, req.Codec.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	c := storage.Codec{
+		Name:        req.Codec.Name,
+		Description: req.Codec.Description,
+	}
+	if req.Codec.OrganizationId != 0 {
+		c.OrganizationID = &req.Codec.OrganizationId
+	}
+
+	if err := storage.CreateCodec(storage.DB(), &c); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &pb.CreateCodecResponse{
+		Id: c.ID,
+	}, nil
+}
+
+// Get returns the codec matching the given id.
+func (a *CodecAPI) Get(ctx context.Context, req *pb.GetCodecRequest) (*pb.GetCodecResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateCodecAccess(auth.Read, req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	c, err := storage.GetCodec(storage.DB(), req.Id, false)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	resp := pb.GetCodecResponse{
+		Codec: &pb.Codec{
+			Id:          c.ID,
+			Name:        c.Name,
+			Description: c.Description,
+		},
+	}
+	if c.OrganizationID != nil {
+		resp.Codec.OrganizationId = *c.OrganizationID
+	}
+
+	versions, err := storage.GetCodecVersions(storage.DB(), c.ID, 1, 0)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	if len(versions) != 0 {
+		resp.LatestVersion = uint32(versions[0].Version)
+	}
+
+	v, err := storage.GetCodecVersion(storage.DB(), c.ID, 0)
+	if err != nil && err != storage.ErrDoesNotExist {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	resp.LatestPublishedVersion = uint32(v.Version)
+
+	resp.CreatedAt, err = ptypes.TimestampProto(c.CreatedAt)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	resp.UpdatedAt, err = ptypes.TimestampProto(c.UpdatedAt)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &resp, nil
+}
+
+// Update updates the given codec.
+func (a *CodecAPI) Update(ctx context.Context, req *pb.UpdateCodecRequest) (*empty.Empty, error) {
+	if req.Codec == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "codec must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateCodecAccess(auth.Update, req.Codec.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	err := storage.Transaction(func(tx sqlx.Ext) error {
+		c, err := storage.GetCodec(tx, req.Codec.Id, true)
+		if err != nil {
+			return err
+		}
+
+		c.Name = req.Codec.Name
+		c.Description = req.Codec.Description
+
+		return storage.UpdateCodec(tx, &c)
+	})
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// Delete deletes the codec matching the given id.
+func (a *CodecAPI) Delete(ctx context.Context, req *pb.DeleteCodecRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateCodecAccess(auth.Delete, req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteCodec(storage.DB(), req.Id); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// List lists the codecs available to the given organization.
+func (a *CodecAPI) List(ctx context.Context, req *pb.ListCodecRequest) (*pb.ListCodecResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateCodecsAccess(auth.List, req.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetCodecCount(storage.DB(), req.OrganizationId)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	codecs, err := storage.GetCodecs(storage.DB(), req.OrganizationId, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	resp := pb.ListCodecResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, c := range codecs {
+		row := pb.CodecListItem{
+			Id:                     c.ID,
+			Name:                   c.Name,
+			Description:            c.Description,
+			LatestVersion:          uint32(c.LatestVersion),
+			LatestPublishedVersion: uint32(c.LatestPublishedVersion),
+		}
+		if c.OrganizationID != nil {
+			row.OrganizationId = *c.OrganizationID
+		}
+
+		row.CreatedAt, err = ptypes.TimestampProto(c.CreatedAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+		row.UpdatedAt, err = ptypes.TimestampProto(c.UpdatedAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		resp.Result = append(resp.Result, &row)
+	}
+
+	return &resp, nil
+}
+
+// CreateVersion creates a new version of the given codec.
+func (a *CodecAPI) CreateVersion(ctx context.Context, req *pb.CreateCodecVersionRequest) (*pb.CreateCodecVersionResponse, error) {
+	if req.CodecVersion == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "codec_version must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateCodecAccess(auth.Update, req.CodecVersion.CodecId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	v := storage.CodecVersion{
+		CodecID:              req.CodecVersion.CodecId,
+		Description:          req.CodecVersion.Description,
+		PayloadCodec:         codec.Type(req.CodecVersion.PayloadCodec),
+		PayloadEncoderScript: req.CodecVersion.PayloadEncoderScript,
+		PayloadDecoderScript: req.CodecVersion.PayloadDecoderScript,
+		DescriptorSet:        req.CodecVersion.PayloadCodecDescriptorSet,
+		WASMModule:           req.CodecVersion.PayloadCodecWasmModule,
+		Published:            req.CodecVersion.Published,
+	}
+
+	// lock the codec to avoid concurrent creation of the same version number
+	err := storage.Transaction(func(tx sqlx.Ext) error {
+		if _, err := storage.GetCodec(tx, v.CodecID, true); err != nil {
+			return err
+		}
+
+		return storage.CreateCodecVersion(tx, &v)
+	})
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &pb.CreateCodecVersionResponse{
+		Version: uint32(v.Version),
+	}, nil
+}
+
+// PublishVersion publishes the given codec version.
+func (a *CodecAPI) PublishVersion(ctx context.Context, req *pb.PublishCodecVersionRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateCodecAccess(auth.Update, req.CodecId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.PublishCodecVersion(storage.DB(), req.CodecId, int(req.Version)); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// GetVersion returns the given codec version.
+func (a *CodecAPI) GetVersion(ctx context.Context, req *pb.GetCodecVersionRequest) (*pb.GetCodecVersionResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateCodecAccess(auth.Read, req.CodecId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	v, err := storage.GetCodecVersion(storage.DB(), req.CodecId, int(req.Version))
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	resp := pb.GetCodecVersionResponse{
+		CodecVersion: &pb.CodecVersion{
+			CodecId:                   v.CodecID,
+			Version:                   uint32(v.Version),
+			Description:               v.Description,
+			PayloadCodec:              string(v.PayloadCodec),
+			PayloadEncoderScript:      v.PayloadEncoderScript,
+			PayloadDecoderScript:      v.PayloadDecoderScript,
+			PayloadCodecDescriptorSet: v.DescriptorSet,
+			PayloadCodecWasmModule:    v.WASMModule,
+			Published:                 v.Published,
+		},
+	}
+
+	resp.CreatedAt, err = ptypes.TimestampProto(v.CreatedAt)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &resp, nil
+}
+
+// ListVersions lists the versions of the given codec.
+func (a *CodecAPI) ListVersions(ctx context.Context, req *pb.ListCodecVersionsRequest) (*pb.ListCodecVersionsResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateCodecAccess(auth.Read, req.CodecId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetCodecVersionCount(storage.DB(), req.CodecId)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	versions, err := storage.GetCodecVersions(storage.DB(), req.CodecId, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	resp := pb.ListCodecVersionsResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, v := range versions {
+		row := pb.CodecVersionListItem{
+			CodecId:      v.CodecID,
+			Version:      uint32(v.Version),
+			Description:  v.Description,
+			PayloadCodec: string(v.PayloadCodec),
+			Published:    v.Published,
+		}
+
+		row.CreatedAt, err = ptypes.TimestampProto(v.CreatedAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		resp.Result = append(resp.Result, &row)
+	}
+
+	return &resp, nil
+}
+
+// ListUsage lists the device-profiles using the given codec.
+// As global codecs can be used by all organizations, this requires the
+// same permissions as updating the codec.
+func (a *CodecAPI) ListUsage(ctx context.Context, req *pb.ListCodecUsageRequest) (*pb.ListCodecUsageResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateCodecAccess(auth.Update, req.CodecId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	usage, err := storage.GetCodecUsage(storage.DB(), req.CodecId)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	var resp pb.ListCodecUsageResponse
+	for _, u := range usage {
+		row := pb.CodecUsageListItem{
+			DeviceProfileId: u.DeviceProfileID.String(),
+			Name:            u.Name,
+			OrganizationId:  u.OrganizationID,
+		}
+		if u.CodecVersion != nil {
+			row.CodecVersion = uint32(*u.CodecVersion)
+		}
+
+		resp.Result = append(resp.Result, &row)
+	}
+
+	return &resp, nil
+}
+
+// codecFromPB returns the codec id and version pointers for the given
+// device-profile codec fields.
+func codecFromPB(codecID int64, codecVersion uint32) (*int64, *int) {
+	if codecID == 0 {
+		return nil, nil
+	}
+
+	if codecVersion == 0 {
+		return &codecID, nil
+	}
+
+	version := int(codecVersion)
+	return &codecID, &version
+}
+
+// codecToPB returns the device-profile codec fields for the given codec id
+// and version pointers.
+func codecToPB(codecID *int64, codecVersion *int) (int64, uint32) {
+	var id int64
+	var version uint32
+
+	if codecID != nil {
+		id = *codecID
+	}
+	if codecVersion != nil {
+		version = uint32(*codecVersion)
+	}
+
+	return id, version
+}
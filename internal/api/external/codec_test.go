@@ -0,0 +1,288 @@
+package external
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+func (ts *APITestSuite) TestCodec() {
+	assert := require.New(ts.T())
+
+	nsClient := mock.NewClient()
+	networkserver.SetPool(mock.NewPool(nsClient))
+
+	validator := &TestValidator{}
+	api := NewCodecAPI(validator)
+	dpAPI := NewDeviceProfileServiceAPI(validator)
+
+	n := storage.NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(storage.CreateNetworkServer(storage.DB(), &n))
+
+	org := storage.Organization{
+		Name: "test-org",
+	}
+	assert.NoError(storage.CreateOrganization(storage.DB(), &org))
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		createReq := pb.CreateCodecRequest{
+			Codec: &pb.Codec{
+				OrganizationId: org.ID,
+				Name:           "test-codec",
+				Description:    "test codec",
+			},
+		}
+		createResp, err := api.Create(context.Background(), &createReq)
+		assert.NoError(err)
+		assert.NotEqual(0, createResp.Id)
+		createReq.Codec.Id = createResp.Id
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+
+			getResp, err := api.Get(context.Background(), &pb.GetCodecRequest{Id: createResp.Id})
+			assert.NoError(err)
+			assert.Equal(createReq.Codec, getResp.Codec)
+			assert.EqualValues(0, getResp.LatestVersion)
+			assert.NotNil(getResp.CreatedAt)
+			assert.NotNil(getResp.UpdatedAt)
+		})
+
+		t.Run("List", func(t *testing.T) {
+			assert := require.New(t)
+
+			listResp, err := api.List(context.Background(), &pb.ListCodecRequest{
+				OrganizationId: org.ID,
+				Limit:          10,
+			})
+			assert.NoError(err)
+			assert.EqualValues(1, listResp.TotalCount)
+			assert.Len(listResp.Result, 1)
+			assert.Equal("test-codec", listResp.Result[0].Name)
+
+			listResp, err = api.List(context.Background(), &pb.ListCodecRequest{
+				Limit: 10,
+			})
+			assert.NoError(err)
+			assert.EqualValues(0, listResp.TotalCount)
+		})
+
+		t.Run("Update", func(t *testing.T) {
+			assert := require.New(t)
+
+			updateReq := pb.UpdateCodecRequest{
+				Codec: &pb.Codec{
+					Id:             createResp.Id,
+					OrganizationId: org.ID,
+					Name:           "updated-codec",
+					Description:    "updated codec",
+				},
+			}
+			_, err := api.Update(context.Background(), &updateReq)
+			assert.NoError(err)
+
+			getResp, err := api.Get(context.Background(), &pb.GetCodecRequest{Id: createResp.Id})
+			assert.NoError(err)
+			assert.Equal(updateReq.Codec, getResp.Codec)
+		})
+
+		t.Run("CreateVersion", func(t *testing.T) {
+			assert := require.New(t)
+
+			for i, script := range []string{"decode-1", "decode-2"} {
+				resp, err := api.CreateVersion(context.Background(), &pb.CreateCodecVersionRequest{
+					CodecVersion: &pb.CodecVersion{
+						CodecId:              createResp.Id,
+						Description:          script,
+						PayloadCodec:         "CUSTOM_JS",
+						PayloadDecoderScript: script,
+						Published:            true,
+					},
+				})
+				assert.NoError(err)
+				assert.EqualValues(i+1, resp.Version)
+			}
+
+			t.Run("GetVersion", func(t *testing.T) {
+				assert := require.New(t)
+
+				resp, err := api.GetVersion(context.Background(), &pb.GetCodecVersionRequest{
+					CodecId: createResp.Id,
+				})
+				assert.NoError(err)
+				assert.Equal(&pb.CodecVersion{
+					CodecId:              createResp.Id,
+					Version:              2,
+					Description:          "decode-2",
+					PayloadCodec:         "CUSTOM_JS",
+					PayloadDecoderScript: "decode-2",
+					Published:            true,
+				}, resp.CodecVersion)
+
+				resp, err = api.GetVersion(context.Background(), &pb.GetCodecVersionRequest{
+					CodecId: createResp.Id,
+					Version: 1,
+				})
+				assert.NoError(err)
+				assert.Equal("decode-1", resp.CodecVersion.PayloadDecoderScript)
+
+				_, err = api.GetVersion(context.Background(), &pb.GetCodecVersionRequest{
+					CodecId: createResp.Id,
+					Version: 3,
+				})
+				assert.Equal(codes.NotFound, grpc.Code(err))
+			})
+
+			t.Run("ListVersions", func(t *testing.T) {
+				assert := require.New(t)
+
+				resp, err := api.ListVersions(context.Background(), &pb.ListCodecVersionsRequest{
+					CodecId: createResp.Id,
+					Limit:   10,
+				})
+				assert.NoError(err)
+				assert.EqualValues(2, resp.TotalCount)
+				assert.Len(resp.Result, 2)
+				assert.EqualValues(2, resp.Result[0].Version)
+				assert.EqualValues(1, resp.Result[1].Version)
+			})
+
+			t.Run("Device-profile", func(t *testing.T) {
+				assert := require.New(t)
+
+				dpResp, err := dpAPI.Create(context.Background(), &pb.CreateDeviceProfileRequest{
+					DeviceProfile: &pb.DeviceProfile{
+						Name:            "test-dp",
+						OrganizationId:  org.ID,
+						NetworkServerId: n.ID,
+						CodecId:         createResp.Id,
+						CodecVersion:    1,
+					},
+				})
+				assert.NoError(err)
+
+				getResp, err := dpAPI.Get(context.Background(), &pb.GetDeviceProfileRequest{Id: dpResp.Id})
+				assert.NoError(err)
+				assert.Equal(createResp.Id, getResp.DeviceProfile.CodecId)
+				assert.EqualValues(1, getResp.DeviceProfile.CodecVersion)
+
+				t.Run("ListUsage", func(t *testing.T) {
+					assert := require.New(t)
+
+					dpID, err := uuid.FromString(dpResp.Id)
+					assert.NoError(err)
+
+					resp, err := api.ListUsage(context.Background(), &pb.ListCodecUsageRequest{
+						CodecId: createResp.Id,
+					})
+					assert.NoError(err)
+					assert.Equal([]*pb.CodecUsageListItem{
+						{DeviceProfileId: dpID.String(), Name: "test-dp", OrganizationId: org.ID, CodecVersion: 1},
+					}, resp.Result)
+				})
+
+				t.Run("Delete codec in use", func(t *testing.T) {
+					assert := require.New(t)
+
+					_, err := api.Delete(context.Background(), &pb.DeleteCodecRequest{Id: createResp.Id})
+					assert.Equal(codes.FailedPrecondition, grpc.Code(err))
+				})
+
+				_, err = dpAPI.Delete(context.Background(), &pb.DeleteDeviceProfileRequest{Id: dpResp.Id})
+				assert.NoError(err)
+			})
+
+			t.Run("Draft version", func(t *testing.T) {
+				assert := require.New(t)
+
+				resp, err := api.CreateVersion(context.Background(), &pb.CreateCodecVersionRequest{
+					CodecVersion: &pb.CodecVersion{
+						CodecId:      createResp.Id,
+						Description:  "draft",
+						PayloadCodec: "CUSTOM_JS",
+						PayloadDecoderScript: `
+							function Decode(fPort, bytes) {
+								return {"version": 3};
+							}
+						`,
+					},
+				})
+				assert.NoError(err)
+				assert.EqualValues(3, resp.Version)
+
+				getResp, err := api.Get(context.Background(), &pb.GetCodecRequest{Id: createResp.Id})
+				assert.NoError(err)
+				assert.EqualValues(3, getResp.LatestVersion)
+				assert.EqualValues(2, getResp.LatestPublishedVersion)
+
+				versionResp, err := api.GetVersion(context.Background(), &pb.GetCodecVersionRequest{
+					CodecId: createResp.Id,
+				})
+				assert.NoError(err)
+				assert.EqualValues(2, versionResp.CodecVersion.Version)
+
+				t.Run("TestPayloadCodec", func(t *testing.T) {
+					assert := require.New(t)
+
+					testResp, err := dpAPI.TestPayloadCodec(context.Background(), &pb.TestPayloadCodecRequest{
+						OrganizationId: org.ID,
+						CodecId:        createResp.Id,
+						CodecVersion:   3,
+						FPort:          1,
+						Data:           []byte{1},
+					})
+					assert.NoError(err)
+					assert.Equal("", testResp.Error)
+					assert.Equal(`{"version":3}`, testResp.ObjectJson)
+				})
+
+				t.Run("PublishVersion", func(t *testing.T) {
+					assert := require.New(t)
+
+					_, err := api.PublishVersion(context.Background(), &pb.PublishCodecVersionRequest{
+						CodecId: createResp.Id,
+						Version: 3,
+					})
+					assert.NoError(err)
+
+					versionResp, err := api.GetVersion(context.Background(), &pb.GetCodecVersionRequest{
+						CodecId: createResp.Id,
+					})
+					assert.NoError(err)
+					assert.EqualValues(3, versionResp.CodecVersion.Version)
+					assert.True(versionResp.CodecVersion.Published)
+
+					_, err = api.PublishVersion(context.Background(), &pb.PublishCodecVersionRequest{
+						CodecId: createResp.Id,
+						Version: 4,
+					})
+					assert.Equal(codes.NotFound, grpc.Code(err))
+				})
+			})
+		})
+
+		t.Run("Delete", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := api.Delete(context.Background(), &pb.DeleteCodecRequest{Id: createResp.Id})
+			assert.NoError(err)
+
+			_, err = api.Get(context.Background(), &pb.GetCodecRequest{Id: createResp.Id})
+			assert.Equal(codes.NotFound, grpc.Code(err))
+		})
+	})
+}
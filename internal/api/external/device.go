@@ -1,12 +1,17 @@
 package external
 
 import (
+	"bytes"
+	"encoding/csv"
 	"encoding/hex"
 	"encoding/json"
+	"strconv"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -26,6 +31,10 @@ import (
 	"github.com/brocaar/lorawan"
 )
 
+// maxUplinkExportCount defines the max. number of uplinks which can be
+// exported at once.
+const maxUplinkExportCount = 10000
+
 // DeviceAPI exports the Node related functions.
 type DeviceAPI struct {
 	validator auth.Validator
@@ -72,6 +81,7 @@ func (a *DeviceAPI) Create(ctx context.Context, req *pb.CreateDeviceRequest) (*e
 		Description:       req.Device.Description,
 		SkipFCntCheck:     req.Device.SkipFCntCheck,
 		ReferenceAltitude: req.Device.ReferenceAltitude,
+		Variables:         req.Device.Variables,
 	}
 
 	// as this also performs a remote call to create the node on the
@@ -112,6 +122,7 @@ func (a *DeviceAPI) Get(ctx context.Context, req *pb.GetDeviceRequest) (*pb.GetD
 			DeviceProfileId:   d.DeviceProfileID.String(),
 			SkipFCntCheck:     d.SkipFCntCheck,
 			ReferenceAltitude: d.ReferenceAltitude,
+			Variables:         d.Variables,
 		},
 
 		DeviceStatusBattery: 256,
@@ -280,6 +291,7 @@ func (a *DeviceAPI) Update(ctx context.Context, req *pb.UpdateDeviceRequest) (*e
 		d.Description = req.Device.Description
 		d.SkipFCntCheck = req.Device.SkipFCntCheck
 		d.ReferenceAltitude = req.Device.ReferenceAltitude
+		d.Variables = req.Device.Variables
 
 		if err := storage.UpdateDevice(tx, &d, false); err != nil {
 			return helpers.ErrToRPCError(err)
@@ -315,6 +327,10 @@ func (a *DeviceAPI) Delete(ctx context.Context, req *pb.DeleteDeviceRequest) (*e
 		return nil, helpers.ErrToRPCError(err)
 	}
 
+	if err := storage.DeleteDeviceCodecState(storage.RedisPool(), eui); err != nil {
+		log.WithError(err).WithField("dev_eui", eui).Error("delete device codec state error")
+	}
+
 	return &empty.Empty{}, nil
 }
 
@@ -788,6 +804,303 @@ func (a *DeviceAPI) GetRandomDevAddr(ctx context.Context, req *pb.GetRandomDevAd
 	}, nil
 }
 
+// GetCodecState returns the codec state of the device.
+func (a *DeviceAPI) GetCodecState(ctx context.Context, req *pb.GetDeviceCodecStateRequest) (*pb.GetDeviceCodecStateResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Read),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	state, err := storage.GetDeviceCodecState(storage.RedisPool(), devEUI)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &pb.GetDeviceCodecStateResponse{
+		StateJson: string(state),
+	}, nil
+}
+
+// ResetCodecState resets the codec state of the device.
+func (a *DeviceAPI) ResetCodecState(ctx context.Context, req *pb.ResetDeviceCodecStateRequest) (*empty.Empty, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteDeviceCodecState(storage.RedisPool(), devEUI); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// ListUplinks returns the stored uplinks of the device.
+func (a *DeviceAPI) ListUplinks(ctx context.Context, req *pb.ListDeviceUplinksRequest) (*pb.ListDeviceUplinksResponse, error) {
+	filters, err := a.uplinkFilters(ctx, req.DevEui, req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Offset < 0 || req.Limit < 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "offset and limit must be >= 0")
+	}
+	filters.Limit = int(req.Limit)
+	filters.Offset = int(req.Offset)
+
+	count, err := storage.GetDeviceUplinkCount(storage.DB(), filters)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	uplinks, err := storage.GetDeviceUplinks(storage.DB(), filters)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	resp := pb.ListDeviceUplinksResponse{
+		TotalCount: int64(count),
+	}
+	for _, u := range uplinks {
+		item := pb.DeviceUplink{
+			FCnt:       u.FCnt,
+			FPort:      uint32(u.FPort),
+			Dr:         uint32(u.DR),
+			Data:       u.Data,
+			ObjectJson: string(u.Object),
+			RxInfoJson: string(u.RXInfo),
+		}
+
+		item.ReceivedAt, err = ptypes.TimestampProto(u.ReceivedAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		resp.Result = append(resp.Result, &item)
+	}
+
+	return &resp, nil
+}
+
+// ExportUplinks exports the stored uplinks of the device as JSON or CSV.
+func (a *DeviceAPI) ExportUplinks(ctx context.Context, req *pb.ExportDeviceUplinksRequest) (*pb.ExportDeviceUplinksResponse, error) {
+	filters, err := a.uplinkFilters(ctx, req.DevEui, req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := storage.GetDeviceUplinkCount(storage.DB(), filters)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	if count > maxUplinkExportCount {
+		return nil, grpc.Errorf(codes.InvalidArgument, "the time range contains %d uplinks, at most %d uplinks can be exported", count, maxUplinkExportCount)
+	}
+
+	filters.Limit = count
+	uplinks, err := storage.GetDeviceUplinks(storage.DB(), filters)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	// export the uplinks in chronological order
+	for i, j := 0, len(uplinks)-1; i < j; i, j = i+1, j-1 {
+		uplinks[i], uplinks[j] = uplinks[j], uplinks[i]
+	}
+
+	var resp pb.ExportDeviceUplinksResponse
+	switch req.Format {
+	case pb.UplinkExportFormat_EXPORT_JSON:
+		resp.ContentType = "application/json"
+		resp.Data, err = exportUplinksJSON(uplinks)
+	case pb.UplinkExportFormat_EXPORT_CSV:
+		resp.ContentType = "text/csv"
+		resp.Data, err = exportUplinksCSV(uplinks)
+	default:
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid export format: %s", req.Format)
+	}
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &resp, nil
+}
+
+// GetStatusHistory returns the aggregated device-status history and the
+// predicted battery depletion of the device.
+func (a *DeviceAPI) GetStatusHistory(ctx context.Context, req *pb.GetDeviceStatusHistoryRequest) (*pb.GetDeviceStatusHistoryResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Read),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	start, err := ptypes.Timestamp(req.StartTimestamp)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "start_timestamp: %s", err)
+	}
+
+	end, err := ptypes.Timestamp(req.EndTimestamp)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "end_timestamp: %s", err)
+	}
+
+	history, err := storage.GetDeviceStatusHistory(storage.DB(), devEUI, req.Interval, start, end)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	depletion, err := storage.GetDeviceBatteryDepletion(storage.DB(), devEUI, start, end)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	var resp pb.GetDeviceStatusHistoryResponse
+	for _, h := range history {
+		item := pb.DeviceStatusHistory{
+			Count:               int32(h.Count),
+			Margin:              int32(h.Margin),
+			ExternalPowerSource: h.ExternalPowerSource,
+		}
+
+		if h.BatteryLevel != nil {
+			item.BatteryLevel = *h.BatteryLevel
+		} else {
+			item.BatteryLevelUnavailable = true
+		}
+
+		item.Timestamp, err = ptypes.TimestampProto(h.Timestamp)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		resp.Result = append(resp.Result, &item)
+	}
+
+	if depletion != nil {
+		resp.BatteryDepletionPredictedAt, err = ptypes.TimestampProto(*depletion)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+	}
+
+	return &resp, nil
+}
+
+// uplinkFilters validates the access to the uplinks of the given device and
+// returns the filters for the given time range.
+func (a *DeviceAPI) uplinkFilters(ctx context.Context, devEUIStr string, start, end *timestamp.Timestamp) (storage.DeviceUplinkFilters, error) {
+	var filters storage.DeviceUplinkFilters
+	if err := filters.DevEUI.UnmarshalText([]byte(devEUIStr)); err != nil {
+		return filters, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(filters.DevEUI, auth.Read),
+	); err != nil {
+		return filters, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	var err error
+	if start != nil {
+		filters.Start, err = ptypes.Timestamp(start)
+		if err != nil {
+			return filters, grpc.Errorf(codes.InvalidArgument, "start: %s", err)
+		}
+	}
+
+	if end != nil {
+		filters.End, err = ptypes.Timestamp(end)
+		if err != nil {
+			return filters, grpc.Errorf(codes.InvalidArgument, "end: %s", err)
+		}
+	}
+
+	return filters, nil
+}
+
+// exportUplinksJSON returns the given uplinks as JSON array.
+func exportUplinksJSON(uplinks []storage.DeviceUplink) (string, error) {
+	type item struct {
+		ReceivedAt time.Time       `json:"receivedAt"`
+		FCnt       uint32          `json:"fCnt"`
+		FPort      uint8           `json:"fPort"`
+		DR         int             `json:"dr"`
+		Data       []byte          `json:"data"`
+		Object     json.RawMessage `json:"object"`
+		RXInfo     json.RawMessage `json:"rxInfo"`
+	}
+
+	items := make([]item, 0, len(uplinks))
+	for _, u := range uplinks {
+		items = append(items, item{
+			ReceivedAt: u.ReceivedAt.UTC(),
+			FCnt:       u.FCnt,
+			FPort:      u.FPort,
+			DR:         u.DR,
+			Data:       u.Data,
+			Object:     u.Object,
+			RXInfo:     u.RXInfo,
+		})
+	}
+
+	b, err := json.Marshal(items)
+	if err != nil {
+		return "", errors.Wrap(err, "marshal json error")
+	}
+
+	return string(b), nil
+}
+
+// exportUplinksCSV returns the given uplinks as CSV. The data is HEX
+// encoded, the object and rx-info JSON encoded.
+func exportUplinksCSV(uplinks []storage.DeviceUplink) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write([]string{"receivedAt", "fCnt", "fPort", "dr", "data", "object", "rxInfo"}); err != nil {
+		return "", errors.Wrap(err, "write csv error")
+	}
+
+	for _, u := range uplinks {
+		if err := w.Write([]string{
+			u.ReceivedAt.UTC().Format(time.RFC3339Nano),
+			strconv.FormatUint(uint64(u.FCnt), 10),
+			strconv.FormatUint(uint64(u.FPort), 10),
+			strconv.Itoa(u.DR),
+			hex.EncodeToString(u.Data),
+			string(u.Object),
+			string(u.RXInfo),
+		}); err != nil {
+			return "", errors.Wrap(err, "write csv error")
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", errors.Wrap(err, "write csv error")
+	}
+
+	return buf.String(), nil
+}
+
 func (a *DeviceAPI) returnList(count int, devices []storage.DeviceListItem) (*pb.ListDeviceResponse, error) {
 	resp := pb.ListDeviceResponse{
 		TotalCount: int64(count),
@@ -1,6 +1,9 @@
 package external
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/gofrs/uuid"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
@@ -43,6 +46,12 @@ func (a *DeviceProfileServiceAPI) Create(ctx context.Context, req *pb.CreateDevi
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	payloadCodecFPorts, err := payloadCodecsFromPB(req.DeviceProfile.PayloadCodecFPorts)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	codecID, codecVersion := codecFromPB(req.DeviceProfile.CodecId, req.DeviceProfile.CodecVersion)
+
 	dp := storage.DeviceProfile{
 		OrganizationID:       req.DeviceProfile.OrganizationId,
 		NetworkServerID:      req.DeviceProfile.NetworkServerId,
@@ -50,6 +59,13 @@ func (a *DeviceProfileServiceAPI) Create(ctx context.Context, req *pb.CreateDevi
 		PayloadCodec:         codec.Type(req.DeviceProfile.PayloadCodec),
 		PayloadEncoderScript: req.DeviceProfile.PayloadEncoderScript,
 		PayloadDecoderScript: req.DeviceProfile.PayloadDecoderScript,
+		PayloadCodecFPorts:   payloadCodecFPorts,
+		DescriptorSet:        req.DeviceProfile.PayloadCodecDescriptorSet,
+		WASMModule:           req.DeviceProfile.PayloadCodecWasmModule,
+		CodecID:              codecID,
+		CodecVersion:         codecVersion,
+		PayloadJSONSchema:    req.DeviceProfile.PayloadJsonSchema,
+		DropInvalidPayloads:  req.DeviceProfile.DropInvalidPayloads,
 		DeviceProfile: ns.DeviceProfile{
 			SupportsClassB:     req.DeviceProfile.SupportsClassB,
 			ClassBTimeout:      req.DeviceProfile.ClassBTimeout,
@@ -75,7 +91,7 @@ func (a *DeviceProfileServiceAPI) Create(ctx context.Context, req *pb.CreateDevi
 
 	// as this also performs a remote call to create the device-profile
 	// on the network-server, wrap it in a transaction
-	err := storage.Transaction(func(tx sqlx.Ext) error {
+	err = storage.Transaction(func(tx sqlx.Ext) error {
 		return storage.CreateDeviceProfile(tx, &dp)
 	})
 	if err != nil {
@@ -109,35 +125,43 @@ func (a *DeviceProfileServiceAPI) Get(ctx context.Context, req *pb.GetDeviceProf
 	if err != nil {
 		return nil, helpers.ErrToRPCError(err)
 	}
+	codecID, codecVersion := codecToPB(dp.CodecID, dp.CodecVersion)
 
 	resp := pb.GetDeviceProfileResponse{
 		DeviceProfile: &pb.DeviceProfile{
-			Id:                   dpID.String(),
-			Name:                 dp.Name,
-			OrganizationId:       dp.OrganizationID,
-			NetworkServerId:      dp.NetworkServerID,
-			PayloadCodec:         string(dp.PayloadCodec),
-			PayloadEncoderScript: dp.PayloadEncoderScript,
-			PayloadDecoderScript: dp.PayloadDecoderScript,
-			SupportsClassB:       dp.DeviceProfile.SupportsClassB,
-			ClassBTimeout:        dp.DeviceProfile.ClassBTimeout,
-			PingSlotPeriod:       dp.DeviceProfile.PingSlotPeriod,
-			PingSlotDr:           dp.DeviceProfile.PingSlotDr,
-			PingSlotFreq:         dp.DeviceProfile.PingSlotFreq,
-			SupportsClassC:       dp.DeviceProfile.SupportsClassC,
-			ClassCTimeout:        dp.DeviceProfile.ClassCTimeout,
-			MacVersion:           dp.DeviceProfile.MacVersion,
-			RegParamsRevision:    dp.DeviceProfile.RegParamsRevision,
-			RxDelay_1:            dp.DeviceProfile.RxDelay_1,
-			RxDrOffset_1:         dp.DeviceProfile.RxDrOffset_1,
-			RxDatarate_2:         dp.DeviceProfile.RxDatarate_2,
-			RxFreq_2:             dp.DeviceProfile.RxFreq_2,
-			MaxEirp:              dp.DeviceProfile.MaxEirp,
-			MaxDutyCycle:         dp.DeviceProfile.MaxDutyCycle,
-			SupportsJoin:         dp.DeviceProfile.SupportsJoin,
-			RfRegion:             dp.DeviceProfile.RfRegion,
-			Supports_32BitFCnt:   dp.DeviceProfile.Supports_32BitFCnt,
-			FactoryPresetFreqs:   dp.DeviceProfile.FactoryPresetFreqs,
+			Id:                        dpID.String(),
+			Name:                      dp.Name,
+			OrganizationId:            dp.OrganizationID,
+			NetworkServerId:           dp.NetworkServerID,
+			PayloadCodec:              string(dp.PayloadCodec),
+			PayloadEncoderScript:      dp.PayloadEncoderScript,
+			PayloadDecoderScript:      dp.PayloadDecoderScript,
+			PayloadCodecFPorts:        payloadCodecsToPB(dp.PayloadCodecFPorts),
+			PayloadCodecDescriptorSet: dp.DescriptorSet,
+			PayloadCodecWasmModule:    dp.WASMModule,
+			CodecId:                   codecID,
+			CodecVersion:              codecVersion,
+			PayloadJsonSchema:         dp.PayloadJSONSchema,
+			DropInvalidPayloads:       dp.DropInvalidPayloads,
+			SupportsClassB:            dp.DeviceProfile.SupportsClassB,
+			ClassBTimeout:             dp.DeviceProfile.ClassBTimeout,
+			PingSlotPeriod:            dp.DeviceProfile.PingSlotPeriod,
+			PingSlotDr:                dp.DeviceProfile.PingSlotDr,
+			PingSlotFreq:              dp.DeviceProfile.PingSlotFreq,
+			SupportsClassC:            dp.DeviceProfile.SupportsClassC,
+			ClassCTimeout:             dp.DeviceProfile.ClassCTimeout,
+			MacVersion:                dp.DeviceProfile.MacVersion,
+			RegParamsRevision:         dp.DeviceProfile.RegParamsRevision,
+			RxDelay_1:                 dp.DeviceProfile.RxDelay_1,
+			RxDrOffset_1:              dp.DeviceProfile.RxDrOffset_1,
+			RxDatarate_2:              dp.DeviceProfile.RxDatarate_2,
+			RxFreq_2:                  dp.DeviceProfile.RxFreq_2,
+			MaxEirp:                   dp.DeviceProfile.MaxEirp,
+			MaxDutyCycle:              dp.DeviceProfile.MaxDutyCycle,
+			SupportsJoin:              dp.DeviceProfile.SupportsJoin,
+			RfRegion:                  dp.DeviceProfile.RfRegion,
+			Supports_32BitFCnt:        dp.DeviceProfile.Supports_32BitFCnt,
+			FactoryPresetFreqs:        dp.DeviceProfile.FactoryPresetFreqs,
 		},
 	}
 
@@ -183,6 +207,15 @@ func (a *DeviceProfileServiceAPI) Update(ctx context.Context, req *pb.UpdateDevi
 		dp.PayloadCodec = codec.Type(req.DeviceProfile.PayloadCodec)
 		dp.PayloadEncoderScript = req.DeviceProfile.PayloadEncoderScript
 		dp.PayloadDecoderScript = req.DeviceProfile.PayloadDecoderScript
+		dp.DescriptorSet = req.DeviceProfile.PayloadCodecDescriptorSet
+		dp.WASMModule = req.DeviceProfile.PayloadCodecWasmModule
+		dp.CodecID, dp.CodecVersion = codecFromPB(req.DeviceProfile.CodecId, req.DeviceProfile.CodecVersion)
+		dp.PayloadJSONSchema = req.DeviceProfile.PayloadJsonSchema
+		dp.DropInvalidPayloads = req.DeviceProfile.DropInvalidPayloads
+		dp.PayloadCodecFPorts, err = payloadCodecsFromPB(req.DeviceProfile.PayloadCodecFPorts)
+		if err != nil {
+			return err
+		}
 		dp.DeviceProfile = ns.DeviceProfile{
 			Id:                 dpID.Bytes(),
 			SupportsClassB:     req.DeviceProfile.SupportsClassB,
@@ -339,3 +372,124 @@ func (a *DeviceProfileServiceAPI) List(ctx context.Context, req *pb.ListDevicePr
 
 	return &resp, nil
 }
+
+// TestPayloadCodec runs the given payload codec, or the given version of a
+// codec of the codec library, against the given payload (decode) or object
+// (encode). Codec errors are returned as part of the response.
+func (a *DeviceProfileServiceAPI) TestPayloadCodec(ctx context.Context, req *pb.TestPayloadCodecRequest) (*pb.TestPayloadCodecResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceProfilesAccess(auth.Create, req.OrganizationId, 0),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if req.FPort > 255 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "fPort must be between 0 and 255")
+	}
+
+	if len(req.Data) == 0 && req.ObjectJson == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "data or objectJSON expected")
+	}
+
+	payloadCodec := storage.CodecVersion{
+		PayloadCodec:         codec.Type(req.PayloadCodec),
+		PayloadEncoderScript: req.PayloadEncoderScript,
+		PayloadDecoderScript: req.PayloadDecoderScript,
+		DescriptorSet:        req.PayloadCodecDescriptorSet,
+		WASMModule:           req.PayloadCodecWasmModule,
+	}
+
+	if req.CodecId != 0 {
+		c, err := storage.GetCodec(storage.DB(), req.CodecId, false)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		if c.OrganizationID != nil && *c.OrganizationID != req.OrganizationId {
+			return nil, helpers.ErrToRPCError(storage.ErrDeviceProfileInvalidCodec)
+		}
+
+		payloadCodec, err = storage.GetCodecVersion(storage.DB(), req.CodecId, int(req.CodecVersion))
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+	}
+
+	codecPL := codec.NewPayload(payloadCodec.PayloadCodec, uint8(req.FPort), payloadCodec.PayloadEncoderScript, payloadCodec.PayloadDecoderScript, payloadCodec.DescriptorSet, payloadCodec.WASMModule)
+	if codecPL == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid payload codec: %s", payloadCodec.PayloadCodec)
+	}
+
+	if c, ok := codecPL.(interface{ SetContext(codec.Context) }); ok {
+		c.SetContext(codec.Context{
+			Variables: req.Variables,
+		})
+	}
+
+	var resp pb.TestPayloadCodecResponse
+	var err error
+
+	start := time.Now()
+	if len(req.Data) != 0 {
+		err = codecPL.DecodeBytes(req.Data)
+		if err == nil {
+			b, jsonErr := json.Marshal(codecPL)
+			if jsonErr != nil {
+				return nil, helpers.ErrToRPCError(jsonErr)
+			}
+			resp.ObjectJson = string(b)
+		}
+	} else {
+		if jsonErr := json.Unmarshal([]byte(req.ObjectJson), &codecPL); jsonErr != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "unmarshal objectJSON error: %s", jsonErr)
+		}
+		resp.Data, err = codecPL.EncodeToBytes()
+	}
+	resp.ExecutionTime = ptypes.DurationProto(time.Since(start))
+
+	if err != nil {
+		resp.Error = err.Error()
+	}
+
+	if l, ok := codecPL.(interface{ Logs() []string }); ok {
+		resp.Logs = l.Logs()
+	}
+
+	return &resp, nil
+}
+
+func payloadCodecsFromPB(in []*pb.DeviceProfilePayloadCodec) (storage.PayloadCodecs, error) {
+	var out storage.PayloadCodecs
+	for _, c := range in {
+		if c == nil {
+			continue
+		}
+
+		if c.FPortFrom > 255 || c.FPortTo > 255 {
+			return nil, storage.ErrDeviceProfileInvalidFPorts
+		}
+
+		out = append(out, storage.PayloadCodec{
+			FPortFrom:            uint8(c.FPortFrom),
+			FPortTo:              uint8(c.FPortTo),
+			PayloadCodec:         codec.Type(c.PayloadCodec),
+			PayloadEncoderScript: c.PayloadEncoderScript,
+			PayloadDecoderScript: c.PayloadDecoderScript,
+		})
+	}
+	return out, nil
+}
+
+func payloadCodecsToPB(in storage.PayloadCodecs) []*pb.DeviceProfilePayloadCodec {
+	var out []*pb.DeviceProfilePayloadCodec
+	for _, c := range in {
+		out = append(out, &pb.DeviceProfilePayloadCodec{
+			FPortFrom:            uint32(c.FPortFrom),
+			FPortTo:              uint32(c.FPortTo),
+			PayloadCodec:         string(c.PayloadCodec),
+			PayloadEncoderScript: c.PayloadEncoderScript,
+			PayloadDecoderScript: c.PayloadDecoderScript,
+		})
+	}
+	return out
+}
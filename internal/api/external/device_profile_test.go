@@ -66,6 +66,15 @@ func (ts *APITestSuite) TestDeviceProfile() {
 				PayloadCodec:         "CUSTOM_JS",
 				PayloadEncoderScript: "Encode() {}",
 				PayloadDecoderScript: "Decode() {}",
+				PayloadCodecFPorts: []*pb.DeviceProfilePayloadCodec{
+					{
+						FPortFrom:    10,
+						FPortTo:      20,
+						PayloadCodec: "CAYENNE_LPP",
+					},
+				},
+				PayloadJsonSchema:   `{"type": "object"}`,
+				DropInvalidPayloads: true,
 			},
 		}
 
@@ -292,4 +301,115 @@ func (ts *APITestSuite) TestDeviceProfile() {
 			assert.Equal(codes.NotFound, grpc.Code(err))
 		})
 	})
+
+	ts.T().Run("TestPayloadCodec", func(t *testing.T) {
+		tests := []struct {
+			Name             string
+			Request          pb.TestPayloadCodecRequest
+			ExpectedResponse pb.TestPayloadCodecResponse
+			ExpectedCode     codes.Code
+		}{
+			{
+				Name: "decode",
+				Request: pb.TestPayloadCodecRequest{
+					OrganizationId: org.ID,
+					PayloadCodec:   "CUSTOM_JS",
+					PayloadDecoderScript: `
+						function Decode(fPort, bytes) {
+							console.log("decoding", bytes.length, "bytes");
+							return {"port": fPort, "on": bytes[0] === 1};
+						}
+					`,
+					FPort: 2,
+					Data:  []byte{1},
+				},
+				ExpectedResponse: pb.TestPayloadCodecResponse{
+					ObjectJson: `{"on":true,"port":2}`,
+					Logs:       []string{"decoding 1 bytes"},
+				},
+			},
+			{
+				Name: "decode using context variables",
+				Request: pb.TestPayloadCodecRequest{
+					OrganizationId: org.ID,
+					PayloadCodec:   "CUSTOM_JS",
+					PayloadDecoderScript: `
+						function Decode(fPort, bytes, context) {
+							return {"temperature": bytes[0] + parseFloat(context.variables.offset)};
+						}
+					`,
+					FPort:     2,
+					Data:      []byte{20},
+					Variables: map[string]string{"offset": "1.5"},
+				},
+				ExpectedResponse: pb.TestPayloadCodecResponse{
+					ObjectJson: `{"temperature":21.5}`,
+				},
+			},
+			{
+				Name: "encode",
+				Request: pb.TestPayloadCodecRequest{
+					OrganizationId: org.ID,
+					PayloadCodec:   "CUSTOM_JS",
+					PayloadEncoderScript: `
+						function Encode(fPort, obj) {
+							return [fPort, obj.on ? 1 : 0];
+						}
+					`,
+					FPort:      2,
+					ObjectJson: `{"on":true}`,
+				},
+				ExpectedResponse: pb.TestPayloadCodecResponse{
+					Data: []byte{2, 1},
+				},
+			},
+			{
+				Name: "codec error",
+				Request: pb.TestPayloadCodecRequest{
+					OrganizationId:       org.ID,
+					PayloadCodec:         "CUSTOM_JS",
+					PayloadDecoderScript: "",
+					FPort:                2,
+					Data:                 []byte{1},
+				},
+				ExpectedResponse: pb.TestPayloadCodecResponse{
+					Error: "js vm error: ReferenceError: 'Decode' is not defined",
+				},
+			},
+			{
+				Name: "invalid codec",
+				Request: pb.TestPayloadCodecRequest{
+					OrganizationId: org.ID,
+					PayloadCodec:   "FOO",
+					Data:           []byte{1},
+				},
+				ExpectedCode: codes.InvalidArgument,
+			},
+			{
+				Name: "no data or object",
+				Request: pb.TestPayloadCodecRequest{
+					OrganizationId: org.ID,
+					PayloadCodec:   "CUSTOM_JS",
+				},
+				ExpectedCode: codes.InvalidArgument,
+			},
+		}
+
+		for _, tst := range tests {
+			t.Run(tst.Name, func(t *testing.T) {
+				assert := require.New(t)
+
+				resp, err := api.TestPayloadCodec(context.Background(), &tst.Request)
+				if tst.ExpectedCode != codes.OK {
+					assert.Equal(tst.ExpectedCode, grpc.Code(err))
+					return
+				}
+				assert.NoError(err)
+
+				assert.NotNil(resp.ExecutionTime)
+				resp.ExecutionTime = nil
+				assert.Equal(tst.ExpectedResponse, *resp)
+			})
+		}
+	})
 }
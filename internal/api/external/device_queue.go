@@ -5,6 +5,7 @@ import (
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
@@ -35,8 +36,6 @@ func NewDeviceQueueAPI(validator auth.Validator) *DeviceQueueAPI {
 
 // Enqueue adds the given item to the device-queue.
 func (d *DeviceQueueAPI) Enqueue(ctx context.Context, req *pb.EnqueueDeviceQueueItemRequest) (*pb.EnqueueDeviceQueueItemResponse, error) {
-	var fCnt uint32
-
 	if req.DeviceQueueItem == nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, "queue_item must not be nil")
 	}
@@ -55,63 +54,8 @@ func (d *DeviceQueueAPI) Enqueue(ctx context.Context, req *pb.EnqueueDeviceQueue
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	if err := storage.Transaction(func(tx sqlx.Ext) error {
-		// Lock the device to avoid concurrent enqueue actions for the same
-		// device as this would result in re-use of the same frame-counter.
-		dev, err := storage.GetDevice(storage.DB(), devEUI, true, true)
-		if err != nil {
-			return helpers.ErrToRPCError(err)
-		}
-
-		// if JSON object is set, try to encode it to bytes
-		if req.DeviceQueueItem.JsonObject != "" {
-			app, err := storage.GetApplication(storage.DB(), dev.ApplicationID)
-			if err != nil {
-				return helpers.ErrToRPCError(err)
-			}
-
-			dp, err := storage.GetDeviceProfile(storage.DB(), dev.DeviceProfileID, false, true)
-			if err != nil {
-				log.WithError(err).WithField("id", dev.DeviceProfileID).Error("get device-profile error")
-				return grpc.Errorf(codes.Internal, "get device-profile error: %s", err)
-			}
-
-			// TODO: in the next major release, remove this and always use the
-			// device-profile codec fields.
-			payloadCodec := app.PayloadCodec
-			payloadEncoderScript := app.PayloadEncoderScript
-			payloadDecoderScript := app.PayloadDecoderScript
-
-			if dp.PayloadCodec != "" {
-				payloadCodec = dp.PayloadCodec
-				payloadEncoderScript = dp.PayloadEncoderScript
-				payloadDecoderScript = dp.PayloadDecoderScript
-			}
-
-			// get codec payload configured for the application
-			codecPL := codec.NewPayload(payloadCodec, uint8(req.DeviceQueueItem.FPort), payloadEncoderScript, payloadDecoderScript)
-			if codecPL == nil {
-				return grpc.Errorf(codes.FailedPrecondition, "no or invalid codec configured for application")
-			}
-
-			err = json.Unmarshal([]byte(req.DeviceQueueItem.JsonObject), &codecPL)
-			if err != nil {
-				return helpers.ErrToRPCError(err)
-			}
-
-			req.DeviceQueueItem.Data, err = codecPL.EncodeToBytes()
-			if err != nil {
-				return helpers.ErrToRPCError(err)
-			}
-		}
-
-		fCnt, err = downlink.EnqueueDownlinkPayload(tx, devEUI, req.DeviceQueueItem.Confirmed, uint8(req.DeviceQueueItem.FPort), req.DeviceQueueItem.Data)
-		if err != nil {
-			return grpc.Errorf(codes.Internal, "enqueue downlink payload error: %s", err)
-		}
-
-		return nil
-	}); err != nil {
+	fCnt, err := enqueueDeviceQueueItem(devEUI, req.DeviceQueueItem)
+	if err != nil {
 		return nil, err
 	}
 
@@ -204,3 +148,87 @@ func (d *DeviceQueueAPI) List(ctx context.Context, req *pb.ListDeviceQueueItemsR
 
 	return &resp, nil
 }
+
+// enqueueDeviceQueueItem encodes (when needed) and enqueues the given item.
+// It returns the frame-counter of the enqueued payload.
+func enqueueDeviceQueueItem(devEUI lorawan.EUI64, item *pb.DeviceQueueItem) (uint32, error) {
+	var fCnt uint32
+
+	if err := storage.Transaction(func(tx sqlx.Ext) error {
+		// Lock the device to avoid concurrent enqueue actions for the same
+		// device as this would result in re-use of the same frame-counter.
+		dev, err := storage.GetDevice(storage.DB(), devEUI, true, true)
+		if err != nil {
+			return helpers.ErrToRPCError(err)
+		}
+
+		// if JSON object is set, try to encode it to bytes
+		if item.JsonObject != "" {
+			app, err := storage.GetApplication(storage.DB(), dev.ApplicationID)
+			if err != nil {
+				return helpers.ErrToRPCError(err)
+			}
+
+			dp, err := storage.GetDeviceProfile(storage.DB(), dev.DeviceProfileID, false, true)
+			if err != nil {
+				log.WithError(err).WithField("id", dev.DeviceProfileID).Error("get device-profile error")
+				return grpc.Errorf(codes.Internal, "get device-profile error: %s", err)
+			}
+
+			// TODO: in the next major release, remove this and always use the
+			// device-profile codec fields.
+			payloadCodec, err := storage.GetDeviceProfilePayloadCodec(storage.DB(), dp, uint8(item.FPort))
+			if err != nil {
+				if errors.Cause(err) != storage.ErrDoesNotExist {
+					log.WithError(err).WithField("id", dev.DeviceProfileID).Error("get device-profile payload codec error")
+					return grpc.Errorf(codes.Internal, "get device-profile payload codec error: %s", err)
+				}
+
+				// the (published) codec version does not exist, the
+				// application codec is used instead
+				downlink.LogCodecError(app, dev, errors.Wrap(err, "get device-profile payload codec error"))
+				payloadCodec = storage.CodecVersion{}
+			}
+			if payloadCodec.PayloadCodec == "" {
+				payloadCodec.PayloadCodec = app.PayloadCodec
+				payloadCodec.PayloadEncoderScript = app.PayloadEncoderScript
+				payloadCodec.PayloadDecoderScript = app.PayloadDecoderScript
+			}
+
+			// get codec payload configured for the application
+			codecPL := codec.NewPayload(payloadCodec.PayloadCodec, uint8(item.FPort), payloadCodec.PayloadEncoderScript, payloadCodec.PayloadDecoderScript, payloadCodec.DescriptorSet, payloadCodec.WASMModule)
+			if codecPL == nil {
+				return grpc.Errorf(codes.FailedPrecondition, "no or invalid codec configured for application")
+			}
+
+			if c, ok := codecPL.(interface{ SetContext(codec.Context) }); ok {
+				c.SetContext(codec.Context{
+					DevEUI:    dev.DevEUI,
+					Variables: dev.Variables,
+				})
+			}
+
+			err = json.Unmarshal([]byte(item.JsonObject), &codecPL)
+			if err != nil {
+				return helpers.ErrToRPCError(err)
+			}
+
+			item.Data, err = codecPL.EncodeToBytes()
+			downlink.LogCodecOutput(app, dev, uint8(item.FPort), codecPL)
+			if err != nil {
+				return helpers.ErrToRPCError(err)
+			}
+		}
+
+		fCnt, err = downlink.EnqueueDownlinkPayload(tx, devEUI, item.Confirmed, uint8(item.FPort), item.Data)
+		if err != nil {
+			return grpc.Errorf(codes.Internal, "enqueue downlink payload error: %s", err)
+		}
+
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	return fCnt, nil
+}
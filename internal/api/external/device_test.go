@@ -7,6 +7,7 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
@@ -149,6 +150,9 @@ func (ts *APITestSuite) TestDevice() {
 				DeviceProfileId:   dpID.String(),
 				SkipFCntCheck:     true,
 				ReferenceAltitude: 5.6,
+				Variables: map[string]string{
+					"temperature_offset": "1.5",
+				},
 			},
 		}
 		_, err := api.Create(context.Background(), &createReq)
@@ -307,6 +311,9 @@ func (ts *APITestSuite) TestDevice() {
 						DeviceProfileId:   dpID.String(),
 						SkipFCntCheck:     true,
 						ReferenceAltitude: 6.7,
+						Variables: map[string]string{
+							"temperature_offset": "2.5",
+						},
 					},
 				}
 
@@ -531,7 +538,7 @@ func (ts *APITestSuite) TestDevice() {
 					}
 				}()
 
-				assert.NoError(eventlog.LogEventForDevice(lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, eventlog.EventLog{
+				assert.NoError(eventlog.LogEventForDevice(app.ID, lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, eventlog.EventLog{
 					Type: eventlog.Join,
 				}))
 
@@ -539,6 +546,183 @@ func (ts *APITestSuite) TestDevice() {
 				assert.Equal(eventlog.Join, resp.Type)
 			})
 
+			t.Run("CodecState", func(t *testing.T) {
+				assert := require.New(t)
+
+				devEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+				assert.NoError(storage.UpdateDeviceCodecState(storage.RedisPool(), devEUI, func(state []byte) ([]byte, error) {
+					return []byte(`{"counter":10}`), nil
+				}))
+
+				resp, err := api.GetCodecState(context.Background(), &pb.GetDeviceCodecStateRequest{
+					DevEui: "0807060504030201",
+				})
+				assert.NoError(err)
+				assert.Equal(`{"counter":10}`, resp.StateJson)
+
+				_, err = api.ResetCodecState(context.Background(), &pb.ResetDeviceCodecStateRequest{
+					DevEui: "0807060504030201",
+				})
+				assert.NoError(err)
+
+				resp, err = api.GetCodecState(context.Background(), &pb.GetDeviceCodecStateRequest{
+					DevEui: "0807060504030201",
+				})
+				assert.NoError(err)
+				assert.Equal("", resp.StateJson)
+			})
+
+			t.Run("Uplinks", func(t *testing.T) {
+				assert := require.New(t)
+
+				devEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+				receivedAt := time.Date(2019, 3, 1, 12, 0, 0, 0, time.UTC)
+				for i := 0; i < 2; i++ {
+					assert.NoError(storage.CreateDeviceUplink(storage.DB(), &storage.DeviceUplink{
+						DevEUI:     devEUI,
+						ReceivedAt: receivedAt.Add(time.Duration(i) * time.Minute),
+						FCnt:       uint32(10 + i),
+						FPort:      1,
+						DR:         5,
+						Data:       []byte{1, 2, byte(i)},
+						Object:     []byte(`{"value":1}`),
+						RXInfo:     []byte(`[{"rssi":-60}]`),
+					}))
+				}
+
+				t.Run("ListUplinks", func(t *testing.T) {
+					assert := require.New(t)
+
+					resp, err := api.ListUplinks(context.Background(), &pb.ListDeviceUplinksRequest{
+						DevEui: devEUI.String(),
+						Limit:  1,
+					})
+					assert.NoError(err)
+					assert.EqualValues(2, resp.TotalCount)
+					assert.Len(resp.Result, 1)
+					assert.EqualValues(11, resp.Result[0].FCnt)
+					assert.Equal([]byte{1, 2, 1}, resp.Result[0].Data)
+					assert.Equal(`{"value": 1}`, resp.Result[0].ObjectJson)
+					assert.Equal(`[{"rssi": -60}]`, resp.Result[0].RxInfoJson)
+
+					start, _ := ptypes.TimestampProto(receivedAt)
+					end, _ := ptypes.TimestampProto(receivedAt.Add(time.Minute))
+					resp, err = api.ListUplinks(context.Background(), &pb.ListDeviceUplinksRequest{
+						DevEui: devEUI.String(),
+						Start:  start,
+						End:    end,
+						Limit:  10,
+					})
+					assert.NoError(err)
+					assert.EqualValues(1, resp.TotalCount)
+					assert.EqualValues(10, resp.Result[0].FCnt)
+
+					_, err = api.ListUplinks(context.Background(), &pb.ListDeviceUplinksRequest{
+						DevEui: devEUI.String(),
+						Limit:  -1,
+					})
+					assert.Equal(codes.InvalidArgument, grpc.Code(err))
+
+					_, err = api.ListUplinks(context.Background(), &pb.ListDeviceUplinksRequest{
+						DevEui: devEUI.String(),
+						Limit:  10,
+						Offset: -1,
+					})
+					assert.Equal(codes.InvalidArgument, grpc.Code(err))
+				})
+
+				t.Run("ExportUplinks CSV", func(t *testing.T) {
+					assert := require.New(t)
+
+					resp, err := api.ExportUplinks(context.Background(), &pb.ExportDeviceUplinksRequest{
+						DevEui: devEUI.String(),
+						Format: pb.UplinkExportFormat_EXPORT_CSV,
+					})
+					assert.NoError(err)
+					assert.Equal("text/csv", resp.ContentType)
+					assert.Equal(`receivedAt,fCnt,fPort,dr,data,object,rxInfo
+2019-03-01T12:00:00Z,10,1,5,010200,"{""value"": 1}","[{""rssi"": -60}]"
+2019-03-01T12:01:00Z,11,1,5,010201,"{""value"": 1}","[{""rssi"": -60}]"
+`, resp.Data)
+				})
+
+				t.Run("ExportUplinks JSON", func(t *testing.T) {
+					assert := require.New(t)
+
+					resp, err := api.ExportUplinks(context.Background(), &pb.ExportDeviceUplinksRequest{
+						DevEui: devEUI.String(),
+						Format: pb.UplinkExportFormat_EXPORT_JSON,
+					})
+					assert.NoError(err)
+					assert.Equal("application/json", resp.ContentType)
+					assert.JSONEq(`[
+						{"receivedAt": "2019-03-01T12:00:00Z", "fCnt": 10, "fPort": 1, "dr": 5, "data": "AQIA", "object": {"value": 1}, "rxInfo": [{"rssi": -60}]},
+						{"receivedAt": "2019-03-01T12:01:00Z", "fCnt": 11, "fPort": 1, "dr": 5, "data": "AQIB", "object": {"value": 1}, "rxInfo": [{"rssi": -60}]}
+					]`, resp.Data)
+				})
+			})
+
+			t.Run("GetStatusHistory", func(t *testing.T) {
+				assert := require.New(t)
+
+				devEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+				start := time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)
+				for i := 0; i < 3; i++ {
+					battery := float32(50 - i)
+					assert.NoError(storage.CreateDeviceStatus(storage.DB(), &storage.DeviceStatus{
+						DevEUI:       devEUI,
+						CreatedAt:    start.Add(time.Duration(i) * 24 * time.Hour),
+						Margin:       10,
+						BatteryLevel: &battery,
+					}))
+				}
+				assert.NoError(storage.CreateDeviceStatus(storage.DB(), &storage.DeviceStatus{
+					DevEUI:    devEUI,
+					CreatedAt: start.Add(3 * 24 * time.Hour),
+					Margin:    10,
+				}))
+
+				startTS, err := ptypes.TimestampProto(start)
+				assert.NoError(err)
+				endTS, err := ptypes.TimestampProto(start.Add(7 * 24 * time.Hour))
+				assert.NoError(err)
+
+				t.Run("Invalid interval", func(t *testing.T) {
+					assert := require.New(t)
+
+					_, err := api.GetStatusHistory(context.Background(), &pb.GetDeviceStatusHistoryRequest{
+						DevEui:         devEUI.String(),
+						Interval:       "decade",
+						StartTimestamp: startTS,
+						EndTimestamp:   endTS,
+					})
+					assert.Equal(codes.InvalidArgument, grpc.Code(err))
+				})
+
+				t.Run("Per day", func(t *testing.T) {
+					assert := require.New(t)
+
+					resp, err := api.GetStatusHistory(context.Background(), &pb.GetDeviceStatusHistoryRequest{
+						DevEui:         devEUI.String(),
+						Interval:       "day",
+						StartTimestamp: startTS,
+						EndTimestamp:   endTS,
+					})
+					assert.NoError(err)
+					assert.Len(resp.Result, 4)
+					assert.EqualValues(1, resp.Result[0].Count)
+					assert.EqualValues(10, resp.Result[0].Margin)
+					assert.EqualValues(50, resp.Result[0].BatteryLevel)
+					assert.False(resp.Result[0].BatteryLevelUnavailable)
+					assert.True(resp.Result[3].BatteryLevelUnavailable)
+
+					// the battery level decreases by 1% per day
+					depletion, err := ptypes.Timestamp(resp.BatteryDepletionPredictedAt)
+					assert.NoError(err)
+					assert.WithinDuration(start.Add(50*24*time.Hour), depletion, time.Minute)
+				})
+			})
+
 			t.Run("Delete", func(t *testing.T) {
 				assert := require.New(t)
 
@@ -27,6 +27,7 @@ import (
 	"github.com/brocaar/lora-app-server/internal/api/external/auth"
 	"github.com/brocaar/lora-app-server/internal/api/helpers"
 	"github.com/brocaar/lora-app-server/internal/config"
+	httpint "github.com/brocaar/lora-app-server/internal/integration/http"
 	"github.com/brocaar/lora-app-server/internal/static"
 	"github.com/brocaar/lora-app-server/internal/storage"
 )
@@ -85,6 +86,7 @@ func setupAPI(conf config.Config) error {
 	api.RegisterServiceProfileServiceServer(grpcServer, NewServiceProfileServiceAPI(validator))
 	api.RegisterDeviceProfileServiceServer(grpcServer, NewDeviceProfileServiceAPI(validator))
 	api.RegisterMulticastGroupServiceServer(grpcServer, NewMulticastGroupAPI(validator, rpID))
+	api.RegisterCodecServiceServer(grpcServer, NewCodecAPI(validator))
 
 	// setup the client http interface variable
 	// we need to start the gRPC service first, as it is used by the
@@ -156,6 +158,9 @@ func setupHTTPAPI(conf config.Config) (http.Handler, error) {
 		return nil, err
 	}
 
+	log.WithField("path", httpint.DownlinkPath).Info("api/external: registering http integration downlink endpoint")
+	r.Handle(httpint.DownlinkPath, httpint.NewDownlinkHandler()).Methods("post")
+
 	log.WithField("path", "/api").Info("api/external: registering rest api handler and documentation endpoint")
 	r.HandleFunc("/api", func(w http.ResponseWriter, r *http.Request) {
 		data, err := static.Asset("swagger/index.html")
@@ -252,6 +257,9 @@ func getJSONGateway(ctx context.Context) (http.Handler, error) {
 	if err := pb.RegisterMulticastGroupServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
 		return nil, errors.Wrap(err, "register multicast-group handler error")
 	}
+	if err := pb.RegisterCodecServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register codec handler error")
+	}
 
 	return mux, nil
 }
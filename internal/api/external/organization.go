@@ -195,7 +195,14 @@ func (a *OrganizationAPI) Delete(ctx context.Context, req *pb.DeleteOrganization
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	var appIDs []int64
 	err := storage.Transaction(func(tx sqlx.Ext) error {
+		var err error
+		appIDs, err = storage.GetApplicationIDsForOrganizationID(tx, req.Id)
+		if err != nil {
+			return helpers.ErrToRPCError(err)
+		}
+
 		if err := storage.DeleteAllGatewaysForOrganizationID(tx, req.Id); err != nil {
 			return helpers.ErrToRPCError(err)
 		}
@@ -210,6 +217,12 @@ func (a *OrganizationAPI) Delete(ctx context.Context, req *pb.DeleteOrganization
 		return nil, err
 	}
 
+	for _, id := range appIDs {
+		if err := deleteApplicationStats(id); err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+	}
+
 	return &empty.Empty{}, nil
 }
 
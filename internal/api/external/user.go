@@ -11,6 +11,7 @@ import (
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/api/external/auth"
 	"github.com/brocaar/lora-app-server/internal/api/helpers"
+	"github.com/brocaar/lora-app-server/internal/integration/stats"
 	"github.com/brocaar/lora-app-server/internal/storage"
 )
 
@@ -388,3 +389,92 @@ func (a *InternalUserAPI) GlobalSearch(ctx context.Context, req *pb.GlobalSearch
 
 	return &out, nil
 }
+
+// ListIntegrationStats lists the delivery statistics of all integrations.
+func (a *InternalUserAPI) ListIntegrationStats(ctx context.Context, req *pb.ListIntegrationStatsRequest) (*pb.ListIntegrationStatsResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateActiveUser()); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	isAdmin, err := a.validator.GetIsAdmin(ctx)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	if !isAdmin {
+		return nil, grpc.Errorf(codes.Unauthenticated, "client must be global admin")
+	}
+
+	if req.Offset < 0 || req.Limit < 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "offset and limit must be >= 0")
+	}
+
+	all, err := stats.List()
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	var appIDs []int64
+	for _, item := range all {
+		if item.ApplicationID != 0 {
+			appIDs = append(appIDs, item.ApplicationID)
+		}
+	}
+
+	appNames, err := storage.GetApplicationNames(storage.DB(), appIDs)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	// skip the statistics of applications which no longer exist, e.g.
+	// when a delivery was recorded while the application was being deleted
+	var items []stats.Stats
+	for _, item := range all {
+		if _, ok := appNames[item.ApplicationID]; item.ApplicationID != 0 && !ok {
+			continue
+		}
+
+		items = append(items, item)
+	}
+
+	if req.FailingOnly {
+		var failing []stats.Stats
+		for _, item := range items {
+			if item.LastErrorAt != nil && (item.LastSuccessAt == nil || item.LastErrorAt.After(*item.LastSuccessAt)) {
+				failing = append(failing, item)
+			}
+		}
+		items = failing
+	}
+
+	out := pb.ListIntegrationStatsResponse{
+		TotalCount: int64(len(items)),
+	}
+
+	if req.Offset >= int64(len(items)) {
+		return &out, nil
+	}
+	items = items[req.Offset:]
+	if req.Limit > 0 && req.Limit < int64(len(items)) {
+		items = items[:req.Limit]
+	}
+
+	for _, item := range items {
+		res := pb.IntegrationStatsListItem{
+			ApplicationId: item.ApplicationID,
+			Kind:          item.Kind,
+		}
+
+		res.ApplicationName = appNames[item.ApplicationID]
+
+		res.Stats, err = integrationStatsToPB(item)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		out.Result = append(out.Result, &res)
+	}
+
+	return &out, nil
+}
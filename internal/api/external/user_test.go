@@ -2,15 +2,19 @@ package external
 
 import (
 	"testing"
+	"time"
 
 	"github.com/brocaar/loraserver/api/ns"
 
 	. "github.com/smartystreets/goconvey/convey"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lora-app-server/internal/integration/stats"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lora-app-server/internal/test"
 )
@@ -62,6 +66,40 @@ func TestUserAPI(t *testing.T) {
 			So(users[0].IsAdmin, ShouldBeTrue)
 		})
 
+		Convey("Given integration stats for an application which no longer exists", func() {
+			test.MustFlushRedis(storage.RedisPool())
+			validator.returnIsAdmin = true
+
+			So(stats.Record(0, "http", time.Millisecond, nil), ShouldBeNil)
+			So(stats.Record(12345, "http", time.Millisecond, nil), ShouldBeNil)
+
+			Convey("Then ListIntegrationStats skips the stale entry", func() {
+				resp, err := apiInternal.ListIntegrationStats(ctx, &pb.ListIntegrationStatsRequest{
+					Limit: 10,
+				})
+				So(err, ShouldBeNil)
+				So(resp.TotalCount, ShouldEqual, 1)
+				So(resp.Result, ShouldHaveLength, 1)
+				So(resp.Result[0].ApplicationId, ShouldEqual, 0)
+
+				items, err := stats.List()
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 2)
+			})
+
+			Convey("Then ListIntegrationStats returns an error for a negative offset or limit", func() {
+				_, err := apiInternal.ListIntegrationStats(ctx, &pb.ListIntegrationStatsRequest{
+					Offset: -1,
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+
+				_, err = apiInternal.ListIntegrationStats(ctx, &pb.ListIntegrationStatsRequest{
+					Limit: -1,
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			})
+		})
+
 		Convey("When creating an user", func() {
 			validator.returnIsAdmin = true
 			createReq := &pb.CreateUserRequest{
@@ -24,8 +24,28 @@ var errToCode = map[error]codes.Code{
 	storage.ErrInvalidEmail:                    codes.InvalidArgument,
 	storage.ErrInvalidGatewayDiscoveryInterval: codes.InvalidArgument,
 	storage.ErrDeviceProfileInvalidName:        codes.InvalidArgument,
+	storage.ErrDeviceProfileInvalidFPorts:      codes.InvalidArgument,
+	storage.ErrDeviceProfileInvalidProtobuf:    codes.InvalidArgument,
+	storage.ErrDeviceProfileInvalidCodec:       codes.InvalidArgument,
+	storage.ErrDeviceProfileNoCodecVersion:     codes.InvalidArgument,
+	storage.ErrDeviceProfileInvalidJSONSchema:  codes.InvalidArgument,
+	storage.ErrDeviceProfileInvalidWASM:        codes.InvalidArgument,
+	storage.ErrCodecInvalidName:                codes.InvalidArgument,
+	storage.ErrCodecInvalidBinarySchema:        codes.InvalidArgument,
+	storage.ErrCodecVersionInvalidCodec:        codes.InvalidArgument,
+	storage.ErrDeviceCodecStateConflict:        codes.Aborted,
+	storage.ErrInvalidAggregationInterval:      codes.InvalidArgument,
 	http.ErrInvalidHeaderName:                  codes.InvalidArgument,
+	http.ErrInvalidMarshaler:                   codes.InvalidArgument,
+	http.ErrInvalidMethod:                      codes.InvalidArgument,
+	http.ErrInvalidTimeout:                     codes.InvalidArgument,
+	http.ErrInvalidTemplate:                    codes.InvalidArgument,
+	http.ErrInvalidTemplateEngine:              codes.InvalidArgument,
 	influxdb.ErrInvalidPrecision:               codes.InvalidArgument,
+	influxdb.ErrInvalidVersion:                 codes.InvalidArgument,
+	influxdb.ErrInvalidV2Config:                codes.InvalidArgument,
+	influxdb.ErrInvalidBatchConfig:             codes.InvalidArgument,
+	influxdb.ErrInvalidTemplate:                codes.InvalidArgument,
 }
 
 func ErrToRPCError(err error) error {
@@ -0,0 +1,633 @@
+package codec
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+)
+
+func init() {
+	gob.Register(BinarySchema{})
+}
+
+// Binary schema field types.
+const (
+	FieldTypeU8       = "u8"
+	FieldTypeI8       = "i8"
+	FieldTypeU16      = "u16"
+	FieldTypeI16      = "i16"
+	FieldTypeU32      = "u32"
+	FieldTypeI32      = "i32"
+	FieldTypeU64      = "u64"
+	FieldTypeI64      = "i64"
+	FieldTypeFloat    = "float"
+	FieldTypeDouble   = "double"
+	FieldTypeBitfield = "bitfield"
+	FieldTypeEnum     = "enum"
+)
+
+// binarySchemaCacheSize defines the number of parsed binary schemas to cache.
+const binarySchemaCacheSize = 100
+
+var binarySchemas = newBinarySchemaCache(binarySchemaCacheSize)
+
+// maxSchemaPayloadSize defines the max. payload size (in bytes) covered by
+// a binary schema.
+const maxSchemaPayloadSize = 255
+
+// Binary schema endianness.
+const (
+	EndiannessBig    = "big"
+	EndiannessLittle = "little"
+)
+
+// Schema defines a binary payload schema. A schema either defines a single
+// list of fields, or multiple messages of which the matching message is
+// selected by fPort and / or discriminator.
+type Schema struct {
+	Fields   []SchemaField   `json:"fields"`
+	Messages []SchemaMessage `json:"messages"`
+}
+
+// SchemaMessage defines a message within a schema.
+type SchemaMessage struct {
+	// FPort (optional) defines the fPort of this message.
+	FPort uint8 `json:"fPort"`
+
+	// Discriminator (optional) defines the byte identifying this message.
+	Discriminator *SchemaDiscriminator `json:"discriminator"`
+
+	Fields []SchemaField `json:"fields"`
+}
+
+// SchemaDiscriminator defines the byte (at the given offset) identifying a
+// message.
+type SchemaDiscriminator struct {
+	Offset int   `json:"offset"`
+	Value  uint8 `json:"value"`
+}
+
+// SchemaField defines a field within a schema message.
+type SchemaField struct {
+	// Name of the field, used as key in the decoded object.
+	Name string `json:"name"`
+
+	// Offset (in bytes) of the field within the payload.
+	Offset int `json:"offset"`
+
+	// Type of the field.
+	Type string `json:"type"`
+
+	// Endianness of the field (default big).
+	Endianness string `json:"endianness"`
+
+	// Size (in bytes) of the bitfield and enum types (default 1).
+	Size int `json:"size"`
+
+	// BitOffset (counted from the least significant bit) and BitLength of
+	// the bitfield type.
+	BitOffset uint `json:"bitOffset"`
+	BitLength uint `json:"bitLength"`
+
+	// Scale and ValueOffset are applied as: value = raw * scale + valueOffset.
+	Scale       float64 `json:"scale"`
+	ValueOffset float64 `json:"valueOffset"`
+
+	// Unit of the (scaled) value. This is informational only.
+	Unit string `json:"unit"`
+
+	// Values maps the raw values of the enum type to their names.
+	Values map[string]string `json:"values"`
+}
+
+// ParseSchema parses the given JSON or YAML schema.
+func ParseSchema(s string) (Schema, error) {
+	var schema Schema
+	if err := yaml.Unmarshal([]byte(s), &schema); err != nil {
+		return schema, errors.Wrap(err, "unmarshal schema error")
+	}
+
+	if len(schema.Fields) != 0 {
+		schema.Messages = append(schema.Messages, SchemaMessage{Fields: schema.Fields})
+		schema.Fields = nil
+	}
+
+	if len(schema.Messages) == 0 {
+		return schema, errors.New("schema must define at least one field")
+	}
+
+	for _, m := range schema.Messages {
+		if err := m.validate(); err != nil {
+			return schema, err
+		}
+	}
+
+	return schema, nil
+}
+
+// BinarySchema is a codec that decodes and encodes payloads using a
+// declarative schema.
+type BinarySchema struct {
+	fPort  uint8
+	schema string
+	Data   map[string]interface{}
+}
+
+// NewBinarySchema creates a new binary schema codec.
+func NewBinarySchema(fPort uint8, schema string) *BinarySchema {
+	return &BinarySchema{
+		fPort:  fPort,
+		schema: schema,
+	}
+}
+
+// Object returns the object data.
+func (c BinarySchema) Object() interface{} {
+	return c.Data
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c BinarySchema) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Data)
+}
+
+// UnmarshalJSON implement json.Unmarshaler.
+func (c *BinarySchema) UnmarshalJSON(text []byte) error {
+	return json.Unmarshal(text, &c.Data)
+}
+
+// DecodeBytes decodes the payload from a slice of bytes.
+func (c *BinarySchema) DecodeBytes(data []byte) error {
+	schema, err := binarySchemas.get(c.schema)
+	if err != nil {
+		return errors.Wrap(err, "parse schema error")
+	}
+
+	var msg *SchemaMessage
+	for i := range schema.Messages {
+		m := schema.Messages[i]
+		if m.FPort != 0 && m.FPort != c.fPort {
+			continue
+		}
+		if m.Discriminator != nil && (m.Discriminator.Offset >= len(data) || data[m.Discriminator.Offset] != m.Discriminator.Value) {
+			continue
+		}
+		msg = &m
+		break
+	}
+	if msg == nil {
+		return fmt.Errorf("no schema message matches fPort %d and payload", c.fPort)
+	}
+
+	c.Data = make(map[string]interface{})
+	for _, f := range msg.Fields {
+		v, err := f.decode(data)
+		if err != nil {
+			return errors.Wrapf(err, "decode field %s error", f.Name)
+		}
+		c.Data[f.Name] = v
+	}
+
+	return nil
+}
+
+// EncodeToBytes encodes the payload to a slice of bytes.
+func (c BinarySchema) EncodeToBytes() ([]byte, error) {
+	schema, err := binarySchemas.get(c.schema)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse schema error")
+	}
+
+	var msg *SchemaMessage
+	for i := range schema.Messages {
+		m := schema.Messages[i]
+		if m.FPort != 0 && m.FPort != c.fPort {
+			continue
+		}
+		if !m.hasFields(c.Data) {
+			continue
+		}
+		msg = &m
+		break
+	}
+	if msg == nil {
+		return nil, fmt.Errorf("no schema message matches fPort %d and object", c.fPort)
+	}
+
+	var size int
+	if msg.Discriminator != nil {
+		size = msg.Discriminator.Offset + 1
+	}
+	for _, f := range msg.Fields {
+		if end := f.Offset + f.size(); end > size {
+			size = end
+		}
+	}
+
+	out := make([]byte, size)
+	if msg.Discriminator != nil {
+		out[msg.Discriminator.Offset] = msg.Discriminator.Value
+	}
+
+	for _, f := range msg.Fields {
+		if err := f.encode(out, c.Data[f.Name]); err != nil {
+			return nil, errors.Wrapf(err, "encode field %s error", f.Name)
+		}
+	}
+
+	return out, nil
+}
+
+// hasFields returns true when the given object contains all fields of the
+// message.
+func (m SchemaMessage) hasFields(obj map[string]interface{}) bool {
+	for _, f := range m.Fields {
+		if _, ok := obj[f.Name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// validate validates the fields of the message and checks that the field
+// names are unique and that the fields do not overlap. Bitfields may share
+// the same bytes, as long as their bit ranges do not overlap.
+func (m SchemaMessage) validate() error {
+	if m.Discriminator != nil && (m.Discriminator.Offset < 0 || m.Discriminator.Offset >= maxSchemaPayloadSize) {
+		return fmt.Errorf("discriminator offset must be between 0 and %d", maxSchemaPayloadSize-1)
+	}
+
+	names := make(map[string]struct{})
+	var bits [maxSchemaPayloadSize]uint8
+
+	for _, f := range m.Fields {
+		if err := f.validate(); err != nil {
+			return errors.Wrapf(err, "field %s", f.Name)
+		}
+
+		if _, ok := names[f.Name]; ok {
+			return fmt.Errorf("field %s: duplicate field name", f.Name)
+		}
+		names[f.Name] = struct{}{}
+
+		for i, mask := range f.bitMask() {
+			if bits[f.Offset+i]&mask != 0 {
+				return fmt.Errorf("field %s: overlaps with an other field", f.Name)
+			}
+			bits[f.Offset+i] |= mask
+		}
+	}
+
+	return nil
+}
+
+func (f SchemaField) validate() error {
+	if f.Name == "" {
+		return errors.New("name must be set")
+	}
+	if f.Offset < 0 {
+		return errors.New("offset must be >= 0")
+	}
+	if f.size() == 0 {
+		return fmt.Errorf("invalid type: %s", f.Type)
+	}
+	if f.Offset+f.size() > maxSchemaPayloadSize {
+		return fmt.Errorf("field exceeds the max. payload size of %d bytes", maxSchemaPayloadSize)
+	}
+
+	switch f.Endianness {
+	case "", EndiannessBig, EndiannessLittle:
+	default:
+		return fmt.Errorf("invalid endianness: %s", f.Endianness)
+	}
+
+	switch f.Type {
+	case FieldTypeBitfield, FieldTypeEnum:
+		switch f.Size {
+		case 0, 1, 2, 4, 8:
+		default:
+			return errors.New("size must be 1, 2, 4 or 8")
+		}
+	}
+
+	if f.Type == FieldTypeBitfield {
+		if f.BitLength == 0 || f.BitOffset+f.BitLength > uint(f.size()*8) {
+			return errors.New("bit range exceeds the field size")
+		}
+	}
+
+	return nil
+}
+
+// size returns the size of the field in bytes, or 0 when the type is
+// invalid.
+func (f SchemaField) size() int {
+	switch f.Type {
+	case FieldTypeU8, FieldTypeI8:
+		return 1
+	case FieldTypeU16, FieldTypeI16:
+		return 2
+	case FieldTypeU32, FieldTypeI32, FieldTypeFloat:
+		return 4
+	case FieldTypeU64, FieldTypeI64, FieldTypeDouble:
+		return 8
+	case FieldTypeBitfield, FieldTypeEnum:
+		if f.Size == 0 {
+			return 1
+		}
+		return f.Size
+	default:
+		return 0
+	}
+}
+
+// bitMask returns per byte of the field the bits used by the field.
+func (f SchemaField) bitMask() []uint8 {
+	out := make([]uint8, f.size())
+	if f.Type != FieldTypeBitfield {
+		for i := range out {
+			out[i] = 0xff
+		}
+		return out
+	}
+
+	for bit := f.BitOffset; bit < f.BitOffset+f.BitLength; bit++ {
+		i := int(bit / 8)
+		if f.Endianness != EndiannessLittle {
+			i = len(out) - 1 - i
+		}
+		out[i] |= 1 << (bit % 8)
+	}
+	return out
+}
+
+func (f SchemaField) byteOrder() binary.ByteOrder {
+	if f.Endianness == EndiannessLittle {
+		return binary.LittleEndian
+	}
+	return binary.BigEndian
+}
+
+func (f SchemaField) scale() float64 {
+	if f.Scale == 0 {
+		return 1
+	}
+	return f.Scale
+}
+
+// readUint reads the unsigned integer of the field size from b.
+func (f SchemaField) readUint(b []byte) uint64 {
+	bo := f.byteOrder()
+	switch len(b) {
+	case 1:
+		return uint64(b[0])
+	case 2:
+		return uint64(bo.Uint16(b))
+	case 4:
+		return uint64(bo.Uint32(b))
+	default:
+		return bo.Uint64(b)
+	}
+}
+
+// writeUint writes the unsigned integer of the field size to b.
+func (f SchemaField) writeUint(b []byte, v uint64) {
+	bo := f.byteOrder()
+	switch len(b) {
+	case 1:
+		b[0] = uint8(v)
+	case 2:
+		bo.PutUint16(b, uint16(v))
+	case 4:
+		bo.PutUint32(b, uint32(v))
+	default:
+		bo.PutUint64(b, v)
+	}
+}
+
+func (f SchemaField) decode(data []byte) (interface{}, error) {
+	size := f.size()
+	if f.Offset+size > len(data) {
+		return nil, fmt.Errorf("payload too short, expected at least %d bytes", f.Offset+size)
+	}
+	raw := f.readUint(data[f.Offset : f.Offset+size])
+
+	var v float64
+	switch f.Type {
+	case FieldTypeU8, FieldTypeU16, FieldTypeU32, FieldTypeU64:
+		if f.Scale == 0 && f.ValueOffset == 0 {
+			return raw, nil
+		}
+		v = float64(raw)
+	case FieldTypeI8:
+		v = float64(int8(raw))
+	case FieldTypeI16:
+		v = float64(int16(raw))
+	case FieldTypeI32:
+		v = float64(int32(raw))
+	case FieldTypeI64:
+		if f.Scale == 0 && f.ValueOffset == 0 {
+			return int64(raw), nil
+		}
+		v = float64(int64(raw))
+	case FieldTypeFloat:
+		v = float64(math.Float32frombits(uint32(raw)))
+		return v*f.scale() + f.ValueOffset, nil
+	case FieldTypeDouble:
+		v = math.Float64frombits(raw)
+		return v*f.scale() + f.ValueOffset, nil
+	case FieldTypeBitfield:
+		return (raw >> f.BitOffset) & (1<<f.BitLength - 1), nil
+	case FieldTypeEnum:
+		if name, ok := f.Values[strconv.FormatUint(raw, 10)]; ok {
+			return name, nil
+		}
+		return raw, nil
+	}
+
+	if f.Scale == 0 && f.ValueOffset == 0 {
+		return int64(v), nil
+	}
+
+	return v*f.scale() + f.ValueOffset, nil
+}
+
+func (f SchemaField) encode(out []byte, val interface{}) error {
+	b := out[f.Offset : f.Offset+f.size()]
+
+	if f.Type == FieldTypeEnum {
+		if name, ok := val.(string); ok {
+			for k, v := range f.Values {
+				if v == name {
+					raw, err := strconv.ParseUint(k, 10, 64)
+					if err != nil {
+						return errors.Wrap(err, "parse enum value error")
+					}
+					f.writeUint(b, raw)
+					return nil
+				}
+			}
+			return fmt.Errorf("unknown enum value: %s", name)
+		}
+	}
+
+	v, ok := toFloat64(val)
+	if !ok {
+		return fmt.Errorf("value must be a number, got: %T", val)
+	}
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return fmt.Errorf("value must be a finite number, got: %v", v)
+	}
+
+	switch f.Type {
+	case FieldTypeFloat:
+		raw := (v - f.ValueOffset) / f.scale()
+		if math.Abs(raw) > math.MaxFloat32 {
+			return fmt.Errorf("value %v out of range for type %s", v, f.Type)
+		}
+		f.writeUint(b, uint64(math.Float32bits(float32(raw))))
+	case FieldTypeDouble:
+		f.writeUint(b, math.Float64bits((v-f.ValueOffset)/f.scale()))
+	case FieldTypeBitfield:
+		raw := math.Round(v)
+		if err := f.checkRange(v, raw, f.BitLength, false); err != nil {
+			return err
+		}
+		mask := uint64(1<<f.BitLength-1) << f.BitOffset
+		f.writeUint(b, f.readUint(b)&^mask|(uint64(raw)<<f.BitOffset)&mask)
+	case FieldTypeEnum:
+		raw := math.Round(v)
+		if err := f.checkRange(v, raw, uint(len(b)*8), false); err != nil {
+			return err
+		}
+		f.writeUint(b, uint64(raw))
+	default:
+		raw := math.Round((v - f.ValueOffset) / f.scale())
+		switch f.Type {
+		case FieldTypeI8, FieldTypeI16, FieldTypeI32, FieldTypeI64:
+			if err := f.checkRange(v, raw, uint(len(b)*8), true); err != nil {
+				return err
+			}
+			f.writeUint(b, uint64(int64(raw)))
+		default:
+			if err := f.checkRange(v, raw, uint(len(b)*8), false); err != nil {
+				return err
+			}
+			f.writeUint(b, uint64(raw))
+		}
+	}
+
+	return nil
+}
+
+// checkRange returns an error when the given raw value (of value v) does not
+// fit in a (signed) integer of the given number of bits.
+func (f SchemaField) checkRange(v, raw float64, bits uint, signed bool) error {
+	min, max := 0.0, math.Ldexp(1, int(bits))
+	if signed {
+		min, max = -math.Ldexp(1, int(bits)-1), math.Ldexp(1, int(bits)-1)
+	}
+
+	if raw < min || raw >= max {
+		return fmt.Errorf("value %v out of range for type %s", v, f.Type)
+	}
+
+	return nil
+}
+
+// binarySchemaCache is a LRU cache of parsed binary schemas, keyed by the
+// SHA256 hash of the schema.
+type binarySchemaCache struct {
+	sync.Mutex
+	size  int
+	ll    *list.List
+	items map[[sha256.Size]byte]*list.Element
+}
+
+type binarySchemaCacheItem struct {
+	key    [sha256.Size]byte
+	schema Schema
+}
+
+func newBinarySchemaCache(size int) *binarySchemaCache {
+	return &binarySchemaCache{
+		size:  size,
+		ll:    list.New(),
+		items: make(map[[sha256.Size]byte]*list.Element),
+	}
+}
+
+// get returns the parsed binary schema. When not in cache, the schema is
+// parsed and added to the cache.
+func (c *binarySchemaCache) get(schema string) (Schema, error) {
+	key := sha256.Sum256([]byte(schema))
+
+	c.Lock()
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		c.Unlock()
+		return el.Value.(*binarySchemaCacheItem).schema, nil
+	}
+	c.Unlock()
+
+	// a parsed schema is never modified, it can be shared
+	s, err := ParseSchema(schema)
+	if err != nil {
+		return s, err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		return el.Value.(*binarySchemaCacheItem).schema, nil
+	}
+
+	c.items[key] = c.ll.PushFront(&binarySchemaCacheItem{key: key, schema: s})
+	if c.ll.Len() > c.size {
+		el := c.ll.Back()
+		c.ll.Remove(el)
+		delete(c.items, el.Value.(*binarySchemaCacheItem).key)
+	}
+
+	return s, nil
+}
+
+// len returns the number of cached binary schemas.
+func (c *binarySchemaCache) len() int {
+	c.Lock()
+	defer c.Unlock()
+	return c.ll.Len()
+}
+
+func toFloat64(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,301 @@
+package codec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBinarySchema(t *testing.T) {
+	schema := `
+messages:
+  - fPort: 1
+    fields:
+      - name: temperature
+        offset: 0
+        type: i16
+        scale: 0.1
+        unit: "°C"
+      - name: humidity
+        offset: 2
+        type: u8
+        scale: 0.5
+      - name: battery
+        offset: 3
+        type: u16
+        endianness: little
+  - fPort: 10
+    discriminator:
+      offset: 0
+      value: 1
+    fields:
+      - name: mode
+        offset: 1
+        type: enum
+        values:
+          "0": "off"
+          "1": "eco"
+          "2": "boost"
+      - name: ledOn
+        offset: 2
+        type: bitfield
+        bitOffset: 0
+        bitLength: 1
+      - name: level
+        offset: 2
+        type: bitfield
+        bitOffset: 4
+        bitLength: 4
+      - name: pressure
+        offset: 3
+        type: float
+`
+
+	tests := []struct {
+		Name          string
+		FPort         uint8
+		Bytes         []byte
+		Object        map[string]interface{}
+		ExpectedError string
+	}{
+		{
+			Name:  "scaled values",
+			FPort: 1,
+			Bytes: []byte{0xff, 0x9c, 0x51, 0x10, 0x0e},
+			Object: map[string]interface{}{
+				"temperature": -10.0,
+				"humidity":    40.5,
+				"battery":     uint64(3600),
+			},
+		},
+		{
+			Name:  "discriminator, enum and bitfields",
+			FPort: 10,
+			Bytes: []byte{0x01, 0x02, 0x51, 0x44, 0x7a, 0x00, 0x00},
+			Object: map[string]interface{}{
+				"mode":     "boost",
+				"ledOn":    uint64(1),
+				"level":    uint64(5),
+				"pressure": 1000.0,
+			},
+		},
+		{
+			Name:          "discriminator does not match",
+			FPort:         10,
+			Bytes:         []byte{0x02, 0x02, 0x51, 0x44, 0x7a, 0x00, 0x00},
+			ExpectedError: "no schema message matches fPort 10 and payload",
+		},
+		{
+			Name:          "payload too short",
+			FPort:         1,
+			Bytes:         []byte{0xff, 0x9c},
+			ExpectedError: "decode field humidity error: payload too short, expected at least 3 bytes",
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			c := NewBinarySchema(tst.FPort, schema)
+			err := c.DecodeBytes(tst.Bytes)
+			if tst.ExpectedError != "" {
+				assert.EqualError(err, tst.ExpectedError)
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(tst.Object, c.Object())
+
+			b, err := c.EncodeToBytes()
+			assert.NoError(err)
+			assert.Equal(tst.Bytes, b)
+		})
+	}
+}
+
+func TestBinarySchemaJSON(t *testing.T) {
+	assert := require.New(t)
+
+	c := NewPayload(BinarySchemaType, 2, "", `{"fields": [{"name": "counter", "offset": 0, "type": "u32"}]}`, nil, nil)
+	assert.NoError(c.(*BinarySchema).UnmarshalJSON([]byte(`{"counter": 1024}`)))
+
+	b, err := c.EncodeToBytes()
+	assert.NoError(err)
+	assert.Equal([]byte{0, 0, 4, 0}, b)
+}
+
+func TestParseSchema(t *testing.T) {
+	tests := []struct {
+		Name          string
+		Schema        string
+		ExpectedError string
+	}{
+		{
+			Name:          "no fields",
+			Schema:        `{}`,
+			ExpectedError: "schema must define at least one field",
+		},
+		{
+			Name:          "invalid type",
+			Schema:        `{"fields": [{"name": "a", "type": "u24"}]}`,
+			ExpectedError: "field a: invalid type: u24",
+		},
+		{
+			Name:          "invalid endianness",
+			Schema:        `{"fields": [{"name": "a", "type": "u16", "endianness": "middle"}]}`,
+			ExpectedError: "field a: invalid endianness: middle",
+		},
+		{
+			Name:          "bit range exceeds size",
+			Schema:        `{"fields": [{"name": "a", "type": "bitfield", "bitOffset": 4, "bitLength": 5}]}`,
+			ExpectedError: "field a: bit range exceeds the field size",
+		},
+		{
+			Name:          "offset exceeds max. payload size",
+			Schema:        `{"fields": [{"name": "a", "type": "u16", "offset": 254}]}`,
+			ExpectedError: "field a: field exceeds the max. payload size of 255 bytes",
+		},
+		{
+			Name:          "invalid discriminator offset",
+			Schema:        `{"messages": [{"discriminator": {"offset": -1}, "fields": [{"name": "a", "type": "u8"}]}]}`,
+			ExpectedError: "discriminator offset must be between 0 and 254",
+		},
+		{
+			Name:          "duplicate field name",
+			Schema:        `{"fields": [{"name": "a", "type": "u8"}, {"name": "a", "type": "u8", "offset": 1}]}`,
+			ExpectedError: "field a: duplicate field name",
+		},
+		{
+			Name:          "overlapping fields",
+			Schema:        `{"fields": [{"name": "a", "type": "u16"}, {"name": "b", "type": "u8", "offset": 1}]}`,
+			ExpectedError: "field b: overlaps with an other field",
+		},
+		{
+			Name:          "overlapping bitfields",
+			Schema:        `{"fields": [{"name": "a", "type": "bitfield", "size": 2, "bitOffset": 0, "bitLength": 9}, {"name": "b", "type": "bitfield", "offset": 0, "bitOffset": 0, "bitLength": 1}]}`,
+			ExpectedError: "field b: overlaps with an other field",
+		},
+		{
+			Name:   "valid",
+			Schema: `{"fields": [{"name": "a", "type": "bitfield", "size": 2, "bitOffset": 4, "bitLength": 5}]}`,
+		},
+		{
+			Name:   "valid bitfields sharing a byte",
+			Schema: `{"fields": [{"name": "a", "type": "bitfield", "size": 2, "bitOffset": 0, "bitLength": 8}, {"name": "b", "type": "bitfield", "offset": 0, "bitOffset": 0, "bitLength": 8}]}`,
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := ParseSchema(tst.Schema)
+			if tst.ExpectedError != "" {
+				assert.EqualError(err, tst.ExpectedError)
+				return
+			}
+			assert.NoError(err)
+		})
+	}
+}
+
+func TestBinarySchemaCache(t *testing.T) {
+	assert := require.New(t)
+
+	schema := `{"fields": [{"name": "a", "type": "u8"}]}`
+	c := newBinarySchemaCache(1)
+
+	s1, err := c.get(schema)
+	assert.NoError(err)
+	s2, err := c.get(schema)
+	assert.NoError(err)
+	assert.Equal(s1, s2)
+	assert.Equal(1, c.len())
+
+	_, err = c.get(`{"fields": [{"name": "b", "type": "u16"}]}`)
+	assert.NoError(err)
+	assert.Equal(1, c.len())
+
+	_, err = c.get(`{"fields": []}`)
+	assert.Error(err)
+	assert.Equal(1, c.len())
+}
+
+func TestBinarySchemaEncodeRange(t *testing.T) {
+	schema := `
+fields:
+  - name: u8
+    offset: 0
+    type: u8
+  - name: i8
+    offset: 1
+    type: i8
+  - name: i16
+    offset: 2
+    type: i16
+    scale: 0.1
+  - name: bits
+    offset: 4
+    type: bitfield
+    bitOffset: 4
+    bitLength: 4
+  - name: enum
+    offset: 5
+    type: enum
+    values:
+      "0": "off"
+  - name: float
+    offset: 6
+    type: float
+`
+
+	valid := map[string]interface{}{
+		"u8":    255.0,
+		"i8":    -128.0,
+		"i16":   3276.7,
+		"bits":  15.0,
+		"enum":  255.0,
+		"float": 1.5,
+	}
+
+	tests := []struct {
+		Name          string
+		Field         string
+		Value         float64
+		ExpectedError string
+	}{
+		{"valid", "", 0, ""},
+		{"u8 too large", "u8", 300, "encode field u8 error: value 300 out of range for type u8"},
+		{"u8 negative", "u8", -1, "encode field u8 error: value -1 out of range for type u8"},
+		{"i8 too large", "i8", 128, "encode field i8 error: value 128 out of range for type i8"},
+		{"i8 too small", "i8", -129, "encode field i8 error: value -129 out of range for type i8"},
+		{"scaled i16 too large", "i16", 3276.8, "encode field i16 error: value 3276.8 out of range for type i16"},
+		{"bitfield too large", "bits", 16, "encode field bits error: value 16 out of range for type bitfield"},
+		{"bitfield negative", "bits", -1, "encode field bits error: value -1 out of range for type bitfield"},
+		{"enum too large", "enum", 256, "encode field enum error: value 256 out of range for type enum"},
+		{"float too large", "float", 1e39, "encode field float error: value 1e+39 out of range for type float"},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			c := NewBinarySchema(1, schema)
+			c.Data = make(map[string]interface{})
+			for k, v := range valid {
+				c.Data[k] = v
+			}
+			if tst.Field != "" {
+				c.Data[tst.Field] = tst.Value
+			}
+
+			b, err := c.EncodeToBytes()
+			if tst.ExpectedError != "" {
+				assert.EqualError(err, tst.ExpectedError)
+				return
+			}
+			assert.NoError(err)
+			assert.Equal([]byte{0xff, 0x80, 0x7f, 0xff, 0xf0, 0xff, 0x3f, 0xc0, 0x00, 0x00}, b)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/gob"
 	"fmt"
 	"io"
+	"math"
 
 	"github.com/pkg/errors"
 )
@@ -28,6 +29,22 @@ const (
 	lppBarometer         byte = 115
 	lppGyrometer         byte = 134
 	lppGPSLocation       byte = 136
+
+	// extended types
+	lppGenericSensor byte = 100
+	lppVoltage       byte = 116
+	lppCurrent       byte = 117
+	lppFrequency     byte = 118
+	lppPercentage    byte = 120
+	lppAltitude      byte = 121
+	lppConcentration byte = 125
+	lppPower         byte = 128
+	lppDistance      byte = 130
+	lppEnergy        byte = 131
+	lppDirection     byte = 132
+	lppUnixTime      byte = 133
+	lppColour        byte = 135
+	lppSwitch        byte = 142
 )
 
 // Accelerometer defines the accelerometer data.
@@ -51,6 +68,13 @@ type GPSLocation struct {
 	Altitude  float64 `json:"altitude"`
 }
 
+// Colour defines the colour data.
+type Colour struct {
+	R uint8 `json:"r"`
+	G uint8 `json:"g"`
+	B uint8 `json:"b"`
+}
+
 // CayenneLPP defines the Cayenne LPP data structure.
 type CayenneLPP struct {
 	DigitalInput      map[byte]uint8         `json:"digitalInput,omitempty" influxdb:"digital_input"`
@@ -65,6 +89,20 @@ type CayenneLPP struct {
 	Barometer         map[byte]float64       `json:"barometer,omitempty" influxdb:"barometer"`
 	Gyrometer         map[byte]Gyrometer     `json:"gyrometer,omitempty" influxdb:"gyrometer"`
 	GPSLocation       map[byte]GPSLocation   `json:"gpsLocation,omitempty" influxdb:"gps_location"`
+	GenericSensor     map[byte]uint32        `json:"genericSensor,omitempty" influxdb:"generic_sensor"`
+	Voltage           map[byte]float64       `json:"voltage,omitempty" influxdb:"voltage"`
+	Current           map[byte]float64       `json:"current,omitempty" influxdb:"current"`
+	Frequency         map[byte]uint32        `json:"frequency,omitempty" influxdb:"frequency"`
+	Percentage        map[byte]uint8         `json:"percentage,omitempty" influxdb:"percentage"`
+	Altitude          map[byte]int16         `json:"altitude,omitempty" influxdb:"altitude"`
+	Concentration     map[byte]uint16        `json:"concentration,omitempty" influxdb:"concentration"`
+	Power             map[byte]uint16        `json:"power,omitempty" influxdb:"power"`
+	Distance          map[byte]float64       `json:"distance,omitempty" influxdb:"distance"`
+	Energy            map[byte]float64       `json:"energy,omitempty" influxdb:"energy"`
+	Direction         map[byte]uint16        `json:"direction,omitempty" influxdb:"direction"`
+	UnixTime          map[byte]uint32        `json:"unixTime,omitempty" influxdb:"unix_time"`
+	Colour            map[byte]Colour        `json:"colour,omitempty" influxdb:"colour"`
+	Switch            map[byte]uint8         `json:"switch,omitempty" influxdb:"switch"`
 }
 
 // Object returns the CayenneLPP data object.
@@ -112,6 +150,34 @@ func (c *CayenneLPP) DecodeBytes(data []byte) error {
 			err = lppGyrometerDecode(buf[0], r, c)
 		case lppGPSLocation:
 			err = lppGPSLocationDecode(buf[0], r, c)
+		case lppGenericSensor:
+			err = lppGenericSensorDecode(buf[0], r, c)
+		case lppVoltage:
+			err = lppVoltageDecode(buf[0], r, c)
+		case lppCurrent:
+			err = lppCurrentDecode(buf[0], r, c)
+		case lppFrequency:
+			err = lppFrequencyDecode(buf[0], r, c)
+		case lppPercentage:
+			err = lppPercentageDecode(buf[0], r, c)
+		case lppAltitude:
+			err = lppAltitudeDecode(buf[0], r, c)
+		case lppConcentration:
+			err = lppConcentrationDecode(buf[0], r, c)
+		case lppPower:
+			err = lppPowerDecode(buf[0], r, c)
+		case lppDistance:
+			err = lppDistanceDecode(buf[0], r, c)
+		case lppEnergy:
+			err = lppEnergyDecode(buf[0], r, c)
+		case lppDirection:
+			err = lppDirectionDecode(buf[0], r, c)
+		case lppUnixTime:
+			err = lppUnixTimeDecode(buf[0], r, c)
+		case lppColour:
+			err = lppColourDecode(buf[0], r, c)
+		case lppSwitch:
+			err = lppSwitchDecode(buf[0], r, c)
 		default:
 			return fmt.Errorf("invalid data type: %d", buf[1])
 		}
@@ -188,6 +254,76 @@ func (c CayenneLPP) EncodeToBytes() ([]byte, error) {
 			return nil, err
 		}
 	}
+	for k, v := range c.GenericSensor {
+		if err := lppGenericSensorEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Voltage {
+		if err := lppVoltageEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Current {
+		if err := lppCurrentEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Frequency {
+		if err := lppFrequencyEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Percentage {
+		if err := lppPercentageEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Altitude {
+		if err := lppAltitudeEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Concentration {
+		if err := lppConcentrationEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Power {
+		if err := lppPowerEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Distance {
+		if err := lppDistanceEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Energy {
+		if err := lppEnergyEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Direction {
+		if err := lppDirectionEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.UnixTime {
+		if err := lppUnixTimeEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Colour {
+		if err := lppColourEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Switch {
+		if err := lppSwitchEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
 
 	return w.Bytes(), nil
 }
@@ -478,3 +614,293 @@ func lppGPSLocationEncode(channel uint8, w io.Writer, data GPSLocation) error {
 	}
 	return nil
 }
+
+func lppGenericSensorDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint32
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint32 error")
+	}
+	if out.GenericSensor == nil {
+		out.GenericSensor = make(map[uint8]uint32)
+	}
+	out.GenericSensor[channel] = v
+	return nil
+}
+
+func lppGenericSensorEncode(channel uint8, w io.Writer, data uint32) error {
+	w.Write([]byte{channel, lppGenericSensor})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint32 error")
+	}
+	return nil
+}
+
+func lppVoltageDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint16
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint16 error")
+	}
+	if out.Voltage == nil {
+		out.Voltage = make(map[uint8]float64)
+	}
+	out.Voltage[channel] = float64(v) / 100
+	return nil
+}
+
+func lppVoltageEncode(channel uint8, w io.Writer, data float64) error {
+	w.Write([]byte{channel, lppVoltage})
+	if err := binary.Write(w, binary.BigEndian, uint16(math.Round(data*100))); err != nil {
+		return errors.Wrap(err, "write uint16 error")
+	}
+	return nil
+}
+
+func lppCurrentDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint16
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint16 error")
+	}
+	if out.Current == nil {
+		out.Current = make(map[uint8]float64)
+	}
+	out.Current[channel] = float64(v) / 1000
+	return nil
+}
+
+func lppCurrentEncode(channel uint8, w io.Writer, data float64) error {
+	w.Write([]byte{channel, lppCurrent})
+	if err := binary.Write(w, binary.BigEndian, uint16(math.Round(data*1000))); err != nil {
+		return errors.Wrap(err, "write uint16 error")
+	}
+	return nil
+}
+
+func lppFrequencyDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint32
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint32 error")
+	}
+	if out.Frequency == nil {
+		out.Frequency = make(map[uint8]uint32)
+	}
+	out.Frequency[channel] = v
+	return nil
+}
+
+func lppFrequencyEncode(channel uint8, w io.Writer, data uint32) error {
+	w.Write([]byte{channel, lppFrequency})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint32 error")
+	}
+	return nil
+}
+
+func lppPercentageDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint8
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint8 error")
+	}
+	if out.Percentage == nil {
+		out.Percentage = make(map[uint8]uint8)
+	}
+	out.Percentage[channel] = v
+	return nil
+}
+
+func lppPercentageEncode(channel uint8, w io.Writer, data uint8) error {
+	w.Write([]byte{channel, lppPercentage})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint8 error")
+	}
+	return nil
+}
+
+func lppAltitudeDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v int16
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read int16 error")
+	}
+	if out.Altitude == nil {
+		out.Altitude = make(map[uint8]int16)
+	}
+	out.Altitude[channel] = v
+	return nil
+}
+
+func lppAltitudeEncode(channel uint8, w io.Writer, data int16) error {
+	w.Write([]byte{channel, lppAltitude})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write int16 error")
+	}
+	return nil
+}
+
+func lppConcentrationDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint16
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint16 error")
+	}
+	if out.Concentration == nil {
+		out.Concentration = make(map[uint8]uint16)
+	}
+	out.Concentration[channel] = v
+	return nil
+}
+
+func lppConcentrationEncode(channel uint8, w io.Writer, data uint16) error {
+	w.Write([]byte{channel, lppConcentration})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint16 error")
+	}
+	return nil
+}
+
+func lppPowerDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint16
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint16 error")
+	}
+	if out.Power == nil {
+		out.Power = make(map[uint8]uint16)
+	}
+	out.Power[channel] = v
+	return nil
+}
+
+func lppPowerEncode(channel uint8, w io.Writer, data uint16) error {
+	w.Write([]byte{channel, lppPower})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint16 error")
+	}
+	return nil
+}
+
+func lppDistanceDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint32
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint32 error")
+	}
+	if out.Distance == nil {
+		out.Distance = make(map[uint8]float64)
+	}
+	out.Distance[channel] = float64(v) / 1000
+	return nil
+}
+
+func lppDistanceEncode(channel uint8, w io.Writer, data float64) error {
+	w.Write([]byte{channel, lppDistance})
+	if err := binary.Write(w, binary.BigEndian, uint32(math.Round(data*1000))); err != nil {
+		return errors.Wrap(err, "write uint32 error")
+	}
+	return nil
+}
+
+func lppEnergyDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint32
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint32 error")
+	}
+	if out.Energy == nil {
+		out.Energy = make(map[uint8]float64)
+	}
+	out.Energy[channel] = float64(v) / 1000
+	return nil
+}
+
+func lppEnergyEncode(channel uint8, w io.Writer, data float64) error {
+	w.Write([]byte{channel, lppEnergy})
+	if err := binary.Write(w, binary.BigEndian, uint32(math.Round(data*1000))); err != nil {
+		return errors.Wrap(err, "write uint32 error")
+	}
+	return nil
+}
+
+func lppDirectionDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint16
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint16 error")
+	}
+	if out.Direction == nil {
+		out.Direction = make(map[uint8]uint16)
+	}
+	out.Direction[channel] = v
+	return nil
+}
+
+func lppDirectionEncode(channel uint8, w io.Writer, data uint16) error {
+	w.Write([]byte{channel, lppDirection})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint16 error")
+	}
+	return nil
+}
+
+func lppUnixTimeDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint32
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint32 error")
+	}
+	if out.UnixTime == nil {
+		out.UnixTime = make(map[uint8]uint32)
+	}
+	out.UnixTime[channel] = v
+	return nil
+}
+
+func lppUnixTimeEncode(channel uint8, w io.Writer, data uint32) error {
+	w.Write([]byte{channel, lppUnixTime})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint32 error")
+	}
+	return nil
+}
+
+func lppColourDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	buf := make([]byte, 3)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return errors.Wrap(err, "read error")
+	}
+	if out.Colour == nil {
+		out.Colour = make(map[uint8]Colour)
+	}
+	out.Colour[channel] = Colour{
+		R: buf[0],
+		G: buf[1],
+		B: buf[2],
+	}
+	return nil
+}
+
+func lppColourEncode(channel uint8, w io.Writer, data Colour) error {
+	w.Write([]byte{channel, lppColour})
+	if _, err := w.Write([]byte{data.R, data.G, data.B}); err != nil {
+		return errors.Wrap(err, "write error")
+	}
+	return nil
+}
+
+func lppSwitchDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var b uint8
+	if err := binary.Read(r, binary.BigEndian, &b); err != nil {
+		return errors.Wrap(err, "read uint8 error")
+	}
+	if b > 1 {
+		return fmt.Errorf("switch value must be 0 or 1, got: %d", b)
+	}
+	if out.Switch == nil {
+		out.Switch = make(map[uint8]uint8)
+	}
+	out.Switch[channel] = b
+	return nil
+}
+
+func lppSwitchEncode(channel uint8, w io.Writer, data uint8) error {
+	if data > 1 {
+		return fmt.Errorf("switch value must be 0 or 1, got: %d", data)
+	}
+	w.Write([]byte{channel, lppSwitch})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint8 error")
+	}
+	return nil
+}
@@ -133,6 +133,104 @@ func TestCayenneLPPDecode(t *testing.T) {
 					},
 				},
 			},
+			{
+				Name:  "generic sensor",
+				Bytes: []byte{1, 100, 0, 1, 226, 64},
+				Struct: CayenneLPP{
+					GenericSensor: map[byte]uint32{1: 123456},
+				},
+			},
+			{
+				Name:  "voltage",
+				Bytes: []byte{1, 116, 1, 74},
+				Struct: CayenneLPP{
+					Voltage: map[byte]float64{1: 3.3},
+				},
+			},
+			{
+				Name:  "current",
+				Bytes: []byte{1, 117, 3, 233},
+				Struct: CayenneLPP{
+					Current: map[byte]float64{1: 1.001},
+				},
+			},
+			{
+				Name:  "frequency",
+				Bytes: []byte{1, 118, 0, 0, 3, 232},
+				Struct: CayenneLPP{
+					Frequency: map[byte]uint32{1: 1000},
+				},
+			},
+			{
+				Name:  "percentage",
+				Bytes: []byte{1, 120, 55},
+				Struct: CayenneLPP{
+					Percentage: map[byte]uint8{1: 55},
+				},
+			},
+			{
+				Name:  "altitude",
+				Bytes: []byte{1, 121, 255, 156},
+				Struct: CayenneLPP{
+					Altitude: map[byte]int16{1: -100},
+				},
+			},
+			{
+				Name:  "concentration",
+				Bytes: []byte{1, 125, 1, 144},
+				Struct: CayenneLPP{
+					Concentration: map[byte]uint16{1: 400},
+				},
+			},
+			{
+				Name:  "power",
+				Bytes: []byte{1, 128, 0, 100},
+				Struct: CayenneLPP{
+					Power: map[byte]uint16{1: 100},
+				},
+			},
+			{
+				Name:  "distance",
+				Bytes: []byte{1, 130, 0, 0, 48, 57},
+				Struct: CayenneLPP{
+					Distance: map[byte]float64{1: 12.345},
+				},
+			},
+			{
+				Name:  "energy",
+				Bytes: []byte{1, 131, 0, 0, 4, 210},
+				Struct: CayenneLPP{
+					Energy: map[byte]float64{1: 1.234},
+				},
+			},
+			{
+				Name:  "direction",
+				Bytes: []byte{1, 132, 1, 14},
+				Struct: CayenneLPP{
+					Direction: map[byte]uint16{1: 270},
+				},
+			},
+			{
+				Name:  "unix time",
+				Bytes: []byte{1, 133, 92, 189, 244, 0},
+				Struct: CayenneLPP{
+					UnixTime: map[byte]uint32{1: 1555952640},
+				},
+			},
+			{
+				Name:  "colour",
+				Bytes: []byte{1, 135, 255, 128, 0},
+				Struct: CayenneLPP{
+					Colour: map[byte]Colour{1: {R: 255, G: 128, B: 0}},
+				},
+			},
+			{
+				Name:  "2 switches",
+				Bytes: []byte{1, 142, 1, 2, 142, 0},
+				Struct: CayenneLPP{
+					Switch: map[byte]uint8{1: 1, 2: 0},
+				},
+			},
 		}
 
 		for i, test := range tests {
@@ -1,12 +1,21 @@
 package codec
 
+import (
+	"time"
+
+	"github.com/brocaar/lorawan"
+)
+
 // Type defines the codec type.
 type Type string
 
 // Available codec types.
 const (
-	CayenneLPPType Type = "CAYENNE_LPP"
-	CustomJSType   Type = "CUSTOM_JS"
+	CayenneLPPType   Type = "CAYENNE_LPP"
+	CustomJSType     Type = "CUSTOM_JS"
+	BinarySchemaType Type = "BINARY_SCHEMA"
+	ProtobufType     Type = "PROTOBUF"
+	WASMType         Type = "WASM"
 )
 
 // Payload defines a codec payload.
@@ -16,14 +25,36 @@ type Payload interface {
 	Object() interface{}
 }
 
+// Context defines the context in which a codec is executed. Codecs which
+// support a context implement SetContext(Context). Optional fields which are
+// unknown (e.g. the uplink meta-data on encoding) are nil.
+type Context struct {
+	DevEUI     lorawan.EUI64
+	FCnt       *uint32
+	ReceivedAt *time.Time
+	RSSI       *int
+	LoRaSNR    *float64
+	Variables  map[string]string
+}
+
 // NewPayload returns a new codec payload. In case of an unknown Type, nil is
-// returned.
-func NewPayload(t Type, fPort uint8, encodeScript, decodeScript string) Payload {
+// returned. For the BinarySchemaType, the schema must be given as
+// decodeScript. For the ProtobufType, the uplink and downlink message names
+// must be given as decodeScript and encodeScript, the descriptorSet must
+// contain the (binary encoded) FileDescriptorSet defining these messages.
+// For the WASMType, the wasmModule must contain the WebAssembly module.
+func NewPayload(t Type, fPort uint8, encodeScript, decodeScript string, descriptorSet, wasmModule []byte) Payload {
 	switch t {
 	case CayenneLPPType:
 		return &CayenneLPP{}
 	case CustomJSType:
 		return NewCustomJS(fPort, encodeScript, decodeScript)
+	case BinarySchemaType:
+		return NewBinarySchema(fPort, decodeScript)
+	case ProtobufType:
+		return NewProtobuf(fPort, descriptorSet, decodeScript, encodeScript)
+	case WASMType:
+		return NewWASM(fPort, wasmModule)
 	default:
 		return nil
 	}
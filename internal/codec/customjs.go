@@ -7,29 +7,24 @@ import (
 	"reflect"
 	"time"
 
-	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/dop251/goja"
 	"github.com/pkg/errors"
-	"github.com/robertkrimen/otto"
+
+	"github.com/brocaar/lorawan"
 )
 
 func init() {
 	gob.Register(CustomJS{})
 }
 
-var (
-	maxExecutionTime = 10 * time.Millisecond
-)
-
-func Setup(conf config.Config) error {
-	maxExecutionTime = conf.ApplicationServer.Codec.JS.MaxExecutionTime
-	return nil
-}
-
 // CustomJS is a scriptable JS codec.
 type CustomJS struct {
 	fPort        uint8
 	encodeScript string
 	decodeScript string
+	context      Context
+	state        []byte
+	logs         []string
 	Data         interface{}
 }
 
@@ -47,6 +42,30 @@ func (c CustomJS) Object() interface{} {
 	return c.Data
 }
 
+// SetContext sets the context which is passed as third argument to the
+// Decode and Encode functions.
+func (c *CustomJS) SetContext(ctx Context) {
+	c.context = ctx
+}
+
+// SetState sets the (JSON encoded) state which is exposed to the Decode
+// function as context.state.
+func (c *CustomJS) SetState(state []byte) {
+	c.state = state
+}
+
+// State returns the (JSON encoded) state after the last decode. It returns
+// nil when the state is empty.
+func (c CustomJS) State() []byte {
+	return c.state
+}
+
+// Logs returns the lines written using console.log during the last decode
+// or encode.
+func (c CustomJS) Logs() []string {
+	return c.logs
+}
+
 // MarshalJSON implements json.Marshaler.
 func (c CustomJS) MarshalJSON() ([]byte, error) {
 	return json.Marshal(c.Data)
@@ -58,85 +77,120 @@ func (c *CustomJS) UnmarshalJSON(text []byte) error {
 }
 
 // DecodeBytes decodes the payload from a slice of bytes.
-func (c *CustomJS) DecodeBytes(data []byte) (err error) {
-	defer func() {
-		if caught := recover(); caught != nil {
-			err = fmt.Errorf("%s", caught)
+func (c *CustomJS) DecodeBytes(data []byte) error {
+	c.logs = nil
+
+	var ctx *goja.Object
+	val, err := callJS(c.decodeScript, "Decode", c.log, func(vm *goja.Runtime) ([]goja.Value, error) {
+		ctx = c.contextToObject(vm)
+
+		state := goja.Value(vm.NewObject())
+		if len(c.state) != 0 {
+			var err error
+			if state, err = parseJSON(vm, c.state); err != nil {
+				return nil, errors.Wrap(err, "parse state error")
+			}
 		}
-	}()
-
-	script := c.decodeScript + "\n\nDecode(fPort, bytes);\n"
-
-	vm := otto.New()
-	vm.Interrupt = make(chan func(), 1)
-	vm.SetStackDepthLimit(32)
-	vm.Set("bytes", data)
-	vm.Set("fPort", c.fPort)
-
-	go func() {
-		time.Sleep(maxExecutionTime)
-		vm.Interrupt <- func() {
-			panic(errors.New("execution timeout"))
+		if err := ctx.Set("state", state); err != nil {
+			return nil, err
 		}
-	}()
 
-	var val otto.Value
-	val, err = vm.Run(script)
+		return []goja.Value{vm.ToValue(c.fPort), bytesToArray(vm, data), ctx}, nil
+	}, func(vm *goja.Runtime) error {
+		state, err := stringifyJSON(vm, ctx.Get("state"))
+		if err != nil {
+			return err
+		}
+		if string(state) == "{}" || string(state) == "null" {
+			state = nil
+		}
+		if len(state) > maxStateSize {
+			return ErrStateSizeLimit
+		}
+		c.state = state
+		return nil
+	})
 	if err != nil {
-		return errors.Wrap(err, "js vm error")
+		return err
 	}
 
-	if !val.IsObject() {
+	if _, ok := val.(*goja.Object); !ok {
 		return errors.New("function must return object")
 	}
 
-	c.Data, err = val.Export()
-	if err != nil {
-		return errors.Wrap(err, "export error")
-	}
-
+	c.Data = val.Export()
 	return nil
 }
 
 // EncodeToBytes encodes the payload to a slice of bytes.
-func (c CustomJS) EncodeToBytes() (b []byte, err error) {
-	defer func() {
-		if caught := recover(); caught != nil {
-			err = fmt.Errorf("%s", caught)
-		}
-	}()
+func (c *CustomJS) EncodeToBytes() ([]byte, error) {
+	c.logs = nil
+	val, err := callJS(c.encodeScript, "Encode", c.log, func(vm *goja.Runtime) ([]goja.Value, error) {
+		return []goja.Value{vm.ToValue(c.fPort), vm.ToValue(c.Data), c.contextToObject(vm)}, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
 
-	script := c.encodeScript + "\n\nEncode(fPort, obj);\n"
+	if _, ok := val.(*goja.Object); !ok {
+		return nil, errors.New("function must return an array")
+	}
 
-	vm := otto.New()
-	vm.Interrupt = make(chan func(), 1)
-	vm.SetStackDepthLimit(32)
-	vm.Set("obj", c.Data)
-	vm.Set("fPort", c.fPort)
+	return interfaceToByteSlice(val.Export())
+}
 
-	go func() {
-		time.Sleep(maxExecutionTime)
-		vm.Interrupt <- func() {
-			panic(errors.New("execution timeout"))
-		}
-	}()
+func (c *CustomJS) log(line string) {
+	c.logs = append(c.logs, line)
+}
 
-	var val otto.Value
-	val, err = vm.Run(script)
-	if err != nil {
-		return nil, errors.Wrap(err, "js vm error")
+// contextToObject returns the context as JS object. Unknown fields are
+// omitted, variables is always set.
+func (c *CustomJS) contextToObject(vm *goja.Runtime) *goja.Object {
+	variables := vm.NewObject()
+	for k, v := range c.context.Variables {
+		variables.Set(k, v)
 	}
-	if !val.IsObject() {
-		return nil, errors.New("function must return an array")
+
+	ctx := vm.NewObject()
+	ctx.Set("variables", variables)
+	if c.context.DevEUI != (lorawan.EUI64{}) {
+		ctx.Set("devEUI", c.context.DevEUI.String())
+	}
+	if c.context.FCnt != nil {
+		ctx.Set("fCnt", *c.context.FCnt)
+	}
+	if c.context.ReceivedAt != nil {
+		ctx.Set("receivedAt", c.context.ReceivedAt.UTC().Format(time.RFC3339Nano))
+	}
+	if c.context.RSSI != nil {
+		ctx.Set("rssi", *c.context.RSSI)
 	}
+	if c.context.LoRaSNR != nil {
+		ctx.Set("loRaSNR", *c.context.LoRaSNR)
+	}
+
+	return ctx
+}
 
-	var out interface{}
-	out, err = val.Export()
+// ExecuteJS runs the given script within the same sandbox as used by the
+// CustomJS codec (stack-depth limit, max. execution time and max. memory).
+// The given variables are set before the script is executed. It returns the
+// exported value of the last evaluated statement.
+func ExecuteJS(script string, vars map[string]interface{}) (interface{}, error) {
+	val, err := runJS(script, vars)
 	if err != nil {
-		return nil, errors.Wrap(err, "export error")
+		return nil, err
 	}
 
-	return interfaceToByteSlice(out)
+	return val.Export(), nil
+}
+
+// CompileJS compiles the given script using the same program cache as used
+// by ExecuteJS, e.g. to check the script for syntax errors before it is
+// stored.
+func CompileJS(script string) error {
+	_, err := programs.get(script)
+	return err
 }
 
 func interfaceToByteSlice(obj interface{}) ([]byte, error) {
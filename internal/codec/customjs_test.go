@@ -3,9 +3,12 @@ package codec
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lorawan"
 )
 
 func TestCustomJSDecode(t *testing.T) {
@@ -175,3 +178,222 @@ func TestCustomEncodeJS(t *testing.T) {
 		}
 	})
 }
+
+func TestCustomJSES6(t *testing.T) {
+	Convey("Given an ES6 decode script", t, func() {
+		js := NewCustomJS(2, "", `
+			const Decode = (fPort, bytes) => {
+				let [on, ...rest] = bytes;
+				return {
+					on: on === 1,
+					rest: rest.length,
+					label: `+"`port ${fPort}`"+`,
+				};
+			};
+		`)
+
+		Convey("Then the payload is decoded", func() {
+			So(js.DecodeBytes([]byte{1, 2, 3}), ShouldBeNil)
+
+			b, err := js.MarshalJSON()
+			So(err, ShouldBeNil)
+			So(string(b), ShouldEqual, `{"label":"port 2","on":true,"rest":2}`)
+		})
+	})
+}
+
+func TestCustomJSMemoryLimit(t *testing.T) {
+	Convey("Given a memory limit and a script allocating memory", t, func() {
+		maxMemory = 1024 * 1024
+		maxExecutionTime = 10 * time.Second
+		defer func() {
+			maxMemory = 0
+			maxExecutionTime = 10 * time.Millisecond
+		}()
+
+		js := NewCustomJS(2, "", `
+			function Decode(fPort, bytes) {
+				var out = [];
+				while(true) {
+					out.push({"value": "some data"});
+				}
+			}
+		`)
+
+		Convey("Then the execution is interrupted", func() {
+			So(js.DecodeBytes([]byte{1}), ShouldEqual, ErrMemoryLimit)
+		})
+	})
+}
+
+func TestProgramCache(t *testing.T) {
+	Convey("Given a program cache of size 2", t, func() {
+		c := newProgramCache(2)
+
+		Convey("Then the same script returns the same program", func() {
+			p1, err := c.get("var a = 1;")
+			So(err, ShouldBeNil)
+			p2, err := c.get("var a = 1;")
+			So(err, ShouldBeNil)
+			So(p1, ShouldEqual, p2)
+			So(c.len(), ShouldEqual, 1)
+		})
+
+		Convey("Then the least recently used program is evicted", func() {
+			p1, err := c.get("var a = 1;")
+			So(err, ShouldBeNil)
+			_, err = c.get("var b = 1;")
+			So(err, ShouldBeNil)
+			_, err = c.get("var c = 1;")
+			So(err, ShouldBeNil)
+			So(c.len(), ShouldEqual, 2)
+
+			p2, err := c.get("var a = 1;")
+			So(err, ShouldBeNil)
+			So(p1, ShouldNotEqual, p2)
+		})
+
+		Convey("Then a compile error is returned", func() {
+			_, err := c.get("var = ;")
+			So(err, ShouldNotBeNil)
+			So(c.len(), ShouldEqual, 0)
+		})
+	})
+}
+
+func TestCustomJSContext(t *testing.T) {
+	Convey("Given a decode and encode script using the context argument", t, func() {
+		fCnt := uint32(10)
+		rssi := -60
+		snr := 5.5
+		receivedAt := time.Date(2019, 4, 1, 12, 30, 0, 0, time.UTC)
+
+		js := NewCustomJS(2, `
+			function Encode(fPort, obj, context) {
+				console.log("encoding for", context.devEUI);
+				return [obj.value * parseInt(context.variables.scale)];
+			}
+		`, `
+			function Decode(fPort, bytes, context) {
+				console.log("fCnt", context.fCnt, context.variables);
+				return {
+					devEUI: context.devEUI,
+					fCnt: context.fCnt,
+					receivedAt: context.receivedAt,
+					rssi: context.rssi,
+					snr: context.loRaSNR,
+					value: bytes[0] / parseInt(context.variables.scale),
+				};
+			}
+		`)
+
+		Convey("Given no context", func() {
+			Convey("Then the variables are empty and the meta-data is undefined", func() {
+				js.decodeScript = `
+					function Decode(fPort, bytes, context) {
+						return {
+							variables: Object.keys(context.variables).length,
+							fCnt: typeof context.fCnt,
+						};
+					}
+				`
+				So(js.DecodeBytes([]byte{1}), ShouldBeNil)
+
+				b, err := js.MarshalJSON()
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, `{"fCnt":"undefined","variables":0}`)
+			})
+		})
+
+		Convey("Given an uplink context", func() {
+			js.SetContext(Context{
+				DevEUI:     lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				FCnt:       &fCnt,
+				ReceivedAt: &receivedAt,
+				RSSI:       &rssi,
+				LoRaSNR:    &snr,
+				Variables:  map[string]string{"scale": "2"},
+			})
+
+			Convey("Then the context is passed to Decode", func() {
+				So(js.DecodeBytes([]byte{10}), ShouldBeNil)
+
+				b, err := js.MarshalJSON()
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, `{"devEUI":"0102030405060708","fCnt":10,"receivedAt":"2019-04-01T12:30:00Z","rssi":-60,"snr":5.5,"value":5}`)
+				So(js.Logs(), ShouldResemble, []string{`fCnt 10 {"scale":"2"}`})
+			})
+		})
+
+		Convey("Given a downlink context", func() {
+			js.SetContext(Context{
+				DevEUI:    lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				Variables: map[string]string{"scale": "2"},
+			})
+			js.Data = map[string]interface{}{"value": 3}
+
+			Convey("Then the context is passed to Encode", func() {
+				b, err := js.EncodeToBytes()
+				So(err, ShouldBeNil)
+				So(b, ShouldResemble, []byte{6})
+				So(js.Logs(), ShouldResemble, []string{"encoding for 0102030405060708"})
+			})
+		})
+	})
+}
+
+func TestCustomJSState(t *testing.T) {
+	Convey("Given a decode script using the state to calculate deltas", t, func() {
+		js := NewCustomJS(2, "", `
+			function Decode(fPort, bytes, context) {
+				var counter = bytes[0];
+				var delta = context.state.counter === undefined ? null : counter - context.state.counter;
+				context.state.counter = counter;
+				return {"counter": counter, "delta": delta};
+			}
+		`)
+
+		Convey("Then the first decode does not have a delta", func() {
+			So(js.DecodeBytes([]byte{10}), ShouldBeNil)
+			b, err := js.MarshalJSON()
+			So(err, ShouldBeNil)
+			So(string(b), ShouldEqual, `{"counter":10,"delta":null}`)
+			So(string(js.State()), ShouldEqual, `{"counter":10}`)
+
+			Convey("Then the next decode uses the previous state", func() {
+				js2 := NewCustomJS(2, "", js.decodeScript)
+				js2.SetState(js.State())
+
+				So(js2.DecodeBytes([]byte{15}), ShouldBeNil)
+				b, err := js2.MarshalJSON()
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, `{"counter":15,"delta":5}`)
+				So(string(js2.State()), ShouldEqual, `{"counter":15}`)
+			})
+		})
+
+		Convey("Then a state exceeding the max. size returns an error", func() {
+			js.decodeScript = `
+				function Decode(fPort, bytes, context) {
+					context.state.data = new Array(5000).join("x");
+					return {};
+				}
+			`
+			js.SetState([]byte(`{"counter":10}`))
+			So(js.DecodeBytes([]byte{1}), ShouldEqual, ErrStateSizeLimit)
+			So(string(js.State()), ShouldEqual, `{"counter":10}`)
+		})
+
+		Convey("Then clearing the state results in an empty state", func() {
+			js.decodeScript = `
+				function Decode(fPort, bytes, context) {
+					context.state = {};
+					return {};
+				}
+			`
+			js.SetState([]byte(`{"counter":10}`))
+			So(js.DecodeBytes([]byte{1}), ShouldBeNil)
+			So(js.State(), ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,157 @@
+package codec
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/xeipuuv/gojsonreference"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// jsonSchemaCacheSize defines the number of parsed JSON Schemas to cache.
+const jsonSchemaCacheSize = 100
+
+var jsonSchemas = newJSONSchemaCache(jsonSchemaCacheSize)
+
+// ParseJSONSchema parses the given JSON Schema. Note that references ($ref)
+// to remote schemas (HTTP or file system) are not supported.
+func ParseJSONSchema(schema string) (*gojsonschema.Schema, error) {
+	s, err := gojsonschema.NewSchema(localSchemaLoader{gojsonschema.NewStringLoader(schema)})
+	if err != nil {
+		return nil, errors.Wrap(err, "parse json schema error")
+	}
+	return s, nil
+}
+
+// ValidateJSONSchema validates the given object against the given JSON
+// Schema. In case the object is not valid, the returned error contains the
+// validation errors. The parsed schemas are cached.
+func ValidateJSONSchema(schema string, obj interface{}) error {
+	s, err := jsonSchemas.get(schema)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.Validate(gojsonschema.NewGoLoader(obj))
+	if err != nil {
+		return errors.Wrap(err, "validate json schema error")
+	}
+
+	if !res.Valid() {
+		var errs []string
+		for _, e := range res.Errors() {
+			errs = append(errs, e.String())
+		}
+		return fmt.Errorf("json schema validation error: %s", strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// jsonSchemaCache is a LRU cache of parsed JSON Schemas, keyed by the SHA256
+// hash of the schema.
+type jsonSchemaCache struct {
+	sync.Mutex
+	size  int
+	ll    *list.List
+	items map[[sha256.Size]byte]*list.Element
+}
+
+type jsonSchemaCacheItem struct {
+	key    [sha256.Size]byte
+	schema *gojsonschema.Schema
+}
+
+func newJSONSchemaCache(size int) *jsonSchemaCache {
+	return &jsonSchemaCache{
+		size:  size,
+		ll:    list.New(),
+		items: make(map[[sha256.Size]byte]*list.Element),
+	}
+}
+
+// get returns the parsed JSON Schema. When not in cache, the schema is
+// parsed and added to the cache.
+func (c *jsonSchemaCache) get(schema string) (*gojsonschema.Schema, error) {
+	key := sha256.Sum256([]byte(schema))
+
+	c.Lock()
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		c.Unlock()
+		return el.Value.(*jsonSchemaCacheItem).schema, nil
+	}
+	c.Unlock()
+
+	// a parsed schema can be used for concurrent validations
+	s, err := ParseJSONSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		return el.Value.(*jsonSchemaCacheItem).schema, nil
+	}
+
+	c.items[key] = c.ll.PushFront(&jsonSchemaCacheItem{key: key, schema: s})
+	if c.ll.Len() > c.size {
+		el := c.ll.Back()
+		c.ll.Remove(el)
+		delete(c.items, el.Value.(*jsonSchemaCacheItem).key)
+	}
+
+	return s, nil
+}
+
+// len returns the number of cached JSON Schemas.
+func (c *jsonSchemaCache) len() int {
+	c.Lock()
+	defer c.Unlock()
+	return c.ll.Len()
+}
+
+// localSchemaLoader wraps a JSON loader and disables the loading of remote
+// schemas.
+type localSchemaLoader struct {
+	gojsonschema.JSONLoader
+}
+
+func (l localSchemaLoader) LoaderFactory() gojsonschema.JSONLoaderFactory {
+	return remoteSchemaLoaderFactory{}
+}
+
+type remoteSchemaLoaderFactory struct{}
+
+func (f remoteSchemaLoaderFactory) New(source string) gojsonschema.JSONLoader {
+	return remoteSchemaLoader{source: source}
+}
+
+// remoteSchemaLoader is returned for references to remote schemas. Loading
+// always returns an error.
+type remoteSchemaLoader struct {
+	source string
+}
+
+func (l remoteSchemaLoader) JsonSource() interface{} {
+	return l.source
+}
+
+func (l remoteSchemaLoader) LoadJSON() (interface{}, error) {
+	return nil, fmt.Errorf("remote schema reference %s is not supported", l.source)
+}
+
+func (l remoteSchemaLoader) JsonReference() (gojsonreference.JsonReference, error) {
+	return gojsonreference.NewJsonReference(l.source)
+}
+
+func (l remoteSchemaLoader) LoaderFactory() gojsonschema.JSONLoaderFactory {
+	return remoteSchemaLoaderFactory{}
+}
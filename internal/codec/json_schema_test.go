@@ -0,0 +1,98 @@
+package codec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateJSONSchema(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {
+			"temperature": {"type": "number"},
+			"label": {"type": "string"}
+		},
+		"required": ["temperature"]
+	}`
+
+	tests := []struct {
+		Name          string
+		Schema        string
+		Object        interface{}
+		ExpectedError string
+	}{
+		{
+			Name:   "valid object",
+			Schema: schema,
+			Object: map[string]interface{}{"temperature": 21.5, "label": "room"},
+		},
+		{
+			Name:   "valid object with integer",
+			Schema: schema,
+			Object: map[string]interface{}{"temperature": int64(21)},
+		},
+		{
+			Name:          "invalid type",
+			Schema:        schema,
+			Object:        map[string]interface{}{"temperature": "21.5"},
+			ExpectedError: "json schema validation error: temperature: Invalid type. Expected: number, given: string",
+		},
+		{
+			Name:          "missing field",
+			Schema:        schema,
+			Object:        map[string]interface{}{"label": "room"},
+			ExpectedError: "json schema validation error: (root): temperature is required",
+		},
+		{
+			Name:          "invalid schema",
+			Schema:        `{"type": 1}`,
+			Object:        map[string]interface{}{},
+			ExpectedError: "parse json schema error: Invalid type. Expected: string/array of strings, given: type",
+		},
+		{
+			Name:          "remote reference",
+			Schema:        `{"$ref": "file:///etc/passwd"}`,
+			Object:        map[string]interface{}{},
+			ExpectedError: "parse json schema error: remote schema reference file:///etc/passwd is not supported",
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			err := ValidateJSONSchema(tst.Schema, tst.Object)
+			if tst.ExpectedError == "" {
+				assert.NoError(err)
+			} else {
+				assert.EqualError(err, tst.ExpectedError)
+			}
+		})
+	}
+
+	t.Run("json schema cache", func(t *testing.T) {
+		assert := require.New(t)
+
+		c := newJSONSchemaCache(1)
+
+		s1, err := c.get(schema)
+		assert.NoError(err)
+		s2, err := c.get(schema)
+		assert.NoError(err)
+		assert.True(s1 == s2)
+		assert.Equal(1, c.len())
+
+		_, err = c.get(`{"type": "object"}`)
+		assert.NoError(err)
+		assert.Equal(1, c.len())
+
+		s3, err := c.get(schema)
+		assert.NoError(err)
+		assert.False(s1 == s3)
+
+		_, err = c.get(`{"type": 1}`)
+		assert.Error(err)
+		assert.Equal(1, c.len())
+	})
+}
@@ -0,0 +1,344 @@
+package codec
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"runtime/metrics"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+)
+
+// maxCallStackSize defines the max. call-stack size of the JS runtime.
+const maxCallStackSize = 32
+
+// heapMetric is the runtime metric used for the memory limit.
+const heapMetric = "/memory/classes/heap/objects:bytes"
+
+// memoryCheckInterval defines the interval in which the memory usage is
+// checked during the execution of a script.
+const memoryCheckInterval = time.Millisecond
+
+// maxLogLines defines the max. number of console.log lines captured per
+// execution.
+const maxLogLines = 100
+
+// JS execution errors.
+var (
+	ErrExecutionTimeout = errors.New("execution timeout")
+	ErrMemoryLimit      = errors.New("memory limit exceeded")
+	ErrStateSizeLimit   = errors.New("state size limit exceeded")
+)
+
+var (
+	maxExecutionTime = 10 * time.Millisecond
+	maxMemory        uint64
+	maxStateSize     = 4096
+	programs         = newProgramCache(1000)
+)
+
+// Setup configures the codec package.
+func Setup(conf config.Config) error {
+	maxExecutionTime = conf.ApplicationServer.Codec.JS.MaxExecutionTime
+	maxMemory = uint64(conf.ApplicationServer.Codec.JS.MaxMemory)
+	if conf.ApplicationServer.Codec.JS.MaxStateSize > 0 {
+		maxStateSize = conf.ApplicationServer.Codec.JS.MaxStateSize
+	}
+	if conf.ApplicationServer.Codec.JS.ProgramCacheSize > 0 {
+		programs = newProgramCache(conf.ApplicationServer.Codec.JS.ProgramCacheSize)
+	}
+	setupWASM(
+		conf.ApplicationServer.Codec.WASM.MaxExecutionTime,
+		conf.ApplicationServer.Codec.WASM.MaxMemory,
+		conf.ApplicationServer.Codec.WASM.ModuleCacheSize,
+	)
+	return nil
+}
+
+// runJS runs the given script after setting the given variables. It returns
+// the value of the last evaluated statement.
+func runJS(script string, vars map[string]interface{}) (goja.Value, error) {
+	return sandbox(script, nil, func(vm *goja.Runtime, p *goja.Program) (goja.Value, error) {
+		for k, v := range vars {
+			if err := vm.Set(k, v); err != nil {
+				return nil, errors.Wrap(err, "set variable error")
+			}
+		}
+
+		return vm.RunProgram(p)
+	})
+}
+
+// callJS runs the given script and then calls the function with the given
+// name, using the arguments returned by args. When not nil, after is called
+// within the sandbox once the function has returned, e.g. to read back
+// the arguments modified by the function. It returns the return value
+// of the function. Lines written using console.log are passed to log (when
+// not nil).
+func callJS(script, name string, log func(string), args func(vm *goja.Runtime) ([]goja.Value, error), after func(vm *goja.Runtime) error) (goja.Value, error) {
+	return sandbox(script, log, func(vm *goja.Runtime, p *goja.Program) (goja.Value, error) {
+		if _, err := vm.RunProgram(p); err != nil {
+			return nil, err
+		}
+
+		f, ok := goja.AssertFunction(vm.Get(name))
+		if !ok {
+			return nil, fmt.Errorf("js vm error: ReferenceError: '%s' is not defined", name)
+		}
+
+		a, err := args(vm)
+		if err != nil {
+			return nil, err
+		}
+
+		val, err := f(goja.Undefined(), a...)
+		if err != nil {
+			return nil, err
+		}
+
+		if after != nil {
+			if err := after(vm); err != nil {
+				return nil, err
+			}
+		}
+
+		return val, nil
+	})
+}
+
+// sandbox compiles (or gets from cache) the given script and calls f with a
+// new runtime. The runtime is interrupted when the max. execution time or
+// the max. memory usage has been exceeded.
+func sandbox(script string, log func(string), f func(vm *goja.Runtime, p *goja.Program) (goja.Value, error)) (val goja.Value, err error) {
+	defer func() {
+		if caught := recover(); caught != nil {
+			err = fmt.Errorf("%s", caught)
+		}
+	}()
+
+	p, err := programs.get(script)
+	if err != nil {
+		return nil, errors.Wrap(err, "js vm error")
+	}
+
+	vm := goja.New()
+	vm.SetMaxCallStackSize(maxCallStackSize)
+	if err := setConsole(vm, log); err != nil {
+		return nil, errors.Wrap(err, "set console error")
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go watchdog(vm, done, maxExecutionTime, maxMemory)
+
+	val, err = f(vm, p)
+	if err != nil {
+		if ie, ok := err.(*goja.InterruptedError); ok {
+			if e, ok := ie.Value().(error); ok {
+				return nil, e
+			}
+		}
+
+		if _, ok := err.(*goja.Exception); ok {
+			return nil, errors.Wrap(err, "js vm error")
+		}
+
+		return nil, err
+	}
+
+	return val, nil
+}
+
+// watchdog interrupts the given runtime when it exceeds the max. execution
+// time or the max. memory usage, until done is closed. As the Go runtime
+// does not track allocations per goroutine, the memory usage is approximated
+// by the growth of the heap since the start of the execution. This includes
+// the allocations of concurrent executions, which is why the limit must be
+// set well above the memory usage of a script. A max. memory usage of 0
+// disables the check. The limits are passed by the caller, as the watchdog
+// might outlive the execution.
+func watchdog(vm *goja.Runtime, done chan struct{}, maxExecutionTime time.Duration, maxMemory uint64) {
+	timer := time.NewTimer(maxExecutionTime)
+	defer timer.Stop()
+
+	var tick <-chan time.Time
+	sample := []metrics.Sample{{Name: heapMetric}}
+	if maxMemory != 0 {
+		metrics.Read(sample)
+		ticker := time.NewTicker(memoryCheckInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	start := heapBytes(sample)
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-timer.C:
+			vm.Interrupt(ErrExecutionTimeout)
+			return
+		case <-tick:
+			metrics.Read(sample)
+			if used := heapBytes(sample); used > start && used-start > maxMemory {
+				vm.Interrupt(ErrMemoryLimit)
+				return
+			}
+		}
+	}
+}
+
+func heapBytes(sample []metrics.Sample) uint64 {
+	if sample[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+	return sample[0].Value.Uint64()
+}
+
+// setConsole sets the console object, of which the log function passes the
+// formatted arguments to the given log function. At most maxLogLines lines
+// are passed.
+func setConsole(vm *goja.Runtime, log func(string)) error {
+	var lines int
+
+	console := vm.NewObject()
+	if err := console.Set("log", func(call goja.FunctionCall) goja.Value {
+		if log == nil || lines >= maxLogLines {
+			return goja.Undefined()
+		}
+		lines++
+
+		var args []string
+		for _, arg := range call.Arguments {
+			args = append(args, formatLogArgument(arg))
+		}
+		log(strings.Join(args, " "))
+
+		return goja.Undefined()
+	}); err != nil {
+		return err
+	}
+
+	return vm.Set("console", console)
+}
+
+// formatLogArgument formats the given console.log argument. Objects are
+// JSON encoded.
+func formatLogArgument(v goja.Value) string {
+	if o, ok := v.(*goja.Object); ok {
+		if _, ok := goja.AssertFunction(o); !ok {
+			if b, err := json.Marshal(o.Export()); err == nil {
+				return string(b)
+			}
+		}
+	}
+	return v.String()
+}
+
+// parseJSON parses the given JSON using JSON.parse of the given runtime.
+func parseJSON(vm *goja.Runtime, b []byte) (goja.Value, error) {
+	parse, ok := goja.AssertFunction(vm.Get("JSON").ToObject(vm).Get("parse"))
+	if !ok {
+		return nil, errors.New("JSON.parse is not a function")
+	}
+	return parse(goja.Undefined(), vm.ToValue(string(b)))
+}
+
+// stringifyJSON returns the given value as JSON using JSON.stringify of the
+// given runtime.
+func stringifyJSON(vm *goja.Runtime, v goja.Value) ([]byte, error) {
+	stringify, ok := goja.AssertFunction(vm.Get("JSON").ToObject(vm).Get("stringify"))
+	if !ok {
+		return nil, errors.New("JSON.stringify is not a function")
+	}
+	out, err := stringify(goja.Undefined(), v)
+	if err != nil {
+		return nil, err
+	}
+	if goja.IsUndefined(out) {
+		return nil, nil
+	}
+	return []byte(out.String()), nil
+}
+
+// bytesToArray returns the given bytes as JS array of numbers.
+func bytesToArray(vm *goja.Runtime, b []byte) *goja.Object {
+	items := make([]interface{}, len(b))
+	for i := range b {
+		items[i] = b[i]
+	}
+	return vm.NewArray(items...)
+}
+
+// programCache is a LRU cache of compiled scripts, keyed by the SHA256 hash
+// of the script.
+type programCache struct {
+	sync.Mutex
+	size  int
+	ll    *list.List
+	items map[[sha256.Size]byte]*list.Element
+}
+
+type programCacheItem struct {
+	key     [sha256.Size]byte
+	program *goja.Program
+}
+
+func newProgramCache(size int) *programCache {
+	return &programCache{
+		size:  size,
+		ll:    list.New(),
+		items: make(map[[sha256.Size]byte]*list.Element),
+	}
+}
+
+// get returns the compiled program for the given script. When not in cache,
+// the script is compiled and added to the cache.
+func (c *programCache) get(script string) (*goja.Program, error) {
+	key := sha256.Sum256([]byte(script))
+
+	c.Lock()
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		c.Unlock()
+		return el.Value.(*programCacheItem).program, nil
+	}
+	c.Unlock()
+
+	// a compiled program can be shared by multiple runtimes
+	p, err := goja.Compile("", script, false)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		return el.Value.(*programCacheItem).program, nil
+	}
+
+	c.items[key] = c.ll.PushFront(&programCacheItem{key: key, program: p})
+	if c.ll.Len() > c.size {
+		el := c.ll.Back()
+		c.ll.Remove(el)
+		delete(c.items, el.Value.(*programCacheItem).key)
+	}
+
+	return p, nil
+}
+
+// len returns the number of cached programs.
+func (c *programCache) len() int {
+	c.Lock()
+	defer c.Unlock()
+	return c.ll.Len()
+}
@@ -0,0 +1,226 @@
+package codec
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/pkg/errors"
+)
+
+// descriptorSetCacheSize defines the number of parsed descriptor sets to
+// cache.
+const descriptorSetCacheSize = 100
+
+var descriptorSets = newDescriptorSetCache(descriptorSetCacheSize)
+
+func init() {
+	gob.Register(Protobuf{})
+}
+
+// Protobuf is a codec that decodes and encodes payloads as Protocol Buffers
+// messages, using the message definitions of a (compiled) FileDescriptorSet.
+// The decoded object follows the proto3 JSON mapping.
+type Protobuf struct {
+	fPort           uint8
+	descriptorSet   []byte
+	uplinkMessage   string
+	downlinkMessage string
+	Data            map[string]interface{}
+}
+
+// NewProtobuf creates a new Protobuf codec. The uplink message is used for
+// decoding, the downlink message for encoding. When the downlink message is
+// empty, the uplink message is also used for encoding.
+func NewProtobuf(fPort uint8, descriptorSet []byte, uplinkMessage, downlinkMessage string) *Protobuf {
+	if downlinkMessage == "" {
+		downlinkMessage = uplinkMessage
+	}
+
+	return &Protobuf{
+		fPort:           fPort,
+		descriptorSet:   descriptorSet,
+		uplinkMessage:   uplinkMessage,
+		downlinkMessage: downlinkMessage,
+	}
+}
+
+// Object returns the object data.
+func (c Protobuf) Object() interface{} {
+	return c.Data
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c Protobuf) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Data)
+}
+
+// UnmarshalJSON implement json.Unmarshaler.
+func (c *Protobuf) UnmarshalJSON(text []byte) error {
+	// use json.Number to avoid loss of precision of (64 bit) integers
+	dec := json.NewDecoder(bytes.NewReader(text))
+	dec.UseNumber()
+	return dec.Decode(&c.Data)
+}
+
+// DecodeBytes decodes the payload from a slice of bytes.
+func (c *Protobuf) DecodeBytes(data []byte) error {
+	md, err := FindProtobufMessage(c.descriptorSet, c.uplinkMessage)
+	if err != nil {
+		return err
+	}
+
+	msg := dynamic.NewMessage(md)
+	if err := msg.Unmarshal(data); err != nil {
+		return errors.Wrapf(err, "unmarshal %s error", md.GetFullyQualifiedName())
+	}
+
+	m := jsonpb.Marshaler{EmitDefaults: true}
+	b, err := msg.MarshalJSONPB(&m)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	c.Data = make(map[string]interface{})
+	return json.Unmarshal(b, &c.Data)
+}
+
+// EncodeToBytes encodes the payload to a slice of bytes.
+func (c Protobuf) EncodeToBytes() ([]byte, error) {
+	md, err := FindProtobufMessage(c.descriptorSet, c.downlinkMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(c.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal json error")
+	}
+
+	msg := dynamic.NewMessage(md)
+	if err := msg.UnmarshalJSONPB(&jsonpb.Unmarshaler{}, b); err != nil {
+		return nil, errors.Wrapf(err, "unmarshal json to %s error", md.GetFullyQualifiedName())
+	}
+
+	return msg.Marshal()
+}
+
+// ParseDescriptorSet parses the given (binary encoded) FileDescriptorSet.
+// It returns an error when the set is invalid or incomplete (e.g. a missing
+// dependency).
+func ParseDescriptorSet(descriptorSet []byte) (map[string]*desc.FileDescriptor, error) {
+	if len(descriptorSet) == 0 {
+		return nil, errors.New("no protobuf descriptor set configured")
+	}
+
+	var fds descriptor.FileDescriptorSet
+	if err := proto.Unmarshal(descriptorSet, &fds); err != nil {
+		return nil, errors.Wrap(err, "unmarshal descriptor set error")
+	}
+
+	files, err := desc.CreateFileDescriptorsFromSet(&fds)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse descriptor set error")
+	}
+
+	return files, nil
+}
+
+// FindProtobufMessage returns the descriptor of the message with the given
+// fully-qualified name (e.g. "example.Uplink") from the given
+// FileDescriptorSet. The parsed descriptor set is cached.
+func FindProtobufMessage(descriptorSet []byte, name string) (*desc.MessageDescriptor, error) {
+	name = strings.TrimPrefix(name, ".")
+	if name == "" {
+		return nil, errors.New("no protobuf message name configured")
+	}
+
+	files, err := descriptorSets.get(descriptorSet)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fd := range files {
+		if md := fd.FindMessage(name); md != nil {
+			return md, nil
+		}
+	}
+
+	return nil, fmt.Errorf("protobuf message %s not found in descriptor set", name)
+}
+
+// descriptorSetCache is a LRU cache of parsed descriptor sets, keyed by the
+// SHA256 hash of the (binary encoded) FileDescriptorSet.
+type descriptorSetCache struct {
+	sync.Mutex
+	size  int
+	ll    *list.List
+	items map[[sha256.Size]byte]*list.Element
+}
+
+type descriptorSetCacheItem struct {
+	key   [sha256.Size]byte
+	files map[string]*desc.FileDescriptor
+}
+
+func newDescriptorSetCache(size int) *descriptorSetCache {
+	return &descriptorSetCache{
+		size:  size,
+		ll:    list.New(),
+		items: make(map[[sha256.Size]byte]*list.Element),
+	}
+}
+
+// get returns the parsed file descriptors of the given descriptor set. When
+// not in cache, the descriptor set is parsed and added to the cache.
+func (c *descriptorSetCache) get(descriptorSet []byte) (map[string]*desc.FileDescriptor, error) {
+	key := sha256.Sum256(descriptorSet)
+
+	c.Lock()
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		c.Unlock()
+		return el.Value.(*descriptorSetCacheItem).files, nil
+	}
+	c.Unlock()
+
+	// the parsed descriptors are immutable and can be shared
+	files, err := ParseDescriptorSet(descriptorSet)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		return el.Value.(*descriptorSetCacheItem).files, nil
+	}
+
+	c.items[key] = c.ll.PushFront(&descriptorSetCacheItem{key: key, files: files})
+	if c.ll.Len() > c.size {
+		el := c.ll.Back()
+		c.ll.Remove(el)
+		delete(c.items, el.Value.(*descriptorSetCacheItem).key)
+	}
+
+	return files, nil
+}
+
+// len returns the number of cached descriptor sets.
+func (c *descriptorSetCache) len() int {
+	c.Lock()
+	defer c.Unlock()
+	return c.ll.Len()
+}
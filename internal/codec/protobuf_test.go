@@ -0,0 +1,225 @@
+package codec
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/stretchr/testify/require"
+)
+
+// testDescriptorSet returns a descriptor set equal to the output of
+// protoc --descriptor_set_out for:
+//
+//	syntax = "proto3";
+//	package example;
+//
+//	message Uplink {
+//	    float temperature = 1;
+//	    uint32 battery_level = 2;
+//	    repeated sint32 samples = 3;
+//	    uint64 counter = 4;
+//	}
+//
+//	message Downlink {
+//	    bool led_on = 1;
+//	}
+func testDescriptorSet(t *testing.T) []byte {
+	field := func(name, jsonName string, number int32, t descriptor.FieldDescriptorProto_Type, label descriptor.FieldDescriptorProto_Label) *descriptor.FieldDescriptorProto {
+		return &descriptor.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(jsonName),
+			Number:   proto.Int32(number),
+			Type:     t.Enum(),
+			Label:    label.Enum(),
+		}
+	}
+	optional := descriptor.FieldDescriptorProto_LABEL_OPTIONAL
+	repeated := descriptor.FieldDescriptorProto_LABEL_REPEATED
+
+	fds := descriptor.FileDescriptorSet{
+		File: []*descriptor.FileDescriptorProto{
+			{
+				Name:    proto.String("example.proto"),
+				Package: proto.String("example"),
+				Syntax:  proto.String("proto3"),
+				MessageType: []*descriptor.DescriptorProto{
+					{
+						Name: proto.String("Uplink"),
+						Field: []*descriptor.FieldDescriptorProto{
+							field("temperature", "temperature", 1, descriptor.FieldDescriptorProto_TYPE_FLOAT, optional),
+							field("battery_level", "batteryLevel", 2, descriptor.FieldDescriptorProto_TYPE_UINT32, optional),
+							field("samples", "samples", 3, descriptor.FieldDescriptorProto_TYPE_SINT32, repeated),
+							field("counter", "counter", 4, descriptor.FieldDescriptorProto_TYPE_UINT64, optional),
+						},
+					},
+					{
+						Name: proto.String("Downlink"),
+						Field: []*descriptor.FieldDescriptorProto{
+							field("led_on", "ledOn", 1, descriptor.FieldDescriptorProto_TYPE_BOOL, optional),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	b, err := proto.Marshal(&fds)
+	require.NoError(t, err)
+	return b
+}
+
+func TestProtobuf(t *testing.T) {
+	descriptorSet := testDescriptorSet(t)
+
+	t.Run("DecodeBytes", func(t *testing.T) {
+		tests := []struct {
+			Name          string
+			Message       string
+			Bytes         []byte
+			Object        map[string]interface{}
+			ExpectedError string
+		}{
+			{
+				Name:    "all fields",
+				Message: "example.Uplink",
+				// temperature = 21.5, battery_level = 90, samples = [-1, 2], counter = 2^40
+				Bytes: []byte{0x0d, 0x00, 0x00, 0xac, 0x41, 0x10, 0x5a, 0x1a, 0x02, 0x01, 0x04, 0x20, 0x80, 0x80, 0x80, 0x80, 0x80, 0x20},
+				Object: map[string]interface{}{
+					"temperature":  21.5,
+					"batteryLevel": 90.0,
+					"samples":      []interface{}{-1.0, 2.0},
+					"counter":      "1099511627776",
+				},
+			},
+			{
+				Name:    "default values",
+				Message: ".example.Uplink",
+				Bytes:   []byte{},
+				Object: map[string]interface{}{
+					"temperature":  0.0,
+					"batteryLevel": 0.0,
+					"samples":      []interface{}{},
+					"counter":      "0",
+				},
+			},
+			{
+				Name:          "unknown message",
+				Message:       "example.Foo",
+				Bytes:         []byte{},
+				ExpectedError: "protobuf message example.Foo not found in descriptor set",
+			},
+			{
+				Name:          "no message",
+				Bytes:         []byte{},
+				ExpectedError: "no protobuf message name configured",
+			},
+		}
+
+		for _, tst := range tests {
+			t.Run(tst.Name, func(t *testing.T) {
+				assert := require.New(t)
+
+				c := NewProtobuf(1, descriptorSet, tst.Message, "")
+				err := c.DecodeBytes(tst.Bytes)
+				if tst.ExpectedError != "" {
+					assert.EqualError(err, tst.ExpectedError)
+					return
+				}
+				assert.NoError(err)
+				assert.Equal(tst.Object, c.Object())
+			})
+		}
+	})
+
+	t.Run("EncodeToBytes", func(t *testing.T) {
+		tests := []struct {
+			Name            string
+			UplinkMessage   string
+			DownlinkMessage string
+			JSON            string
+			Bytes           []byte
+			ExpectedError   string
+		}{
+			{
+				Name:            "downlink message",
+				UplinkMessage:   "example.Uplink",
+				DownlinkMessage: "example.Downlink",
+				JSON:            `{"ledOn": true}`,
+				Bytes:           []byte{0x08, 0x01},
+			},
+			{
+				Name:          "fallback to uplink message",
+				UplinkMessage: "example.Uplink",
+				JSON:          `{"battery_level": 90, "counter": "1099511627776"}`,
+				Bytes:         []byte{0x10, 0x5a, 0x20, 0x80, 0x80, 0x80, 0x80, 0x80, 0x20},
+			},
+			{
+				Name:            "unknown field",
+				UplinkMessage:   "example.Uplink",
+				DownlinkMessage: "example.Downlink",
+				JSON:            `{"ledOff": true}`,
+				ExpectedError:   `unmarshal json to example.Downlink error: message type example.Downlink has no known field named ledOff`,
+			},
+		}
+
+		for _, tst := range tests {
+			t.Run(tst.Name, func(t *testing.T) {
+				assert := require.New(t)
+
+				c := NewPayload(ProtobufType, 1, tst.DownlinkMessage, tst.UplinkMessage, descriptorSet, nil)
+				assert.NoError(c.(*Protobuf).UnmarshalJSON([]byte(tst.JSON)))
+
+				b, err := c.EncodeToBytes()
+				if tst.ExpectedError != "" {
+					assert.EqualError(err, tst.ExpectedError)
+					return
+				}
+				assert.NoError(err)
+				assert.Equal(tst.Bytes, b)
+			})
+		}
+	})
+
+	t.Run("invalid descriptor set", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := ParseDescriptorSet(nil)
+		assert.EqualError(err, "no protobuf descriptor set configured")
+
+		_, err = ParseDescriptorSet([]byte{0x0a, 0x05})
+		assert.Error(err)
+	})
+
+	t.Run("descriptor set cache", func(t *testing.T) {
+		assert := require.New(t)
+
+		other, err := proto.Marshal(&descriptor.FileDescriptorSet{
+			File: []*descriptor.FileDescriptorProto{
+				{Name: proto.String("other.proto")},
+			},
+		})
+		assert.NoError(err)
+
+		c := newDescriptorSetCache(1)
+
+		f1, err := c.get(descriptorSet)
+		assert.NoError(err)
+		f2, err := c.get(descriptorSet)
+		assert.NoError(err)
+		assert.True(f1["example.proto"] == f2["example.proto"])
+		assert.Equal(1, c.len())
+
+		_, err = c.get(other)
+		assert.NoError(err)
+		assert.Equal(1, c.len())
+
+		f3, err := c.get(descriptorSet)
+		assert.NoError(err)
+		assert.False(f1["example.proto"] == f3["example.proto"])
+
+		_, err = c.get([]byte{0x0a, 0x05})
+		assert.Error(err)
+		assert.Equal(1, c.len())
+	})
+}
@@ -0,0 +1,509 @@
+package codec
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+)
+
+func init() {
+	gob.Register(WASM{})
+}
+
+// wasmPageSize defines the size of a WebAssembly memory page.
+const wasmPageSize = 65536
+
+var (
+	wasmMaxExecutionTime = 100 * time.Millisecond
+	wasmMaxMemory        int64
+	wasmModuleCacheSize  = 100
+
+	wasmMux sync.Mutex
+	wasmRT  *wasmRuntime
+)
+
+// WASM is a codec that executes a WebAssembly module. The module must
+// implement the following ABI:
+//
+//   - export "memory": the linear memory of the module
+//   - export "alloc(size i32) -> i32": returns a pointer to size bytes of
+//     memory in which the input is written
+//   - export "decode(f_port i32, ptr i32, len i32) -> i64": decodes the
+//     payload bytes at ptr and returns the location of the JSON encoded
+//     object as (ptr << 32 | len)
+//   - export "encode(f_port i32, ptr i32, len i32) -> i64": encodes the JSON
+//     encoded object at ptr and returns the location of the payload bytes as
+//     (ptr << 32 | len)
+//
+// Optionally, the module can import "env.log(ptr i32, len i32)" to log a
+// line and "env.error(ptr i32, len i32)" to fail the current call with the
+// given error message. The WASI (snapshot preview1) functions are available
+// to the module, without access to the filesystem, environment or network.
+// A new instance of the module is created for every call.
+//
+// The execution time and the size of the linear memory of a call are
+// limited. Instruction (fuel) metering is not supported by the runtime,
+// the execution time is the only limit on the CPU usage of a call.
+type WASM struct {
+	fPort  uint8
+	module []byte
+	logs   []string
+	Data   map[string]interface{}
+}
+
+// NewWASM creates a new WASM codec.
+func NewWASM(fPort uint8, module []byte) *WASM {
+	return &WASM{
+		fPort:  fPort,
+		module: module,
+	}
+}
+
+// Object returns the object data.
+func (c WASM) Object() interface{} {
+	return c.Data
+}
+
+// Logs returns the lines logged by the module during the last Decode or
+// Encode call.
+func (c WASM) Logs() []string {
+	return c.logs
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c WASM) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Data)
+}
+
+// UnmarshalJSON implement json.Unmarshaler.
+func (c *WASM) UnmarshalJSON(text []byte) error {
+	// use json.Number to avoid loss of precision of (64 bit) integers
+	dec := json.NewDecoder(bytes.NewReader(text))
+	dec.UseNumber()
+	return dec.Decode(&c.Data)
+}
+
+// DecodeBytes decodes the payload from a slice of bytes.
+func (c *WASM) DecodeBytes(data []byte) error {
+	out, err := c.call("decode", data)
+	if err != nil {
+		return err
+	}
+
+	// numbers are decoded as float64, like the objects of the other codecs
+	var obj interface{}
+	if err := json.Unmarshal(out, &obj); err != nil {
+		return errors.Wrap(err, "unmarshal json error")
+	}
+
+	m, ok := obj.(map[string]interface{})
+	if !ok {
+		return errors.New("function must return object")
+	}
+	c.Data = m
+
+	return nil
+}
+
+// EncodeToBytes encodes the payload to a slice of bytes.
+func (c *WASM) EncodeToBytes() ([]byte, error) {
+	b, err := json.Marshal(c.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal json error")
+	}
+
+	return c.call("encode", b)
+}
+
+// call instantiates the module and calls the exported function with the
+// given name using the given input. It returns a copy of the output.
+func (c *WASM) call(name string, input []byte) ([]byte, error) {
+	c.logs = nil
+
+	rt := acquireWASMRuntime()
+	defer rt.release()
+
+	compiled, release, err := rt.modules.get(c.module)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), wasmMaxExecutionTime)
+	defer cancel()
+	state := wasmCallState{log: c.log}
+	ctx = context.WithValue(ctx, wasmCallStateKey{}, &state)
+
+	out, err := rt.call(ctx, compiled, name, c.fPort, input)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, ErrExecutionTimeout
+		}
+		return nil, errors.Wrap(err, "wasm error")
+	}
+
+	if state.err != "" {
+		return nil, fmt.Errorf("wasm error: %s", state.err)
+	}
+
+	return out, nil
+}
+
+func (c *WASM) log(line string) {
+	c.logs = append(c.logs, line)
+}
+
+// ValidateWASMModule compiles the given module and validates that it
+// exports the memory and alloc function required by the ABI.
+func ValidateWASMModule(module []byte) error {
+	rt := acquireWASMRuntime()
+	defer rt.release()
+
+	_, release, err := rt.modules.get(module)
+	if err != nil {
+		return err
+	}
+	release()
+
+	return nil
+}
+
+// setupWASM configures the WebAssembly runtime. As compiled modules are
+// bound to the runtime which compiled them, this (re)creates the module
+// cache.
+func setupWASM(maxExecutionTime time.Duration, maxMemory int64, moduleCacheSize int) {
+	wasmMux.Lock()
+	defer wasmMux.Unlock()
+
+	if maxExecutionTime > 0 {
+		wasmMaxExecutionTime = maxExecutionTime
+	}
+	wasmMaxMemory = maxMemory
+	if moduleCacheSize > 0 {
+		wasmModuleCacheSize = moduleCacheSize
+	}
+
+	// running calls keep using the previous runtime until they return,
+	// after which it is closed
+	if wasmRT != nil {
+		go wasmRT.close()
+		wasmRT = nil
+	}
+}
+
+// acquireWASMRuntime returns the shared runtime, creating it on first use.
+// The runtime must be released after use.
+func acquireWASMRuntime() *wasmRuntime {
+	wasmMux.Lock()
+	defer wasmMux.Unlock()
+
+	if wasmRT == nil {
+		wasmRT = newWASMRuntime(wasmMaxMemory, wasmModuleCacheSize)
+	}
+	wasmRT.active.Add(1)
+
+	return wasmRT
+}
+
+type wasmCallStateKey struct{}
+
+// wasmCallState holds the state of a single call, passed to the host
+// functions through the context.
+type wasmCallState struct {
+	log   func(string)
+	lines int
+	err   string
+}
+
+// wasmRuntime wraps a wazero runtime with the host modules and the cache
+// of modules compiled by it.
+type wasmRuntime struct {
+	runtime wazero.Runtime
+	modules *moduleCache
+
+	// active is incremented while holding wasmMux, and only as long as the
+	// runtime has not been replaced, so that close can wait for it
+	active sync.WaitGroup
+}
+
+func newWASMRuntime(maxMemory int64, moduleCacheSize int) *wasmRuntime {
+	ctx := context.Background()
+
+	conf := wazero.NewRuntimeConfig().WithCloseOnContextDone(true)
+	if pages := maxMemory / wasmPageSize; pages > 0 && pages < 65536 {
+		conf = conf.WithMemoryLimitPages(uint32(pages))
+	}
+
+	r := wazero.NewRuntimeWithConfig(ctx, conf)
+	wasi_snapshot_preview1.MustInstantiate(ctx, r)
+
+	_, err := r.NewHostModuleBuilder("env").
+		NewFunctionBuilder().WithFunc(wasmLog).Export("log").
+		NewFunctionBuilder().WithFunc(wasmError).Export("error").
+		Instantiate(ctx)
+	if err != nil {
+		// this can only fail on an invalid host module definition
+		panic(errors.Wrap(err, "instantiate env host module error"))
+	}
+
+	rt := wasmRuntime{
+		runtime: r,
+	}
+	rt.modules = newModuleCache(moduleCacheSize, rt.compile)
+
+	return &rt
+}
+
+// release releases the runtime acquired by acquireWASMRuntime.
+func (rt *wasmRuntime) release() {
+	rt.active.Done()
+}
+
+// close waits until all acquired references have been released and then
+// closes the runtime, including its compiled modules.
+func (rt *wasmRuntime) close() {
+	rt.active.Wait()
+	if err := rt.runtime.Close(context.Background()); err != nil {
+		log.WithError(err).Error("codec: close wasm runtime error")
+	}
+}
+
+// compile compiles and validates the given module.
+func (rt *wasmRuntime) compile(module []byte) (wazero.CompiledModule, error) {
+	if len(module) == 0 {
+		return nil, errors.New("no wasm module configured")
+	}
+
+	compiled, err := rt.runtime.CompileModule(context.Background(), module)
+	if err != nil {
+		return nil, errors.Wrap(err, "compile wasm module error")
+	}
+
+	if _, ok := compiled.ExportedMemories()["memory"]; !ok {
+		compiled.Close(context.Background())
+		return nil, errors.New("wasm module must export memory")
+	}
+
+	if _, ok := compiled.ExportedFunctions()["alloc"]; !ok {
+		compiled.Close(context.Background())
+		return nil, errors.New("wasm module must export function alloc")
+	}
+
+	return compiled, nil
+}
+
+// call instantiates the given module and calls the exported function with
+// the given name.
+func (rt *wasmRuntime) call(ctx context.Context, compiled wazero.CompiledModule, name string, fPort uint8, input []byte) ([]byte, error) {
+	// the module name must be empty, so that multiple instances can
+	// co-exist; _initialize is called for WASI reactor modules
+	mod, err := rt.runtime.InstantiateModule(ctx, compiled, wazero.NewModuleConfig().
+		WithName("").
+		WithStartFunctions("_initialize"))
+	if err != nil {
+		return nil, errors.Wrap(err, "instantiate wasm module error")
+	}
+	defer mod.Close(context.Background())
+
+	f := mod.ExportedFunction(name)
+	if f == nil {
+		return nil, fmt.Errorf("function %s is not exported", name)
+	}
+
+	res, err := mod.ExportedFunction("alloc").Call(ctx, uint64(len(input)))
+	if err != nil {
+		return nil, errors.Wrap(err, "call alloc error")
+	}
+	ptr := uint32(res[0])
+
+	if !mod.Memory().Write(ptr, input) {
+		return nil, errors.New("alloc returned out of range pointer")
+	}
+
+	res, err = f.Call(ctx, uint64(fPort), uint64(ptr), uint64(len(input)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "call %s error", name)
+	}
+
+	// the error function might have been called by the module
+	if state, ok := ctx.Value(wasmCallStateKey{}).(*wasmCallState); ok && state.err != "" {
+		return nil, nil
+	}
+
+	out, ok := mod.Memory().Read(uint32(res[0]>>32), uint32(res[0]))
+	if !ok {
+		return nil, fmt.Errorf("%s returned out of range memory location", name)
+	}
+
+	// the memory is released when the module is closed
+	return append([]byte(nil), out...), nil
+}
+
+// wasmLog implements the env.log host function.
+func wasmLog(ctx context.Context, m api.Module, ptr, length uint32) {
+	state, ok := ctx.Value(wasmCallStateKey{}).(*wasmCallState)
+	if !ok || state.log == nil || state.lines >= maxLogLines {
+		return
+	}
+
+	b, ok := m.Memory().Read(ptr, length)
+	if !ok {
+		return
+	}
+
+	state.lines++
+	state.log(string(b))
+}
+
+// wasmError implements the env.error host function.
+func wasmError(ctx context.Context, m api.Module, ptr, length uint32) {
+	state, ok := ctx.Value(wasmCallStateKey{}).(*wasmCallState)
+	if !ok {
+		return
+	}
+
+	b, ok := m.Memory().Read(ptr, length)
+	if !ok {
+		state.err = "error called with out of range memory location"
+		return
+	}
+
+	state.err = string(b)
+}
+
+// moduleCache is a LRU cache of compiled modules, keyed by the SHA256 hash
+// of the module.
+//
+// As the runtime shares the compiled code of modules with the same hash,
+// closing a compiled module invalidates all compiled modules with the same
+// hash. Therefore a module is compiled at most once at a time and the
+// compiled module is only closed when it has been evicted and all references
+// have been released.
+type moduleCache struct {
+	sync.Mutex
+	size    int
+	compile func([]byte) (wazero.CompiledModule, error)
+	ll      *list.List
+	items   map[[sha256.Size]byte]*moduleCacheItem
+}
+
+type moduleCacheItem struct {
+	key  [sha256.Size]byte
+	refs int
+
+	// el is nil when the item is not (or no longer) in the LRU list
+	el *list.Element
+
+	// module and err are set before ready is closed
+	ready  chan struct{}
+	module wazero.CompiledModule
+	err    error
+}
+
+func newModuleCache(size int, compile func([]byte) (wazero.CompiledModule, error)) *moduleCache {
+	return &moduleCache{
+		size:    size,
+		compile: compile,
+		ll:      list.New(),
+		items:   make(map[[sha256.Size]byte]*moduleCacheItem),
+	}
+}
+
+// get returns the compiled module and a function which must be called to
+// release it after use. When not in cache, the module is compiled and added
+// to the cache.
+func (c *moduleCache) get(module []byte) (wazero.CompiledModule, func(), error) {
+	key := sha256.Sum256(module)
+
+	c.Lock()
+	item, ok := c.items[key]
+	if !ok {
+		item = &moduleCacheItem{key: key, ready: make(chan struct{})}
+		c.items[key] = item
+	}
+	item.refs++
+	c.Unlock()
+
+	release := func() {
+		c.Lock()
+		defer c.Unlock()
+
+		item.refs--
+		if item.refs == 0 && item.el == nil {
+			c.remove(item)
+		}
+	}
+
+	if ok {
+		// the module might still be compiled by an other caller
+		<-item.ready
+		if item.err != nil {
+			release()
+			return nil, nil, item.err
+		}
+	} else {
+		item.module, item.err = c.compile(module)
+		close(item.ready)
+		if item.err != nil {
+			release()
+			return nil, nil, item.err
+		}
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if item.el != nil {
+		c.ll.MoveToFront(item.el)
+	} else {
+		item.el = c.ll.PushFront(item)
+	}
+
+	for c.ll.Len() > c.size {
+		el := c.ll.Back()
+		c.ll.Remove(el)
+
+		evicted := el.Value.(*moduleCacheItem)
+		evicted.el = nil
+		if evicted.refs == 0 {
+			c.remove(evicted)
+		}
+	}
+
+	var once sync.Once
+	return item.module, func() { once.Do(release) }, nil
+}
+
+// remove removes the given item, which is no longer referenced, from the
+// cache and closes the compiled module. It must be called while holding
+// the lock.
+func (c *moduleCache) remove(item *moduleCacheItem) {
+	if c.items[item.key] == item {
+		delete(c.items, item.key)
+	}
+
+	if item.module != nil {
+		if err := item.module.Close(context.Background()); err != nil {
+			log.WithError(err).Error("codec: close compiled wasm module error")
+		}
+	}
+}
+
+// len returns the number of cached modules.
+func (c *moduleCache) len() int {
+	c.Lock()
+	defer c.Unlock()
+	return c.ll.Len()
+}
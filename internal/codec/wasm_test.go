@@ -0,0 +1,250 @@
+package codec
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testWASMModule implements the WASM codec ABI. Decode and Encode return
+// their input, except for the following fPorts:
+//
+//	98: tries to grow the memory by 1000 pages (64MiB) and calls error on failure
+//	99: loops forever
+//
+// An empty input results in an error. Source:
+//
+//	(module
+//	  (import "env" "log" (func $log (param i32 i32)))
+//	  (import "env" "error" (func $error (param i32 i32)))
+//	  (memory (export "memory") 1)
+//	  (global $heap (mut i32) (i32.const 1024))
+//	  (data (i32.const 0) "decoding")
+//	  (data (i32.const 16) "empty payload")
+//	  (data (i32.const 32) "out of memory")
+//	  (func $alloc (export "alloc") (param $size i32) (result i32) (local $ptr i32)
+//	    (local.set $ptr (global.get $heap))
+//	    (global.set $heap (i32.add (global.get $heap) (local.get $size)))
+//	    (local.get $ptr))
+//	  (func $echo (param $fPort i32) (param $ptr i32) (param $len i32) (result i64)
+//	    (if (i32.eq (local.get $fPort) (i32.const 99))
+//	      (then (loop $forever (br $forever))))
+//	    (if (i32.eq (local.get $fPort) (i32.const 98))
+//	      (then
+//	        (if (i32.eq (memory.grow (i32.const 1000)) (i32.const -1))
+//	          (then (call $error (i32.const 32) (i32.const 13)) (return (i64.const 0))))))
+//	    (if (i32.eqz (local.get $len))
+//	      (then (call $error (i32.const 16) (i32.const 13)) (return (i64.const 0))))
+//	    (i64.or
+//	      (i64.shl (i64.extend_i32_u (local.get $ptr)) (i64.const 32))
+//	      (i64.extend_i32_u (local.get $len))))
+//	  (func (export "decode") (param i32 i32 i32) (result i64)
+//	    (call $log (i32.const 0) (i32.const 8))
+//	    (call $echo (local.get 0) (local.get 1) (local.get 2)))
+//	  (func (export "encode") (param i32 i32 i32) (result i64)
+//	    (call $echo (local.get 0) (local.get 1) (local.get 2))))
+var testWASMModule = []byte{
+	0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, 0x01, 0x12, 0x03, 0x60,
+	0x02, 0x7f, 0x7f, 0x00, 0x60, 0x01, 0x7f, 0x01, 0x7f, 0x60, 0x03, 0x7f,
+	0x7f, 0x7f, 0x01, 0x7e, 0x02, 0x17, 0x02, 0x03, 0x65, 0x6e, 0x76, 0x03,
+	0x6c, 0x6f, 0x67, 0x00, 0x00, 0x03, 0x65, 0x6e, 0x76, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x00, 0x00, 0x03, 0x05, 0x04, 0x01, 0x02, 0x02, 0x02,
+	0x05, 0x03, 0x01, 0x00, 0x01, 0x06, 0x07, 0x01, 0x7f, 0x01, 0x41, 0x80,
+	0x08, 0x0b, 0x07, 0x24, 0x04, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x02, 0x00, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x00, 0x02, 0x06, 0x64,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x00, 0x04, 0x06, 0x65, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x00, 0x05, 0x0a, 0x76, 0x04, 0x11, 0x01, 0x01, 0x7f, 0x23,
+	0x00, 0x21, 0x01, 0x23, 0x00, 0x20, 0x00, 0x6a, 0x24, 0x00, 0x20, 0x01,
+	0x0b, 0x46, 0x00, 0x20, 0x00, 0x41, 0xe3, 0x00, 0x46, 0x04, 0x40, 0x03,
+	0x40, 0x0c, 0x00, 0x0b, 0x0b, 0x20, 0x00, 0x41, 0xe2, 0x00, 0x46, 0x04,
+	0x40, 0x41, 0xe8, 0x07, 0x40, 0x00, 0x41, 0x7f, 0x46, 0x04, 0x40, 0x41,
+	0x20, 0x41, 0x0d, 0x10, 0x01, 0x42, 0x00, 0x0f, 0x0b, 0x0b, 0x20, 0x02,
+	0x45, 0x04, 0x40, 0x41, 0x10, 0x41, 0x0d, 0x10, 0x01, 0x42, 0x00, 0x0f,
+	0x0b, 0x20, 0x01, 0xad, 0x42, 0x20, 0x86, 0x20, 0x02, 0xad, 0x84, 0x0b,
+	0x10, 0x00, 0x41, 0x00, 0x41, 0x08, 0x10, 0x00, 0x20, 0x00, 0x20, 0x01,
+	0x20, 0x02, 0x10, 0x03, 0x0b, 0x0a, 0x00, 0x20, 0x00, 0x20, 0x01, 0x20,
+	0x02, 0x10, 0x03, 0x0b, 0x0b, 0x32, 0x03, 0x00, 0x41, 0x00, 0x0b, 0x08,
+	0x64, 0x65, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x00, 0x41, 0x10, 0x0b,
+	0x0d, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x00, 0x41, 0x20, 0x0b, 0x0d, 0x6f, 0x75, 0x74, 0x20, 0x6f,
+	0x66, 0x20, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+}
+
+func TestWASM(t *testing.T) {
+	setupWASM(100*time.Millisecond, 16*1024*1024, 10)
+
+	t.Run("Decode", func(t *testing.T) {
+		assert := require.New(t)
+
+		c := NewPayload(WASMType, 10, "", "", nil, testWASMModule)
+		assert.NoError(c.DecodeBytes([]byte(`{"temperature": 21.5, "counter": 12345678901234567}`)))
+		assert.Equal(map[string]interface{}{
+			"temperature": 21.5,
+			"counter":     float64(12345678901234567),
+		}, c.Object())
+		assert.Equal([]string{"decoding"}, c.(*WASM).Logs())
+	})
+
+	t.Run("Encode", func(t *testing.T) {
+		assert := require.New(t)
+
+		c := NewWASM(10, testWASMModule)
+		c.Data = map[string]interface{}{"led": true}
+		b, err := c.EncodeToBytes()
+		assert.NoError(err)
+		assert.Equal(`{"led":true}`, string(b))
+		assert.Len(c.Logs(), 0)
+	})
+
+	tests := []struct {
+		Name          string
+		FPort         uint8
+		Module        []byte
+		Data          []byte
+		ExpectedError string
+	}{
+		{
+			Name:          "decode non-object",
+			FPort:         10,
+			Module:        testWASMModule,
+			Data:          []byte(`[1, 2, 3]`),
+			ExpectedError: "function must return object",
+		},
+		{
+			Name:          "error called by module",
+			FPort:         10,
+			Module:        testWASMModule,
+			ExpectedError: "wasm error: empty payload",
+		},
+		{
+			Name:          "memory limit",
+			FPort:         98,
+			Module:        testWASMModule,
+			Data:          []byte(`{}`),
+			ExpectedError: "wasm error: out of memory",
+		},
+		{
+			Name:          "execution timeout",
+			FPort:         99,
+			Module:        testWASMModule,
+			Data:          []byte(`{}`),
+			ExpectedError: ErrExecutionTimeout.Error(),
+		},
+		{
+			Name:          "no module",
+			FPort:         10,
+			Data:          []byte(`{}`),
+			ExpectedError: "no wasm module configured",
+		},
+		{
+			Name:          "invalid module",
+			FPort:         10,
+			Module:        []byte{0x00, 0x61, 0x73, 0x6d},
+			Data:          []byte(`{}`),
+			ExpectedError: "compile wasm module error: invalid version header",
+		},
+		{
+			Name:          "module without exports",
+			FPort:         10,
+			Module:        []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00},
+			Data:          []byte(`{}`),
+			ExpectedError: "wasm module must export memory",
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			c := NewWASM(tst.FPort, tst.Module)
+			err := c.DecodeBytes(tst.Data)
+			assert.Error(err)
+			assert.Equal(tst.ExpectedError, err.Error())
+		})
+	}
+
+	t.Run("ValidateWASMModule", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.NoError(ValidateWASMModule(testWASMModule))
+		assert.Error(ValidateWASMModule([]byte{0x00}))
+	})
+
+	t.Run("Module cache", func(t *testing.T) {
+		assert := require.New(t)
+
+		setupWASM(0, 16*1024*1024, 1)
+		rt := acquireWASMRuntime()
+		defer rt.release()
+
+		assert.NoError(ValidateWASMModule(testWASMModule))
+		assert.NoError(ValidateWASMModule(testWASMModule))
+		assert.Equal(1, rt.modules.len())
+
+		c := NewWASM(10, testWASMModule)
+		assert.NoError(c.DecodeBytes([]byte(`{}`)))
+		assert.Equal(1, rt.modules.len())
+	})
+
+	t.Run("Module cache concurrent use", func(t *testing.T) {
+		assert := require.New(t)
+
+		setupWASM(0, 16*1024*1024, 10)
+
+		var wg sync.WaitGroup
+		errs := make(chan error, 10)
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c := NewWASM(10, testWASMModule)
+				errs <- c.DecodeBytes([]byte(`{}`))
+			}()
+		}
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			assert.NoError(err)
+		}
+
+		c := NewWASM(10, testWASMModule)
+		assert.NoError(c.DecodeBytes([]byte(`{}`)))
+	})
+
+	t.Run("Module cache eviction", func(t *testing.T) {
+		assert := require.New(t)
+
+		setupWASM(0, 16*1024*1024, 1)
+		rt := acquireWASMRuntime()
+		defer rt.release()
+
+		// a second (valid) module, with a custom section appended
+		other := append(append([]byte(nil), testWASMModule...), 0x00, 0x03, 0x01, 0x78, 0x00)
+
+		compiled, release, err := rt.modules.get(testWASMModule)
+		assert.NoError(err)
+
+		// evict the module while it is still referenced
+		_, releaseOther, err := rt.modules.get(other)
+		assert.NoError(err)
+		releaseOther()
+		assert.Equal(1, rt.modules.len())
+
+		// the evicted module is still usable and is returned again
+		compiled2, release2, err := rt.modules.get(testWASMModule)
+		assert.NoError(err)
+		assert.True(compiled == compiled2)
+		release()
+		release2()
+
+		c := NewWASM(10, testWASMModule)
+		assert.NoError(c.DecodeBytes([]byte(`{}`)))
+		c = NewWASM(10, other)
+		assert.NoError(c.DecodeBytes([]byte(`{}`)))
+		c = NewWASM(10, testWASMModule)
+		assert.NoError(c.DecodeBytes([]byte(`{}`)))
+	})
+}
@@ -33,9 +33,28 @@ type Config struct {
 		Codec struct {
 			JS struct {
 				MaxExecutionTime time.Duration `mapstructure:"max_execution_time"`
+				MaxMemory        int64         `mapstructure:"max_memory"`
+				ProgramCacheSize int           `mapstructure:"program_cache_size"`
+				MaxStateSize     int           `mapstructure:"max_state_size"`
 			} `mapstructure:"js"`
+
+			WASM struct {
+				MaxExecutionTime time.Duration `mapstructure:"max_execution_time"`
+				MaxMemory        int64         `mapstructure:"max_memory"`
+				ModuleCacheSize  int           `mapstructure:"module_cache_size"`
+			} `mapstructure:"wasm"`
 		} `mapstructure:"codec"`
 
+		DeviceStatus struct {
+			HistoryDays int `mapstructure:"history_days"`
+		} `mapstructure:"device_status"`
+
+		EventStream struct {
+			Enabled     bool          `mapstructure:"enabled"`
+			BacklogSize int           `mapstructure:"backlog_size"`
+			BacklogTTL  time.Duration `mapstructure:"backlog_ttl"`
+		} `mapstructure:"event_stream"`
+
 		Integration struct {
 			Backend         string                 `mapstructure:"backend"` // deprecated
 			Enabled         []string               `mapstructure:"enabled"`
@@ -2,7 +2,6 @@ package downlink
 
 import (
 	"encoding/json"
-	"fmt"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
@@ -18,28 +17,39 @@ import (
 	"github.com/brocaar/lorawan"
 )
 
+// ErrDeviceNotInApplication is returned when the device of the downlink
+// payload does not belong to the given application.
+var ErrDeviceNotInApplication = errors.New("enqueue downlink payload: device does not exist for given application")
+
 // HandleDataDownPayloads handles received downlink payloads to be emitted to the
 // devices.
 func HandleDataDownPayloads() {
 	for pl := range integration.Integration().DataDownChan() {
 		go func(pl integration.DataDownPayload) {
-			if err := handleDataDownPayload(pl); err != nil {
+			err := HandleDataDownPayload(pl)
+			if err != nil {
 				log.WithFields(log.Fields{
 					"dev_eui":        pl.DevEUI,
 					"application_id": pl.ApplicationID,
 				}).Errorf("handle data-down payload error: %s", err)
 			}
+
+			if pl.Result != nil {
+				pl.Result <- err
+			}
 		}(pl)
 	}
 }
 
-func handleDataDownPayload(pl integration.DataDownPayload) error {
+// HandleDataDownPayload handles a single downlink payload. In case the Object
+// is set, it is encoded to bytes using the application codec.
+func HandleDataDownPayload(pl integration.DataDownPayload) error {
 	return storage.Transaction(func(tx sqlx.Ext) error {
 		// lock the device so that a concurrent Enqueue action will block
 		// until this transaction has been completed
 		d, err := storage.GetDevice(tx, pl.DevEUI, true, true)
 		if err != nil {
-			return fmt.Errorf("get device error: %s", err)
+			return errors.Wrap(err, "get device error")
 		}
 
 		// Validate that the ApplicationID matches the actual DevEUI.
@@ -47,32 +57,65 @@ func handleDataDownPayload(pl integration.DataDownPayload) error {
 		// where it is unknown if the given ApplicationID matches the given
 		// DevEUI.
 		if d.ApplicationID != pl.ApplicationID {
-			return errors.New("enqueue downlink payload: device does not exist for given application")
+			return ErrDeviceNotInApplication
 		}
 
-		// if Object is set, try to encode it to bytes using the application codec
+		// if Object is set, try to encode it to bytes using the device-profile
+		// or application codec
 		if pl.Object != nil && string(pl.Object) != "null" {
 			app, err := storage.GetApplication(tx, d.ApplicationID)
 			if err != nil {
 				return errors.Wrap(err, "get application error")
 			}
 
-			// get the codec payload configured for the application
-			codecPL := codec.NewPayload(app.PayloadCodec, pl.FPort, app.PayloadEncoderScript, app.PayloadDecoderScript)
+			dp, err := storage.GetDeviceProfile(tx, d.DeviceProfileID, false, true)
+			if err != nil {
+				return errors.Wrap(err, "get device-profile error")
+			}
+
+			// TODO: in the next major release, remove this and always use the
+			// device-profile codec fields.
+			payloadCodec, err := storage.GetDeviceProfilePayloadCodec(tx, dp, pl.FPort)
+			if err != nil {
+				if errors.Cause(err) != storage.ErrDoesNotExist {
+					return errors.Wrap(err, "get device-profile payload codec error")
+				}
+
+				// the (published) codec version does not exist, the
+				// application codec is used instead
+				LogCodecError(app, d, errors.Wrap(err, "get device-profile payload codec error"))
+				payloadCodec = storage.CodecVersion{}
+			}
+			if payloadCodec.PayloadCodec == "" {
+				payloadCodec.PayloadCodec = app.PayloadCodec
+				payloadCodec.PayloadEncoderScript = app.PayloadEncoderScript
+				payloadCodec.PayloadDecoderScript = app.PayloadDecoderScript
+			}
+
+			// get the codec payload configured for the fPort
+			codecPL := codec.NewPayload(payloadCodec.PayloadCodec, pl.FPort, payloadCodec.PayloadEncoderScript, payloadCodec.PayloadDecoderScript, payloadCodec.DescriptorSet, payloadCodec.WASMModule)
 			if codecPL == nil {
-				logCodecError(app, d, errors.New("no or invalid codec configured for application"))
+				LogCodecError(app, d, errors.New("no or invalid codec configured for application"))
 				return errors.New("no or invalid codec configured for application")
 			}
 
+			if c, ok := codecPL.(interface{ SetContext(codec.Context) }); ok {
+				c.SetContext(codec.Context{
+					DevEUI:    d.DevEUI,
+					Variables: d.Variables,
+				})
+			}
+
 			err = json.Unmarshal(pl.Object, &codecPL)
 			if err != nil {
-				logCodecError(app, d, err)
+				LogCodecError(app, d, err)
 				return errors.Wrap(err, "unmarshal to codec payload error")
 			}
 
 			pl.Data, err = codecPL.EncodeToBytes()
+			LogCodecOutput(app, d, pl.FPort, codecPL)
 			if err != nil {
-				logCodecError(app, d, err)
+				LogCodecError(app, d, err)
 				return errors.Wrap(err, "marshal codec payload to binary error")
 			}
 		}
@@ -141,17 +184,20 @@ func EnqueueDownlinkPayload(db sqlx.Ext, devEUI lorawan.EUI64, confirmed bool, f
 	return resp.FCnt, nil
 }
 
-func logCodecError(a storage.Application, d storage.Device, err error) {
+// LogCodecError logs the given codec error as Error event and sends it as
+// CODEC error notification to the integrations.
+func LogCodecError(a storage.Application, d storage.Device, err error) {
 	errNotification := integration.ErrorNotification{
 		ApplicationID:   a.ID,
 		ApplicationName: a.Name,
 		DeviceName:      d.Name,
+		Variables:       d.Variables,
 		DevEUI:          d.DevEUI,
 		Type:            "CODEC",
 		Error:           err.Error(),
 	}
 
-	if err := eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
+	if err := eventlog.LogEventForDevice(a.ID, d.DevEUI, eventlog.EventLog{
 		Type:    eventlog.Error,
 		Payload: errNotification,
 	}); err != nil {
@@ -162,3 +208,27 @@ func logCodecError(a storage.Application, d storage.Device, err error) {
 		log.WithError(err).Error("send error notification to integration error")
 	}
 }
+
+// LogCodecOutput logs the lines written by the payload codec (using
+// console.log) as Log event.
+func LogCodecOutput(a storage.Application, d storage.Device, fPort uint8, codecPL codec.Payload) {
+	l, ok := codecPL.(interface{ Logs() []string })
+	if !ok || len(l.Logs()) == 0 {
+		return
+	}
+
+	if err := eventlog.LogEventForDevice(a.ID, d.DevEUI, eventlog.EventLog{
+		Type: eventlog.Log,
+		Payload: eventlog.CodecLog{
+			ApplicationID:   a.ID,
+			ApplicationName: a.Name,
+			DeviceName:      d.Name,
+			DevEUI:          d.DevEUI,
+			Function:        "Encode",
+			FPort:           fPort,
+			Lines:           l.Logs(),
+		},
+	}); err != nil {
+		log.WithError(err).Error("log event for device error")
+	}
+}
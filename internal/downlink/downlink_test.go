@@ -10,9 +10,10 @@ import (
 	. "github.com/smartystreets/goconvey/convey"
 
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
-	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	nsmock "github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
 	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/integration/mock"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lora-app-server/internal/test"
 	"github.com/brocaar/loraserver/api/ns"
@@ -28,11 +29,14 @@ func TestHandleDownlinkQueueItem(t *testing.T) {
 	Convey("Given a clean database an organization, application + node", t, func() {
 		test.MustResetDB(storage.DB().DB)
 
-		nsClient := mock.NewClient()
+		nsClient := nsmock.NewClient()
 		nsClient.GetNextDownlinkFCntForDevEUIResponse = ns.GetNextDownlinkFCntForDevEUIResponse{
 			FCnt: 12,
 		}
-		networkserver.SetPool(mock.NewPool(nsClient))
+		networkserver.SetPool(nsmock.NewPool(nsClient))
+
+		h := mock.New()
+		integration.SetIntegration(h)
 
 		org := storage.Organization{
 			Name: "test-org",
@@ -188,7 +192,7 @@ func TestHandleDownlinkQueueItem(t *testing.T) {
 					app.PayloadEncoderScript = test.PayloadEncoderScript
 					So(storage.UpdateApplication(storage.DB(), app), ShouldBeNil)
 
-					err := handleDataDownPayload(test.Payload)
+					err := HandleDataDownPayload(test.Payload)
 					if test.ExpectedError != nil {
 						So(err, ShouldNotBeNil)
 						So(err.Error(), ShouldEqual, test.ExpectedError.Error())
@@ -202,5 +206,55 @@ func TestHandleDownlinkQueueItem(t *testing.T) {
 				})
 			}
 		})
+
+		Convey("Given the device-profile uses a codec version which does not exist", func() {
+			app.PayloadCodec = codec.CustomJSType
+			app.PayloadEncoderScript = `
+				function Encode(fPort, obj) {
+					return obj.Bytes;
+				}
+			`
+			So(storage.UpdateApplication(storage.DB(), app), ShouldBeNil)
+
+			c := storage.Codec{
+				OrganizationID: &org.ID,
+				Name:           "test-codec",
+			}
+			So(storage.CreateCodec(storage.DB(), &c), ShouldBeNil)
+			So(storage.CreateCodecVersion(storage.DB(), &storage.CodecVersion{
+				CodecID:      c.ID,
+				PayloadCodec: codec.CayenneLPPType,
+				Published:    true,
+			}), ShouldBeNil)
+
+			dp.CodecID = &c.ID
+			So(storage.UpdateDeviceProfile(storage.DB(), &dp), ShouldBeNil)
+
+			// versions can not be removed through the API
+			_, err := storage.DB().Exec("delete from codec_version where codec_id = $1", c.ID)
+			So(err, ShouldBeNil)
+
+			Convey("Then the application codec is used and a CODEC error is sent", func() {
+				So(HandleDataDownPayload(integration.DataDownPayload{
+					ApplicationID: app.ID,
+					DevEUI:        device.DevEUI,
+					FPort:         2,
+					Object:        json.RawMessage(`{"Bytes": [1, 2, 3, 4]}`),
+				}), ShouldBeNil)
+
+				errNotification := <-h.SendErrorNotificationChan
+				So(errNotification.Type, ShouldEqual, "CODEC")
+				So(errNotification.DevEUI, ShouldEqual, device.DevEUI)
+
+				So(<-nsClient.CreateDeviceQueueItemChan, ShouldResemble, ns.CreateDeviceQueueItemRequest{
+					Item: &ns.DeviceQueueItem{
+						DevEui:     device.DevEUI[:],
+						FrmPayload: b,
+						FCnt:       12,
+						FPort:      2,
+					},
+				})
+			})
+		})
 	})
 }
@@ -16,6 +16,7 @@ import (
 
 const (
 	deviceEventUplinkPubSubKeyTempl = "lora:as:device:%s:pubsub:event"
+	applicationEventPubSubKeyTempl  = "lora:as:application:%d:pubsub:event"
 )
 
 // Event types.
@@ -26,6 +27,7 @@ const (
 	Error    = "error"
 	Status   = "status"
 	Location = "location"
+	Log      = "log"
 )
 
 // EventLog contains an event log.
@@ -34,18 +36,50 @@ type EventLog struct {
 	Payload interface{}
 }
 
-// LogEventForDevice logs an event for the given device.
-func LogEventForDevice(devEUI lorawan.EUI64, el EventLog) error {
+// CodecLog contains the lines written by the payload codec (using
+// console.log) during the decoding or encoding of a payload. It is logged
+// as Log event.
+type CodecLog struct {
+	ApplicationID   int64         `json:"applicationID,string"`
+	ApplicationName string        `json:"applicationName"`
+	DeviceName      string        `json:"deviceName"`
+	DevEUI          lorawan.EUI64 `json:"devEUI"`
+	Function        string        `json:"function"`
+	FPort           uint8         `json:"fPort"`
+	FCnt            uint32        `json:"fCnt,omitempty"`
+	Lines           []string      `json:"lines"`
+}
+
+// ApplicationEventLog contains an event log of a device within an
+// application.
+type ApplicationEventLog struct {
+	DevEUI lorawan.EUI64
+	EventLog
+}
+
+// LogEventForDevice logs an event for the given device. The event is also
+// published to the event log of the application the device belongs to.
+func LogEventForDevice(applicationID int64, devEUI lorawan.EUI64, el EventLog) error {
 	c := storage.RedisPool().Get()
 	defer c.Close()
 
-	key := fmt.Sprintf(deviceEventUplinkPubSubKeyTempl, devEUI)
 	b, err := json.Marshal(el)
 	if err != nil {
 		return errors.Wrap(err, "gob encode error")
 	}
 
-	if _, err := c.Do("PUBLISH", key, b); err != nil {
+	appB, err := json.Marshal(ApplicationEventLog{
+		DevEUI:   devEUI,
+		EventLog: el,
+	})
+	if err != nil {
+		return errors.Wrap(err, "gob encode error")
+	}
+
+	c.Send("MULTI")
+	c.Send("PUBLISH", fmt.Sprintf(deviceEventUplinkPubSubKeyTempl, devEUI), b)
+	c.Send("PUBLISH", fmt.Sprintf(applicationEventPubSubKeyTempl, applicationID), appB)
+	if _, err := c.Do("EXEC"); err != nil {
 		return errors.Wrap(err, "publish device event error")
 	}
 
@@ -55,10 +89,35 @@ func LogEventForDevice(devEUI lorawan.EUI64, el EventLog) error {
 // GetEventLogForDevice subscribes to the device events for the given DevEUI
 // and sends this to the given channel.
 func GetEventLogForDevice(ctx context.Context, devEUI lorawan.EUI64, eventsChan chan EventLog) error {
+	return subscribe(ctx, fmt.Sprintf(deviceEventUplinkPubSubKeyTempl, devEUI), func(msg redis.Message) {
+		el, err := redisMessageToEventLog(msg)
+		if err != nil {
+			log.WithError(err).Error("decode message errror")
+		} else {
+			eventsChan <- el
+		}
+	})
+}
+
+// GetEventLogForApplication subscribes to the device events for the given
+// application ID and sends this to the given channel.
+func GetEventLogForApplication(ctx context.Context, applicationID int64, eventsChan chan ApplicationEventLog) error {
+	return subscribe(ctx, fmt.Sprintf(applicationEventPubSubKeyTempl, applicationID), func(msg redis.Message) {
+		var el ApplicationEventLog
+		if err := json.Unmarshal(msg.Data, &el); err != nil {
+			log.WithError(err).Error("decode message errror")
+		} else {
+			eventsChan <- el
+		}
+	})
+}
+
+// subscribe subscribes to the given key and calls f for each received
+// message until the given context is cancelled.
+func subscribe(ctx context.Context, key string, f func(redis.Message)) error {
 	c := storage.RedisPool().Get()
 	defer c.Close()
 
-	key := fmt.Sprintf(deviceEventUplinkPubSubKeyTempl, devEUI)
 	psc := redis.PubSubConn{Conn: c}
 	if err := psc.Subscribe(key); err != nil {
 		return errors.Wrap(err, "subscribe error")
@@ -70,12 +129,7 @@ func GetEventLogForDevice(ctx context.Context, devEUI lorawan.EUI64, eventsChan
 		for {
 			switch v := psc.Receive().(type) {
 			case redis.Message:
-				el, err := redisMessageToEventLog(v)
-				if err != nil {
-					log.WithError(err).Error("decode message errror")
-				} else {
-					eventsChan <- el
-				}
+				f(v)
 			case redis.Subscription:
 				if v.Count == 0 {
 					done <- nil
@@ -87,7 +141,6 @@ func GetEventLogForDevice(ctx context.Context, devEUI lorawan.EUI64, eventsChan
 			}
 		}
 	}()
-
 	// todo: make this a config value?
 	ticker := time.NewTicker(30 * time.Second)
 	defer ticker.Stop()
@@ -46,7 +46,7 @@ func TestEventLog(t *testing.T) {
 					},
 				}
 
-				So(LogEventForDevice(devEUI, el), ShouldBeNil)
+				So(LogEventForDevice(1, devEUI, el), ShouldBeNil)
 
 				Convey("Then the event has been logged", func() {
 					So(<-logChannel, ShouldResemble, EventLog{
@@ -58,5 +58,40 @@ func TestEventLog(t *testing.T) {
 				})
 			})
 		})
+
+		Convey("Testing GetEventLogForApplication", func() {
+			devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+			logChannel := make(chan ApplicationEventLog, 1)
+			ctx := context.Background()
+			cctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			go func() {
+				if err := GetEventLogForApplication(cctx, 1, logChannel); err != nil {
+					log.Fatal(err)
+				}
+			}()
+
+			// some time to subscribe
+			time.Sleep(time.Millisecond * 100)
+
+			Convey("When calling LogEventForDevice", func() {
+				el := EventLog{
+					Type: Uplink,
+					Payload: map[string]interface{}{
+						"foo": "bar",
+					},
+				}
+
+				So(LogEventForDevice(1, devEUI, el), ShouldBeNil)
+
+				Convey("Then the event has been logged for the application", func() {
+					So(<-logChannel, ShouldResemble, ApplicationEventLog{
+						DevEUI:   devEUI,
+						EventLog: el,
+					})
+				})
+			})
+		})
 	})
 }
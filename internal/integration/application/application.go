@@ -3,10 +3,16 @@ package application
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"sync"
+	"time"
 
+	"github.com/gomodule/redigo/redis"
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 
 	"github.com/brocaar/lora-app-server/internal/integration"
 	"github.com/brocaar/lora-app-server/internal/integration/http"
@@ -15,78 +21,108 @@ import (
 	"github.com/brocaar/lora-app-server/internal/storage"
 )
 
+const (
+	cacheInvalidatePubSubKey = "lora:as:application:integration:pubsub:invalidate"
+
+	// subscriptionPingInterval defines the interval in which the Redis
+	// connection of the invalidation subscription is checked.
+	subscriptionPingInterval = 30 * time.Second
+)
+
 // Integration implements the application integration wrapper.
-// Per request it will fetch the application integrations and forward the
-// request to these integrations.
-type Integration struct{}
+// It forwards each request to the integrations configured for the
+// application. The integrations are built on first use and cached per
+// application until they are invalidated (see InvalidateCache).
+type Integration struct {
+	sync.RWMutex
+	cache map[int64]*cacheItem
+
+	// generation is incremented on every invalidation, so that integrations
+	// built from a configuration read before the invalidation are not cached.
+	generation uint64
+
+	// closing tracks the removed integrations which are closed once the
+	// requests still using them have returned.
+	closing sync.WaitGroup
+}
+
+// cacheItem holds a cached application integration. The requests using the
+// integration are tracked, so that an integration removed from the cache
+// is only closed after the last request using it has returned.
+type cacheItem struct {
+	integrator integration.Integrator
+	users      sync.WaitGroup
+}
 
 // New creates a new application integration.
 func New() *Integration {
-	return &Integration{}
+	return &Integration{
+		cache: make(map[int64]*cacheItem),
+	}
 }
 
 // SendDataUp sends an uplink payload.
 func (i *Integration) SendDataUp(pl integration.DataUpPayload) error {
-	multi, err := i.getApplicationIntegration(pl.ApplicationID)
+	multi, release, err := i.getApplicationIntegration(pl.ApplicationID)
 	if err != nil {
 		return errors.Wrap(err, "get application integration error")
 	}
-	defer multi.Close()
+	defer release()
 
 	return multi.SendDataUp(pl)
 }
 
 // SendJoinNotification sends a join notification.
 func (i *Integration) SendJoinNotification(pl integration.JoinNotification) error {
-	multi, err := i.getApplicationIntegration(pl.ApplicationID)
+	multi, release, err := i.getApplicationIntegration(pl.ApplicationID)
 	if err != nil {
 		return errors.Wrap(err, "get appplication integration error")
 	}
-	defer multi.Close()
+	defer release()
 
 	return multi.SendJoinNotification(pl)
 }
 
 // SendACKNotification sends an ACK notification.
 func (i *Integration) SendACKNotification(pl integration.ACKNotification) error {
-	multi, err := i.getApplicationIntegration(pl.ApplicationID)
+	multi, release, err := i.getApplicationIntegration(pl.ApplicationID)
 	if err != nil {
 		return errors.Wrap(err, "get appplication integration error")
 	}
-	defer multi.Close()
+	defer release()
 
 	return multi.SendACKNotification(pl)
 }
 
 // SendErrorNotification sends an error notification.
 func (i *Integration) SendErrorNotification(pl integration.ErrorNotification) error {
-	multi, err := i.getApplicationIntegration(pl.ApplicationID)
+	multi, release, err := i.getApplicationIntegration(pl.ApplicationID)
 	if err != nil {
 		return errors.Wrap(err, "get appplication integration error")
 	}
-	defer multi.Close()
+	defer release()
 
 	return multi.SendErrorNotification(pl)
 }
 
 // SendStatusNotification sends a status notification.
 func (i *Integration) SendStatusNotification(pl integration.StatusNotification) error {
-	multi, err := i.getApplicationIntegration(pl.ApplicationID)
+	multi, release, err := i.getApplicationIntegration(pl.ApplicationID)
 	if err != nil {
 		return errors.Wrap(err, "get appplication integration error")
 	}
-	defer multi.Close()
+	defer release()
 
 	return multi.SendStatusNotification(pl)
 }
 
 // SendLocationNotification sends a location notification.
 func (i *Integration) SendLocationNotification(pl integration.LocationNotification) error {
-	multi, err := i.getApplicationIntegration(pl.ApplicationID)
+	multi, release, err := i.getApplicationIntegration(pl.ApplicationID)
 	if err != nil {
 		return errors.Wrap(err, "get appplication integration error")
 	}
-	defer multi.Close()
+	defer release()
 
 	return multi.SendLocationNotification(pl)
 }
@@ -96,12 +132,214 @@ func (i *Integration) DataDownChan() chan integration.DataDownPayload {
 	return nil
 }
 
-// Close closes the integration.
+// Close closes the integration and all cached application integrations.
+// It blocks until the requests using these integrations have returned.
 func (i *Integration) Close() error {
+	i.Lock()
+	cache := i.cache
+	i.cache = make(map[int64]*cacheItem)
+	i.generation++
+	i.Unlock()
+
+	// wait for the integrations removed by invalidations
+	i.closing.Wait()
+
+	for _, item := range cache {
+		item.users.Wait()
+		if err := item.integrator.Close(); err != nil {
+			return errors.Wrap(err, "close integration error")
+		}
+	}
+
+	return nil
+}
+
+// InvalidateCache publishes a cache invalidation for the integrations of the
+// given application id. Every application-server instance subscribed
+// through HandleCacheInvalidation (including this one) will drop its cached
+// integrations for the application, so that the next request re-reads
+// the integration configuration from the database.
+func InvalidateCache(applicationID int64) error {
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	if _, err := c.Do("PUBLISH", cacheInvalidatePubSubKey, applicationID); err != nil {
+		return errors.Wrap(err, "publish cache invalidation error")
+	}
+
 	return nil
 }
 
-func (i *Integration) getApplicationIntegration(id int64) (integration.Integrator, error) {
+// HandleCacheInvalidationLoop is a never returning function handling the
+// cache invalidations. In case of a subscription error, it will re-subscribe.
+func (i *Integration) HandleCacheInvalidationLoop() {
+	for {
+		if err := i.HandleCacheInvalidation(context.Background()); err != nil {
+			log.WithError(err).Error("integration/application: handle cache invalidation error")
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+// HandleCacheInvalidation subscribes to the cache invalidations and drops
+// the cached integrations for the published application ids until the
+// given context is cancelled. As invalidations could have been missed while
+// not being subscribed, the complete cache is flushed on subscribe.
+func (i *Integration) HandleCacheInvalidation(ctx context.Context) error {
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	psc := redis.PubSubConn{Conn: c}
+	if err := psc.Subscribe(cacheInvalidatePubSubKey); err != nil {
+		return errors.Wrap(err, "subscribe error")
+	}
+
+	done := make(chan error, 1)
+
+	go func() {
+		for {
+			switch v := psc.Receive().(type) {
+			case redis.Message:
+				id, err := strconv.ParseInt(string(v.Data), 10, 64)
+				if err != nil {
+					log.WithError(err).Error("integration/application: decode cache invalidation error")
+					continue
+				}
+				i.invalidate(id)
+			case redis.Subscription:
+				if v.Count == 0 {
+					done <- nil
+					return
+				}
+				i.flush()
+			case error:
+				done <- v
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(subscriptionPingInterval)
+	defer ticker.Stop()
+
+loop:
+	for {
+		select {
+		case <-ticker.C:
+			if err := psc.Ping(""); err != nil {
+				log.WithError(err).Error("integration/application: subscription ping error")
+				break loop
+			}
+		case <-ctx.Done():
+			break loop
+		case err := <-done:
+			return err
+		}
+	}
+
+	if err := psc.Unsubscribe(); err != nil {
+		return errors.Wrap(err, "unsubscribe error")
+	}
+
+	return <-done
+}
+
+// invalidate removes the cached integrations for the given application id.
+// They are closed once the requests using them have returned.
+func (i *Integration) invalidate(id int64) {
+	i.Lock()
+	item, ok := i.cache[id]
+	delete(i.cache, id)
+	i.generation++
+	i.Unlock()
+
+	if ok {
+		i.close(id, item)
+	}
+
+	log.WithField("application_id", id).Debug("integration/application: integration cache invalidated")
+}
+
+// flush removes all the cached integrations. They are closed once the
+// requests using them have returned.
+func (i *Integration) flush() {
+	i.Lock()
+	cache := i.cache
+	i.cache = make(map[int64]*cacheItem)
+	i.generation++
+	i.Unlock()
+
+	for id, item := range cache {
+		i.close(id, item)
+	}
+}
+
+// close closes the given integration, removed from the cache, in the
+// background after the last request using it has returned.
+func (i *Integration) close(id int64, item *cacheItem) {
+	i.closing.Add(1)
+	go func() {
+		defer i.closing.Done()
+
+		item.users.Wait()
+		if err := item.integrator.Close(); err != nil {
+			log.WithError(err).WithField("application_id", id).Error("integration/application: close integration error")
+		}
+	}()
+}
+
+// getApplicationIntegration returns the (cached) integration for the given
+// application id. The returned function must be called once the integration
+// is no longer used.
+func (i *Integration) getApplicationIntegration(id int64) (integration.Integrator, func(), error) {
+	for {
+		// the user is added while holding the lock, so that an invalidation
+		// removing the item always sees it
+		i.RLock()
+		item, ok := i.cache[id]
+		if ok {
+			item.users.Add(1)
+		}
+		generation := i.generation
+		i.RUnlock()
+		if ok {
+			return item.integrator, item.users.Done, nil
+		}
+
+		ii, err := newApplicationIntegration(id)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		i.Lock()
+		cached, ok := i.cache[id]
+		if !ok && generation == i.generation {
+			item = &cacheItem{integrator: ii}
+			item.users.Add(1)
+			i.cache[id] = item
+			i.Unlock()
+			return ii, item.users.Done, nil
+		}
+		if ok {
+			cached.users.Add(1)
+		}
+		i.Unlock()
+
+		// an other request has built the integration in the meantime or the
+		// cache has been invalidated while building the integration (the
+		// configuration might be outdated), in both cases the integration
+		// is not cached and must be closed before retrying
+		if err := ii.Close(); err != nil {
+			log.WithError(err).WithField("application_id", id).Error("integration/application: close integration error")
+		}
+
+		if ok {
+			return cached.integrator, cached.users.Done, nil
+		}
+	}
+}
+
+func newApplicationIntegration(id int64) (integration.Integrator, error) {
 	var configs []interface{}
 
 	// read integrations
@@ -130,5 +368,5 @@ func (i *Integration) getApplicationIntegration(id int64) (integration.Integrato
 		}
 	}
 
-	return multi.New(configs)
+	return multi.NewForApplication(id, configs)
 }
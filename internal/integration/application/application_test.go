@@ -2,16 +2,20 @@ package application
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
 	"github.com/brocaar/lora-app-server/internal/integration"
 	httpint "github.com/brocaar/lora-app-server/internal/integration/http"
+	intmock "github.com/brocaar/lora-app-server/internal/integration/mock"
+	"github.com/brocaar/lora-app-server/internal/integration/multi"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lora-app-server/internal/test"
 	"github.com/brocaar/lorawan"
@@ -31,12 +35,24 @@ func (h *testHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// closeRecorder is a mock integration which records that it has been closed.
+type closeRecorder struct {
+	*intmock.Integration
+	closed chan struct{}
+}
+
+func (c *closeRecorder) Close() error {
+	close(c.closed)
+	return nil
+}
+
 type ApplicationTestSuite struct {
 	suite.Suite
 
 	httpServer   *httptest.Server
 	httpRequests chan *http.Request
-	integration  integration.Integrator
+	integration  *Integration
+	app          storage.Application
 }
 
 func (ts *ApplicationTestSuite) SetupSuite() {
@@ -78,12 +94,12 @@ func (ts *ApplicationTestSuite) SetupSuite() {
 	spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
 	assert.NoError(err)
 
-	app := storage.Application{
+	ts.app = storage.Application{
 		OrganizationID:   org.ID,
 		Name:             "test-app",
 		ServiceProfileID: spID,
 	}
-	assert.NoError(storage.CreateApplication(storage.DB(), &app))
+	assert.NoError(storage.CreateApplication(storage.DB(), &ts.app))
 
 	httpConfig := httpint.Config{
 		DataUpURL:               ts.httpServer.URL + "/rx",
@@ -97,7 +113,7 @@ func (ts *ApplicationTestSuite) SetupSuite() {
 	assert.NoError(err)
 
 	assert.NoError(storage.CreateIntegration(storage.DB(), &storage.Integration{
-		ApplicationID: app.ID,
+		ApplicationID: ts.app.ID,
 		Kind:          integration.HTTP,
 		Settings:      configJSON,
 	}))
@@ -174,6 +190,112 @@ func (ts *ApplicationTestSuite) TestLocationNotification() {
 	assert.Equal("/location", req.URL.Path)
 }
 
+func (ts *ApplicationTestSuite) TestCacheInvalidation() {
+	assert := require.New(ts.T())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- ts.integration.HandleCacheInvalidation(ctx)
+	}()
+
+	// wait for the subscription
+	time.Sleep(100 * time.Millisecond)
+
+	assert.NoError(ts.integration.SendDataUp(integration.DataUpPayload{
+		ApplicationID: ts.app.ID,
+		DevEUI:        lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+	}))
+	req := <-ts.httpRequests
+	assert.Equal("/rx", req.URL.Path)
+
+	ts.integration.RLock()
+	assert.Len(ts.integration.cache, 1)
+	ts.integration.RUnlock()
+
+	appInt, err := storage.GetIntegrationByApplicationID(storage.DB(), ts.app.ID, integration.HTTP)
+	assert.NoError(err)
+	settings := appInt.Settings
+	appInt.Settings, err = json.Marshal(httpint.Config{
+		DataUpURL: ts.httpServer.URL + "/rx2",
+	})
+	assert.NoError(err)
+	assert.NoError(storage.UpdateIntegration(storage.DB(), &appInt))
+
+	ts.T().Run("Without invalidation the cached integration is used", func(t *testing.T) {
+		assert := require.New(t)
+		assert.NoError(ts.integration.SendDataUp(integration.DataUpPayload{
+			ApplicationID: ts.app.ID,
+			DevEUI:        lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		}))
+		req := <-ts.httpRequests
+		assert.Equal("/rx", req.URL.Path)
+	})
+
+	ts.T().Run("After invalidation the integration is rebuilt", func(t *testing.T) {
+		assert := require.New(t)
+		assert.NoError(InvalidateCache(ts.app.ID))
+		time.Sleep(100 * time.Millisecond)
+
+		ts.integration.RLock()
+		assert.Len(ts.integration.cache, 0)
+		ts.integration.RUnlock()
+
+		assert.NoError(ts.integration.SendDataUp(integration.DataUpPayload{
+			ApplicationID: ts.app.ID,
+			DevEUI:        lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		}))
+		req := <-ts.httpRequests
+		assert.Equal("/rx2", req.URL.Path)
+	})
+
+	// restore the original configuration for the other tests
+	appInt.Settings = settings
+	assert.NoError(storage.UpdateIntegration(storage.DB(), &appInt))
+	ts.integration.flush()
+
+	cancel()
+	assert.NoError(<-done)
+}
+
+func TestInvalidateDuringSend(t *testing.T) {
+	assert := require.New(t)
+
+	// the unbuffered channel blocks the send until it is read
+	mockInt := &closeRecorder{
+		Integration: intmock.New(),
+		closed:      make(chan struct{}),
+	}
+	mockInt.SendDataUpChan = make(chan integration.DataUpPayload)
+
+	multiInt, err := multi.New(nil)
+	assert.NoError(err)
+	multiInt.Add(mockInt)
+
+	i := New()
+	i.cache[1] = &cacheItem{integrator: multiInt}
+
+	assert.NoError(i.SendDataUp(integration.DataUpPayload{ApplicationID: 1}))
+	i.invalidate(1)
+
+	select {
+	case <-mockInt.closed:
+		t.Fatal("integration closed while sending")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	pl := <-mockInt.SendDataUpChan
+	assert.EqualValues(1, pl.ApplicationID)
+
+	select {
+	case <-mockInt.closed:
+	case <-time.After(time.Second):
+		t.Fatal("integration not closed after sending")
+	}
+
+	assert.NoError(i.Close())
+}
+
 func TestApplication(t *testing.T) {
 	suite.Run(t, new(ApplicationTestSuite))
 }
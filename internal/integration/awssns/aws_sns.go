@@ -2,7 +2,8 @@
 package awssns
 
 import (
-	"encoding/json"
+	"encoding/base64"
+	"fmt"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -13,27 +14,37 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/integration/marshaler"
 	"github.com/brocaar/lorawan"
 )
 
 // Config holds the AWS SNS integration configuration.
 type Config struct {
-	AWSRegion          string `mapstructure:"aws_region"`
-	AWSAccessKeyID     string `mapstructure:"aws_access_key_id"`
-	AWSSecretAccessKey string `mapstructure:"aws_secret_access_key"`
-	TopicARN           string `mapstructure:"topic_arn"`
+	AWSRegion          string         `mapstructure:"aws_region"`
+	AWSAccessKeyID     string         `mapstructure:"aws_access_key_id"`
+	AWSSecretAccessKey string         `mapstructure:"aws_secret_access_key"`
+	TopicARN           string         `mapstructure:"topic_arn"`
+	Marshaler          marshaler.Type `mapstructure:"marshaler"`
 }
 
 // Integration implements the AWS SNS integration.
 type Integration struct {
-	sns      *sns.SNS
-	topicARN string
+	sns       *sns.SNS
+	topicARN  string
+	marshaler marshaler.Type
 }
 
 // New creates a new AWS SNS integration.
 func New(conf Config) (*Integration, error) {
 	i := Integration{
-		topicARN: conf.TopicARN,
+		topicARN:  conf.TopicARN,
+		marshaler: conf.Marshaler,
+	}
+
+	switch i.marshaler {
+	case "", marshaler.JSON, marshaler.Protobuf, marshaler.CloudEvents:
+	default:
+		return nil, fmt.Errorf("marshaler %s is not supported by the aws sns integration", i.marshaler)
 	}
 
 	log.Info("integration/awssns: setting up session")
@@ -59,32 +70,32 @@ func New(conf Config) (*Integration, error) {
 
 // SendDataUp sends an uplink data payload.
 func (i *Integration) SendDataUp(pl integration.DataUpPayload) error {
-	return i.publish("up", pl.ApplicationID, pl.DevEUI, pl)
+	return i.publish(marshaler.Uplink, pl.ApplicationID, pl.DevEUI, pl)
 }
 
 // SendJoinNotification sends a join notification.
 func (i *Integration) SendJoinNotification(pl integration.JoinNotification) error {
-	return i.publish("join", pl.ApplicationID, pl.DevEUI, pl)
+	return i.publish(marshaler.Join, pl.ApplicationID, pl.DevEUI, pl)
 }
 
 // SendACKNotification sends an ack notification.
 func (i *Integration) SendACKNotification(pl integration.ACKNotification) error {
-	return i.publish("ack", pl.ApplicationID, pl.DevEUI, pl)
+	return i.publish(marshaler.ACK, pl.ApplicationID, pl.DevEUI, pl)
 }
 
 // SendErrorNotification sends an error notification.
 func (i *Integration) SendErrorNotification(pl integration.ErrorNotification) error {
-	return i.publish("error", pl.ApplicationID, pl.DevEUI, pl)
+	return i.publish(marshaler.Error, pl.ApplicationID, pl.DevEUI, pl)
 }
 
 // SendStatusNotification sends a status notification.
 func (i *Integration) SendStatusNotification(pl integration.StatusNotification) error {
-	return i.publish("status", pl.ApplicationID, pl.DevEUI, pl)
+	return i.publish(marshaler.Status, pl.ApplicationID, pl.DevEUI, pl)
 }
 
 // SendLocationNotification sends a location notification.
 func (i *Integration) SendLocationNotification(pl integration.LocationNotification) error {
-	return i.publish("location", pl.ApplicationID, pl.DevEUI, pl)
+	return i.publish(marshaler.Location, pl.ApplicationID, pl.DevEUI, pl)
 }
 
 // DataDownChan return nil.
@@ -98,13 +109,20 @@ func (i *Integration) Close() error {
 }
 
 func (i *Integration) publish(event string, applicationID int64, devEUI lorawan.EUI64, v interface{}) error {
-	jsonB, err := json.Marshal(v)
+	msg, err := marshaler.Marshal(i.marshaler, event, v)
 	if err != nil {
-		return errors.Wrap(err, "marshal json error")
+		return errors.Wrap(err, "marshal payload error")
+	}
+
+	// SNS messages must be valid UTF-8, therefore binary payloads are
+	// base64 encoded
+	message := string(msg.Data)
+	if i.marshaler == marshaler.Protobuf {
+		message = base64.StdEncoding.EncodeToString(msg.Data)
 	}
 
 	_, err = i.sns.Publish(&sns.PublishInput{
-		Message: aws.String(string(jsonB)),
+		Message: aws.String(message),
 		MessageAttributes: map[string]*sns.MessageAttributeValue{
 			"event":          &sns.MessageAttributeValue{DataType: aws.String("String"), StringValue: aws.String(event)},
 			"dev_eui":        &sns.MessageAttributeValue{DataType: aws.String("String"), StringValue: aws.String(devEUI.String())},
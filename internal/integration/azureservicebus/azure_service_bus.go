@@ -5,12 +5,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
+	"time"
 
 	servicebus "github.com/Azure/azure-service-bus-go"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/integration/marshaler"
 	"github.com/brocaar/lorawan"
 )
 
@@ -25,9 +28,14 @@ const (
 
 // Config holds the Azure Service-Bus integration configuration.
 type Config struct {
-	ConnectionString string      `mapstructure:"connection_string"`
-	PublishMode      PublishMode `mapstructure:"publish_mode"`
-	PublishName      string      `mapstructure:"publish_name"`
+	ConnectionString string         `mapstructure:"connection_string"`
+	PublishMode      PublishMode    `mapstructure:"publish_mode"`
+	PublishName      string         `mapstructure:"publish_name"`
+	Marshaler        marshaler.Type `mapstructure:"marshaler"`
+
+	// DownlinkQueueName (optional) defines the queue from which downlink
+	// payloads are received.
+	DownlinkQueueName string `mapstructure:"downlink_queue_name"`
 }
 
 // Integration implements an Azure Service-Bus integration.
@@ -36,8 +44,13 @@ type Integration struct {
 	cancel      context.CancelFunc
 	ns          *servicebus.Namespace
 	publishName string
+	marshaler   marshaler.Type
 	topic       *servicebus.Topic
 	queue       *servicebus.Queue
+
+	downlinkQueue *servicebus.Queue
+	dataDownChan  chan integration.DataDownPayload
+	wg            sync.WaitGroup
 }
 
 // New creates a new Azure Service-Bus integration.
@@ -47,9 +60,14 @@ func New(conf Config) (*Integration, error) {
 	i := Integration{
 		ctx:         context.Background(),
 		publishName: conf.PublishName,
+		marshaler:   conf.Marshaler,
 	}
 	i.ctx, i.cancel = context.WithCancel(i.ctx)
 
+	if err := conf.Marshaler.Validate(); err != nil {
+		return nil, err
+	}
+
 	log.Info("integration/azureservicebus: setting up namespace")
 	i.ns, err = servicebus.NewNamespace(servicebus.NamespaceWithConnectionString(conf.ConnectionString))
 	if err != nil {
@@ -69,42 +87,54 @@ func New(conf Config) (*Integration, error) {
 		return nil, fmt.Errorf("unknown publish_mode: %s", conf.PublishMode)
 	}
 
+	if conf.DownlinkQueueName != "" {
+		i.downlinkQueue, err = i.newQueue(conf.DownlinkQueueName)
+		if err != nil {
+			return nil, errors.Wrap(err, "set downlink queue client error")
+		}
+
+		i.dataDownChan = make(chan integration.DataDownPayload)
+		i.wg.Add(1)
+		go i.receiveLoop()
+	}
+
 	return &i, nil
 }
 
 // SendDataUp sends an uplink data payload.
 func (i *Integration) SendDataUp(pl integration.DataUpPayload) error {
-	return i.publish("up", pl.ApplicationID, pl.DevEUI, pl)
+	return i.publish(marshaler.Uplink, pl.ApplicationID, pl.DevEUI, pl)
 }
 
 // SendJoinNotification sends a join notification.
 func (i *Integration) SendJoinNotification(pl integration.JoinNotification) error {
-	return i.publish("join", pl.ApplicationID, pl.DevEUI, pl)
+	return i.publish(marshaler.Join, pl.ApplicationID, pl.DevEUI, pl)
 }
 
 // SendACKNotification sends an ack notification.
 func (i *Integration) SendACKNotification(pl integration.ACKNotification) error {
-	return i.publish("ack", pl.ApplicationID, pl.DevEUI, pl)
+	return i.publish(marshaler.ACK, pl.ApplicationID, pl.DevEUI, pl)
 }
 
 // SendErrorNotification sends an error notification.
 func (i *Integration) SendErrorNotification(pl integration.ErrorNotification) error {
-	return i.publish("error", pl.ApplicationID, pl.DevEUI, pl)
+	return i.publish(marshaler.Error, pl.ApplicationID, pl.DevEUI, pl)
 }
 
 // SendStatusNotification sends a status notification.
 func (i *Integration) SendStatusNotification(pl integration.StatusNotification) error {
-	return i.publish("status", pl.ApplicationID, pl.DevEUI, pl)
+	return i.publish(marshaler.Status, pl.ApplicationID, pl.DevEUI, pl)
 }
 
 // SendLocationNotification sends a location notification.
 func (i *Integration) SendLocationNotification(pl integration.LocationNotification) error {
-	return i.publish("location", pl.ApplicationID, pl.DevEUI, pl)
+	return i.publish(marshaler.Location, pl.ApplicationID, pl.DevEUI, pl)
 }
 
-// DataDownChan return nil.
+// DataDownChan returns the channel containing the received DataDownPayload.
+// It returns nil when no downlink queue has been configured.
 func (i *Integration) DataDownChan() chan integration.DataDownPayload {
-	return nil
+	return i.dataDownChan
 }
 
 func (i *Integration) setTopicClient() error {
@@ -133,33 +163,110 @@ func (i *Integration) setTopicClient() error {
 }
 
 func (i *Integration) setQueueClient() error {
+	var err error
+	i.queue, err = i.newQueue(i.publishName)
+	return err
+}
+
+// newQueue returns a client for the given queue. The queue is created when
+// it does not exist.
+func (i *Integration) newQueue(name string) (*servicebus.Queue, error) {
 	qm := i.ns.NewQueueManager()
 
-	log.WithField("queue", i.publishName).Info("integration/azureservicebus: testing if queue exists")
-	q, err := qm.Get(i.ctx, i.publishName)
+	log.WithField("queue", name).Info("integration/azureservicebus: testing if queue exists")
+	q, err := qm.Get(i.ctx, name)
 	if err != nil {
-		return errors.Wrap(err, "get queue error")
+		return nil, errors.Wrap(err, "get queue error")
 	}
 
 	if q == nil {
-		log.WithField("queue", i.publishName).Info("integration/azureservicebus: queue does not exist, creating it")
-		_, err := qm.Put(i.ctx, i.publishName)
+		log.WithField("queue", name).Info("integration/azureservicebus: queue does not exist, creating it")
+		_, err := qm.Put(i.ctx, name)
 		if err != nil {
-			return errors.Wrap(err, "create queue error")
+			return nil, errors.Wrap(err, "create queue error")
 		}
 	}
 
-	i.queue, err = i.ns.NewQueue(i.publishName)
+	queue, err := i.ns.NewQueue(name)
 	if err != nil {
-		return errors.Wrap(err, "new queue error")
+		return nil, errors.Wrap(err, "new queue error")
+	}
+
+	return queue, nil
+}
+
+// receiveLoop receives the downlink payloads from the downlink queue until
+// the integration is closed.
+func (i *Integration) receiveLoop() {
+	defer i.wg.Done()
+
+	for {
+		err := i.downlinkQueue.Receive(i.ctx, servicebus.HandlerFunc(i.handleDataDown))
+		if i.ctx.Err() != nil {
+			return
+		}
+
+		log.WithError(err).Error("integration/azureservicebus: receive error")
+
+		select {
+		case <-time.After(time.Second):
+		case <-i.ctx.Done():
+			return
+		}
+	}
+}
+
+// handleDataDown handles a received downlink payload. The message is
+// completed when the payload has been enqueued, else it is abandoned so that
+// it will be redelivered. Messages that can not be decoded are
+// dead-lettered. It always returns nil as an error would terminate the
+// receive loop.
+func (i *Integration) handleDataDown(ctx context.Context, msg *servicebus.Message) error {
+	var pl integration.DataDownPayload
+	if err := json.Unmarshal(msg.Data, &pl); err != nil {
+		log.WithError(err).WithField("message_id", msg.ID).Error("integration/azureservicebus: unmarshal downlink payload error")
+		if err := msg.DeadLetter(ctx, err); err != nil {
+			log.WithError(err).Error("integration/azureservicebus: dead-letter message error")
+		}
+		return nil
+	}
+
+	if err := integration.SendDataDown(ctx, i.dataDownChan, pl); err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"message_id": msg.ID,
+			"dev_eui":    pl.DevEUI,
+		}).Error("integration/azureservicebus: handle downlink payload error")
+		if err := msg.Abandon(ctx); err != nil {
+			log.WithError(err).Error("integration/azureservicebus: abandon message error")
+		}
+		return nil
+	}
+
+	log.WithFields(log.Fields{
+		"message_id": msg.ID,
+		"dev_eui":    pl.DevEUI,
+	}).Info("integration/azureservicebus: downlink payload enqueued")
+
+	if err := msg.Complete(ctx); err != nil {
+		log.WithError(err).Error("integration/azureservicebus: complete message error")
 	}
 
 	return nil
 }
 
+// Close closes the integration.
 func (i *Integration) Close() error {
 	log.Info("integration/azureservicebus: closing integration")
 	i.cancel()
+
+	if i.downlinkQueue != nil {
+		i.wg.Wait()
+		close(i.dataDownChan)
+		if err := i.downlinkQueue.Close(context.Background()); err != nil {
+			log.WithError(err).Error("integration/azureservicebus: close downlink queue error")
+		}
+	}
+
 	if i.topic != nil {
 		return i.topic.Close(i.ctx)
 	}
@@ -170,20 +277,24 @@ func (i *Integration) Close() error {
 }
 
 func (i *Integration) publish(event string, applicationID int64, devEUI lorawan.EUI64, v interface{}) error {
-	jsonB, err := json.Marshal(v)
+	pl, err := marshaler.Marshal(i.marshaler, event, v)
 	if err != nil {
-		return errors.Wrap(err, "marshal json error")
+		return errors.Wrap(err, "marshal payload error")
 	}
 
 	msg := servicebus.Message{
-		ContentType: "application/json",
-		Data:        jsonB,
+		ContentType: pl.ContentType,
+		Data:        pl.Data,
 		UserProperties: map[string]interface{}{
 			"event":          event,
 			"application_id": applicationID,
 			"dev_eui":        devEUI.String(),
 		},
 	}
+	// see the CloudEvents AMQP protocol binding
+	for k, v := range pl.Headers {
+		msg.UserProperties["cloudEvents:"+k] = v
+	}
 
 	if i.queue != nil {
 		err = i.queue.Send(i.ctx, &msg)
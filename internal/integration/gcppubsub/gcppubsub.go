@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sync"
+	"time"
 
 	"cloud.google.com/go/pubsub"
 	"github.com/pkg/errors"
@@ -12,14 +13,20 @@ import (
 	"google.golang.org/api/option"
 
 	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/integration/marshaler"
 	"github.com/brocaar/lorawan"
 )
 
 // Config holds the GCP Pub/Sub integration configuration.
 type Config struct {
-	CredentialsFile string `mapstructure:"credentials_file"`
-	ProjectID       string `mapstructure:"project_id"`
-	TopicName       string `mapstructure:"topic_name"`
+	CredentialsFile string         `mapstructure:"credentials_file"`
+	ProjectID       string         `mapstructure:"project_id"`
+	TopicName       string         `mapstructure:"topic_name"`
+	Marshaler       marshaler.Type `mapstructure:"marshaler"`
+
+	// SubscriptionName (optional) defines the subscription from which
+	// downlink payloads are received.
+	SubscriptionName string `mapstructure:"subscription_name"`
 }
 
 // Integration implements a GCP Pub/Sub integration.
@@ -28,18 +35,28 @@ type Integration struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 
-	client *pubsub.Client
-	topic  *pubsub.Topic
+	client    *pubsub.Client
+	topic     *pubsub.Topic
+	marshaler marshaler.Type
+
+	subscription *pubsub.Subscription
+	dataDownChan chan integration.DataDownPayload
+	wg           sync.WaitGroup
 }
 
 // New creates a new Pub/Sub integration.
 func New(conf Config) (*Integration, error) {
 	i := Integration{
-		ctx: context.Background(),
+		ctx:       context.Background(),
+		marshaler: conf.Marshaler,
 	}
 	var err error
 	var o []option.ClientOption
 
+	if err := conf.Marshaler.Validate(); err != nil {
+		return nil, err
+	}
+
 	i.ctx, i.cancel = context.WithCancel(i.ctx)
 
 	if conf.CredentialsFile != "" {
@@ -62,6 +79,22 @@ func New(conf Config) (*Integration, error) {
 		return nil, fmt.Errorf("topic %s does not exist", conf.TopicName)
 	}
 
+	if conf.SubscriptionName != "" {
+		log.WithField("subscription", conf.SubscriptionName).Info("integration/gcp_pub_sub: setup subscription")
+		i.subscription = i.client.Subscription(conf.SubscriptionName)
+		ok, err := i.subscription.Exists(i.ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "subscription exists error")
+		}
+		if !ok {
+			return nil, fmt.Errorf("subscription %s does not exist", conf.SubscriptionName)
+		}
+
+		i.dataDownChan = make(chan integration.DataDownPayload)
+		i.wg.Add(1)
+		go i.receiveLoop()
+	}
+
 	return &i, nil
 }
 
@@ -69,56 +102,120 @@ func New(conf Config) (*Integration, error) {
 func (i *Integration) Close() error {
 	log.Info("integration/gcppubsub: closing integration")
 	i.cancel()
+
+	if i.dataDownChan != nil {
+		i.wg.Wait()
+		close(i.dataDownChan)
+	}
+
 	return i.client.Close()
 }
 
+// receiveLoop receives the downlink payloads from the subscription until
+// the integration is closed.
+func (i *Integration) receiveLoop() {
+	defer i.wg.Done()
+
+	for {
+		err := i.subscription.Receive(i.ctx, i.handleDataDown)
+		if i.ctx.Err() != nil {
+			return
+		}
+
+		log.WithError(err).Error("integration/gcppubsub: receive error")
+
+		select {
+		case <-time.After(time.Second):
+		case <-i.ctx.Done():
+			return
+		}
+	}
+}
+
+// handleDataDown handles a received downlink payload. The message is
+// acknowledged when the payload has been enqueued, else it is
+// negatively-acknowledged so that it will be redelivered. Messages that can
+// not be decoded are acknowledged to avoid endless redelivery.
+func (i *Integration) handleDataDown(ctx context.Context, msg *pubsub.Message) {
+	var pl integration.DataDownPayload
+	if err := json.Unmarshal(msg.Data, &pl); err != nil {
+		log.WithError(err).WithField("message_id", msg.ID).Error("integration/gcppubsub: unmarshal downlink payload error")
+		msg.Ack()
+		return
+	}
+
+	if err := integration.SendDataDown(ctx, i.dataDownChan, pl); err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"message_id": msg.ID,
+			"dev_eui":    pl.DevEUI,
+		}).Error("integration/gcppubsub: handle downlink payload error")
+		msg.Nack()
+		return
+	}
+
+	log.WithFields(log.Fields{
+		"message_id": msg.ID,
+		"dev_eui":    pl.DevEUI,
+	}).Info("integration/gcppubsub: downlink payload enqueued")
+
+	msg.Ack()
+}
+
 // SendDataUp sends an uplink data payload.
 func (i *Integration) SendDataUp(pl integration.DataUpPayload) error {
-	return i.publish("up", pl.DevEUI, pl)
+	return i.publish(marshaler.Uplink, pl.DevEUI, pl)
 }
 
 // SendJoinNotification sends a join notification.
 func (i *Integration) SendJoinNotification(pl integration.JoinNotification) error {
-	return i.publish("join", pl.DevEUI, pl)
+	return i.publish(marshaler.Join, pl.DevEUI, pl)
 }
 
 // SendACKNotification sends an ack notification.
 func (i *Integration) SendACKNotification(pl integration.ACKNotification) error {
-	return i.publish("ack", pl.DevEUI, pl)
+	return i.publish(marshaler.ACK, pl.DevEUI, pl)
 }
 
 // SendErrorNotification sends an error notification.
 func (i *Integration) SendErrorNotification(pl integration.ErrorNotification) error {
-	return i.publish("error", pl.DevEUI, pl)
+	return i.publish(marshaler.Error, pl.DevEUI, pl)
 }
 
 // SendStatusNotification sends a status notification.
 func (i *Integration) SendStatusNotification(pl integration.StatusNotification) error {
-	return i.publish("status", pl.DevEUI, pl)
+	return i.publish(marshaler.Status, pl.DevEUI, pl)
 }
 
 // SendLocationNotification sends a location notification.
 func (i *Integration) SendLocationNotification(pl integration.LocationNotification) error {
-	return i.publish("location", pl.DevEUI, pl)
+	return i.publish(marshaler.Location, pl.DevEUI, pl)
 }
 
-// DataDownChan return nil.
+// DataDownChan returns the channel containing the received DataDownPayload.
+// It returns nil when no subscription has been configured.
 func (i *Integration) DataDownChan() chan integration.DataDownPayload {
-	return nil
+	return i.dataDownChan
 }
 
 func (i *Integration) publish(event string, devEUI lorawan.EUI64, v interface{}) error {
-	jsonB, err := json.Marshal(v)
+	msg, err := marshaler.Marshal(i.marshaler, event, v)
 	if err != nil {
-		return errors.Wrap(err, "marshal json error")
+		return errors.Wrap(err, "marshal payload error")
+	}
+
+	attributes := map[string]string{
+		"event":       event,
+		"devEUI":      devEUI.String(),
+		"contentType": msg.ContentType,
+	}
+	// see the CloudEvents Google Cloud Pub/Sub protocol binding
+	for k, v := range msg.Headers {
+		attributes["ce-"+k] = v
 	}
 
 	res := i.topic.Publish(i.ctx, &pubsub.Message{
-		Data: jsonB,
-		Attributes: map[string]string{
-			"event":  event,
-			"devEUI": devEUI.String(),
-		},
+		Data:       msg.Data,
+		Attributes: attributes,
 	})
 	if _, err := res.Get(i.ctx); err != nil {
 		return errors.Wrap(err, "get publish result error")
@@ -0,0 +1,136 @@
+package http
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/downlink"
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// DownlinkPath defines the path of the downlink endpoint.
+const DownlinkPath = "/api/applications/{applicationID}/integrations/http/downlink"
+
+// maxDownlinkBodySize defines the max. size (in bytes) of the request body
+// of the downlink endpoint.
+const maxDownlinkBodySize = 64 * 1024
+
+// NewDownlinkToken returns a new random downlink token.
+func NewDownlinkToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "read random bytes error")
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// DownlinkHandler implements the downlink endpoint of the HTTP integration.
+// It accepts integration.DataDownPayload JSON objects, authenticated using
+// the downlink token of the HTTP integration of the application.
+type DownlinkHandler struct{}
+
+// NewDownlinkHandler creates a new DownlinkHandler.
+func NewDownlinkHandler() *DownlinkHandler {
+	return &DownlinkHandler{}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *DownlinkHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	applicationID, err := strconv.ParseInt(mux.Vars(r)["applicationID"], 10, 64)
+	if err != nil {
+		writeDownlinkError(w, http.StatusBadRequest, errors.New("invalid application id"))
+		return
+	}
+
+	intgr, err := storage.GetIntegrationByApplicationID(storage.DB(), applicationID, integration.HTTP)
+	if err != nil {
+		if errors.Cause(err) == storage.ErrDoesNotExist {
+			writeDownlinkError(w, http.StatusNotFound, errors.New("downlink endpoint is not enabled"))
+		} else {
+			log.WithError(err).Error("integration/http: get integration error")
+			writeDownlinkError(w, http.StatusInternalServerError, errors.New("get integration error"))
+		}
+		return
+	}
+
+	var conf Config
+	if err := json.Unmarshal(intgr.Settings, &conf); err != nil {
+		log.WithError(err).Error("integration/http: unmarshal integration config error")
+		writeDownlinkError(w, http.StatusInternalServerError, errors.New("get integration error"))
+		return
+	}
+
+	if conf.DownlinkToken == "" {
+		writeDownlinkError(w, http.StatusNotFound, errors.New("downlink endpoint is not enabled"))
+		return
+	}
+
+	if subtle.ConstantTimeCompare([]byte(requestToken(r)), []byte(conf.DownlinkToken)) != 1 {
+		writeDownlinkError(w, http.StatusUnauthorized, errors.New("invalid token"))
+		return
+	}
+
+	var pl integration.DataDownPayload
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxDownlinkBodySize)).Decode(&pl); err != nil {
+		if _, ok := err.(*http.MaxBytesError); ok {
+			writeDownlinkError(w, http.StatusRequestEntityTooLarge, errors.New("request body too large"))
+		} else {
+			writeDownlinkError(w, http.StatusBadRequest, errors.Wrap(err, "decode payload error"))
+		}
+		return
+	}
+	pl.ApplicationID = applicationID
+
+	log.WithFields(log.Fields{
+		"application_id": pl.ApplicationID,
+		"dev_eui":        pl.DevEUI,
+	}).Info("integration/http: downlink payload received")
+
+	if err := downlink.HandleDataDownPayload(pl); err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"application_id": pl.ApplicationID,
+			"dev_eui":        pl.DevEUI,
+		}).Error("integration/http: handle downlink payload error")
+
+		// internal errors are logged only, the client gets a generic error
+		switch errors.Cause(err) {
+		case storage.ErrDoesNotExist, downlink.ErrDeviceNotInApplication:
+			writeDownlinkError(w, http.StatusNotFound, err)
+		default:
+			writeDownlinkError(w, http.StatusInternalServerError, errors.New("handle downlink payload error"))
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// requestToken returns the token from the Authorization (Bearer) header.
+// The token is not accepted as query parameter, as it would end up in the
+// access logs.
+func requestToken(r *http.Request) string {
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		return strings.TrimPrefix(auth, "Bearer ")
+	}
+	return ""
+}
+
+func writeDownlinkError(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(struct {
+		Error string `json:"error"`
+	}{
+		Error: err.Error(),
+	})
+}
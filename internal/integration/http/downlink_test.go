@@ -0,0 +1,267 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+type DownlinkHandlerTestSuite struct {
+	suite.Suite
+
+	nsClient *mock.Client
+	router   *mux.Router
+
+	app         storage.Application
+	appDisabled storage.Application
+	device      storage.Device
+}
+
+func (ts *DownlinkHandlerTestSuite) SetupSuite() {
+	assert := require.New(ts.T())
+
+	conf := test.GetConfig()
+	assert.NoError(storage.Setup(conf))
+	test.MustResetDB(storage.DB().DB)
+
+	ts.nsClient = mock.NewClient()
+	networkserver.SetPool(mock.NewPool(ts.nsClient))
+
+	org := storage.Organization{
+		Name: "test-org",
+	}
+	assert.NoError(storage.CreateOrganization(storage.DB(), &org))
+
+	n := storage.NetworkServer{
+		Name:   "test-ns",
+		Server: "test-ns:1234",
+	}
+	assert.NoError(storage.CreateNetworkServer(storage.DB(), &n))
+
+	sp := storage.ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(storage.CreateServiceProfile(storage.DB(), &sp))
+	spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+	assert.NoError(err)
+
+	dp := storage.DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(storage.CreateDeviceProfile(storage.DB(), &dp))
+	dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+	assert.NoError(err)
+
+	ts.app = storage.Application{
+		OrganizationID:   org.ID,
+		Name:             "test-app",
+		ServiceProfileID: spID,
+	}
+	assert.NoError(storage.CreateApplication(storage.DB(), &ts.app))
+
+	ts.appDisabled = storage.Application{
+		OrganizationID:   org.ID,
+		Name:             "test-app-disabled",
+		ServiceProfileID: spID,
+	}
+	assert.NoError(storage.CreateApplication(storage.DB(), &ts.appDisabled))
+
+	for _, item := range []struct {
+		applicationID int64
+		conf          Config
+	}{
+		{ts.app.ID, Config{DownlinkToken: "secret"}},
+		{ts.appDisabled.ID, Config{}},
+	} {
+		b, err := json.Marshal(item.conf)
+		assert.NoError(err)
+		assert.NoError(storage.CreateIntegration(storage.DB(), &storage.Integration{
+			ApplicationID: item.applicationID,
+			Kind:          integration.HTTP,
+			Settings:      b,
+		}))
+	}
+
+	ts.device = storage.Device{
+		ApplicationID:   ts.app.ID,
+		DeviceProfileID: dpID,
+		Name:            "test-device",
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+	}
+	assert.NoError(storage.CreateDevice(storage.DB(), &ts.device))
+
+	assert.NoError(storage.CreateDevice(storage.DB(), &storage.Device{
+		ApplicationID:   ts.appDisabled.ID,
+		DeviceProfileID: dpID,
+		Name:            "test-device-other-app",
+		DevEUI:          lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+	}))
+
+	assert.NoError(storage.CreateDeviceActivation(storage.DB(), &storage.DeviceActivation{
+		DevEUI:  ts.device.DevEUI,
+		DevAddr: lorawan.DevAddr{1, 2, 3, 4},
+		AppSKey: lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+	}))
+
+	ts.router = mux.NewRouter()
+	ts.router.Handle(DownlinkPath, NewDownlinkHandler()).Methods("post")
+}
+
+func (ts *DownlinkHandlerTestSuite) TestServeHTTP() {
+	validBody := `{"devEUI": "0102030405060708", "fPort": 10, "data": "AQID"}`
+
+	tests := []struct {
+		Name          string
+		ApplicationID int64
+		Header        string
+		Query         string
+		Body          string
+
+		ExpectedCode  int
+		ExpectedError string
+		ExpectedQueue bool
+	}{
+		{
+			Name:          "valid token in header",
+			ApplicationID: ts.app.ID,
+			Header:        "Bearer secret",
+			Body:          validBody,
+			ExpectedCode:  http.StatusOK,
+			ExpectedQueue: true,
+		},
+		{
+			Name:          "token in query",
+			ApplicationID: ts.app.ID,
+			Query:         "?token=secret",
+			Body:          validBody,
+			ExpectedCode:  http.StatusUnauthorized,
+			ExpectedError: "invalid token",
+		},
+		{
+			Name:          "invalid token",
+			ApplicationID: ts.app.ID,
+			Header:        "Bearer wrong",
+			Body:          validBody,
+			ExpectedCode:  http.StatusUnauthorized,
+			ExpectedError: "invalid token",
+		},
+		{
+			Name:          "no token",
+			ApplicationID: ts.app.ID,
+			Body:          validBody,
+			ExpectedCode:  http.StatusUnauthorized,
+			ExpectedError: "invalid token",
+		},
+		{
+			Name:          "unknown integration",
+			ApplicationID: ts.app.ID + 1000,
+			Header:        "Bearer secret",
+			Body:          validBody,
+			ExpectedCode:  http.StatusNotFound,
+			ExpectedError: "downlink endpoint is not enabled",
+		},
+		{
+			Name:          "downlink endpoint disabled",
+			ApplicationID: ts.appDisabled.ID,
+			Header:        "Bearer ",
+			Body:          validBody,
+			ExpectedCode:  http.StatusNotFound,
+			ExpectedError: "downlink endpoint is not enabled",
+		},
+		{
+			Name:          "malformed json",
+			ApplicationID: ts.app.ID,
+			Header:        "Bearer secret",
+			Body:          `{"devEUI": `,
+			ExpectedCode:  http.StatusBadRequest,
+			ExpectedError: "decode payload error: unexpected EOF",
+		},
+		{
+			Name:          "invalid devEUI",
+			ApplicationID: ts.app.ID,
+			Header:        "Bearer secret",
+			Body:          `{"devEUI": "0102", "fPort": 10, "data": "AQID"}`,
+			ExpectedCode:  http.StatusBadRequest,
+			ExpectedError: "decode payload error: lorawan: exactly 8 bytes are expected",
+		},
+		{
+			Name:          "invalid data",
+			ApplicationID: ts.app.ID,
+			Header:        "Bearer secret",
+			Body:          `{"devEUI": "0102030405060708", "fPort": 10, "data": "%%%"}`,
+			ExpectedCode:  http.StatusBadRequest,
+			ExpectedError: "decode payload error: illegal base64 data at input byte 0",
+		},
+		{
+			Name:          "device in other application",
+			ApplicationID: ts.app.ID,
+			Header:        "Bearer secret",
+			Body:          `{"devEUI": "0807060504030201", "fPort": 10, "data": "AQID"}`,
+			ExpectedCode:  http.StatusNotFound,
+			ExpectedError: "enqueue downlink payload: device does not exist for given application",
+		},
+		{
+			Name:          "body too large",
+			ApplicationID: ts.app.ID,
+			Header:        "Bearer secret",
+			Body:          `{"devEUI": "0102030405060708", "fPort": 10, "data": "` + strings.Repeat("A", maxDownlinkBodySize) + `"}`,
+			ExpectedCode:  http.StatusRequestEntityTooLarge,
+			ExpectedError: "request body too large",
+		},
+	}
+
+	for _, tst := range tests {
+		ts.T().Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			r := httptest.NewRequest("POST", fmt.Sprintf("/api/applications/%d/integrations/http/downlink%s", tst.ApplicationID, tst.Query), bytes.NewBufferString(tst.Body))
+			if tst.Header != "" {
+				r.Header.Set("Authorization", tst.Header)
+			}
+			w := httptest.NewRecorder()
+
+			ts.router.ServeHTTP(w, r)
+			assert.Equal(tst.ExpectedCode, w.Code)
+
+			if tst.ExpectedError != "" {
+				var resp struct {
+					Error string `json:"error"`
+				}
+				assert.NoError(json.NewDecoder(w.Body).Decode(&resp))
+				assert.Equal(tst.ExpectedError, resp.Error)
+			}
+
+			if tst.ExpectedQueue {
+				req := <-ts.nsClient.CreateDeviceQueueItemChan
+				assert.Equal(ts.device.DevEUI[:], req.Item.DevEui)
+				assert.EqualValues(10, req.Item.FPort)
+			} else {
+				assert.Len(ts.nsClient.CreateDeviceQueueItemChan, 0)
+			}
+		})
+	}
+}
+
+func TestDownlinkHandler(t *testing.T) {
+	suite.Run(t, new(DownlinkHandlerTestSuite))
+}
@@ -4,5 +4,10 @@ import "errors"
 
 // errors
 var (
-	ErrInvalidHeaderName = errors.New("Invalid header name")
+	ErrInvalidHeaderName     = errors.New("Invalid header name")
+	ErrInvalidMarshaler      = errors.New("Invalid marshaler")
+	ErrInvalidMethod         = errors.New("Invalid HTTP method")
+	ErrInvalidTimeout        = errors.New("Invalid timeout")
+	ErrInvalidTemplate       = errors.New("Invalid template")
+	ErrInvalidTemplateEngine = errors.New("Invalid template engine")
 )
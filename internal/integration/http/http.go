@@ -3,19 +3,42 @@ package http
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"net/url"
 	"regexp"
+	"text/template"
+	"time"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/integration/marshaler"
+	"github.com/brocaar/lorawan"
 )
 
 var headerNameValidator = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
 
+var methodValidator = regexp.MustCompile(`^(GET|POST|PUT|PATCH|DELETE)$`)
+
+// maxResponseDrainSize defines the max. number of bytes read from the
+// response body before closing it. Reading the body makes it possible to
+// re-use the (keep-alive) connection.
+const maxResponseDrainSize = 64 * 1024
+
+// TemplateEngine defines the engine used for rendering the body templates.
+type TemplateEngine string
+
+// Available template engines.
+const (
+	GoTemplate TemplateEngine = "go_template"
+	JS         TemplateEngine = "js"
+)
+
 // Config contains the configuration for the HTTP integration.
 type Config struct {
 	Headers                 map[string]string `json:"headers"`
@@ -25,6 +48,13 @@ type Config struct {
 	ErrorNotificationURL    string            `json:"errorNotificationURL"`
 	StatusNotificationURL   string            `json:"statusNotificationURL"`
 	LocationNotificationURL string            `json:"locationNotificationURL"`
+	Marshaler               marshaler.Type    `json:"marshaler"`
+	Method                  string            `json:"method"`
+	Timeout                 time.Duration     `json:"timeout"`
+	ContentType             string            `json:"contentType"`
+	TemplateEngine          TemplateEngine    `json:"templateEngine"`
+	BodyTemplates           map[string]string `json:"bodyTemplates"`
+	DownlinkToken           string            `json:"downlinkToken"`
 }
 
 // Validate validates the HandlerConfig data.
@@ -34,51 +64,222 @@ func (c Config) Validate() error {
 			return ErrInvalidHeaderName
 		}
 	}
+	if err := c.Marshaler.Validate(); err != nil {
+		return ErrInvalidMarshaler
+	}
+	if c.Method != "" && !methodValidator.MatchString(c.Method) {
+		return ErrInvalidMethod
+	}
+	if c.Timeout < 0 {
+		return ErrInvalidTimeout
+	}
+	if _, err := c.urlTemplates(); err != nil {
+		return ErrInvalidTemplate
+	}
+
+	switch c.TemplateEngine {
+	case "", GoTemplate:
+		if _, err := c.goBodyTemplates(); err != nil {
+			return ErrInvalidTemplate
+		}
+	case JS:
+		for _, body := range c.BodyTemplates {
+			if body == "" {
+				continue
+			}
+			if err := codec.CompileJS(jsTransformScript(body)); err != nil {
+				return ErrInvalidTemplate
+			}
+		}
+	default:
+		return ErrInvalidTemplateEngine
+	}
+
+	for event := range c.BodyTemplates {
+		if _, ok := c.eventURLs()[event]; !ok {
+			return ErrInvalidTemplate
+		}
+	}
+
 	return nil
 }
 
+// eventURLs returns the URL (templates) by event type.
+func (c Config) eventURLs() map[string]string {
+	return map[string]string{
+		marshaler.Uplink:   c.DataUpURL,
+		marshaler.Join:     c.JoinNotificationURL,
+		marshaler.ACK:      c.ACKNotificationURL,
+		marshaler.Error:    c.ErrorNotificationURL,
+		marshaler.Status:   c.StatusNotificationURL,
+		marshaler.Location: c.LocationNotificationURL,
+	}
+}
+
+func (c Config) urlTemplates() (map[string]*template.Template, error) {
+	out := make(map[string]*template.Template)
+	for event, url := range c.eventURLs() {
+		if url == "" {
+			continue
+		}
+
+		tmpl, err := newTemplate(event+"_url", url)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse %s url template error", event)
+		}
+		out[event] = tmpl
+	}
+	return out, nil
+}
+
+func (c Config) goBodyTemplates() (map[string]*template.Template, error) {
+	out := make(map[string]*template.Template)
+	for event, body := range c.BodyTemplates {
+		if body == "" {
+			continue
+		}
+
+		tmpl, err := newTemplate(event+"_body", body)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse %s body template error", event)
+		}
+		out[event] = tmpl
+	}
+	return out, nil
+}
+
 // Integration implements a HTTP integration.
 type Integration struct {
 	config Config
+	client *http.Client
+
+	urlTemplates  map[string]*template.Template
+	bodyTemplates map[string]*template.Template
 }
 
 // New creates a new HTTP integration.
 func New(conf Config) (*Integration, error) {
-	return &Integration{
+	i := Integration{
 		config: conf,
-	}, nil
+		client: http.DefaultClient,
+	}
+
+	if conf.Timeout > 0 {
+		i.client = &http.Client{
+			Timeout: conf.Timeout,
+		}
+	}
+
+	var err error
+	i.urlTemplates, err = conf.urlTemplates()
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.TemplateEngine != JS {
+		i.bodyTemplates, err = conf.goBodyTemplates()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &i, nil
+}
+
+// SetHTTPClient sets the HTTP client used for making requests.
+func (i *Integration) SetHTTPClient(c *http.Client) {
+	i.client = c
 }
 
-func (i *Integration) send(url string, payload interface{}) error {
-	b, err := json.Marshal(payload)
+func (i *Integration) send(event, name string, devEUI lorawan.EUI64, payload interface{}) error {
+	u, err := executeTemplate(i.urlTemplates[event], payload)
 	if err != nil {
-		return errors.Wrap(err, "marshal json error")
+		return errors.Wrap(err, "execute url template error")
+	}
+
+	// the query string might contain credentials, it is not logged
+	logURL := redactURL(string(u))
+
+	log.WithFields(log.Fields{
+		"url":     logURL,
+		"dev_eui": devEUI,
+	}).Infof("integration/http: publishing %s", name)
+
+	var msg marshaler.Message
+	if body := i.config.BodyTemplates[event]; body != "" {
+		msg.ContentType = marshaler.ContentTypeJSON
+
+		switch i.config.TemplateEngine {
+		case JS:
+			msg.Data, err = executeJS(body, event, payload)
+		default:
+			msg.Data, err = executeTemplate(i.bodyTemplates[event], payload)
+		}
+		if err != nil {
+			return errors.Wrap(err, "execute body template error")
+		}
+	} else {
+		msg, err = marshaler.Marshal(i.config.Marshaler, event, payload)
+		if err != nil {
+			return errors.Wrap(err, "marshal payload error")
+		}
+	}
+
+	if i.config.ContentType != "" {
+		msg.ContentType = i.config.ContentType
+	}
+
+	method := i.config.Method
+	if method == "" {
+		method = http.MethodPost
 	}
 
-	req, err := http.NewRequest("POST", url, bytes.NewReader(b))
+	req, err := http.NewRequest(method, string(u), bytes.NewReader(msg.Data))
 	if err != nil {
 		return errors.Wrap(err, "new request error")
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", msg.ContentType)
+	for k, v := range msg.Headers {
+		req.Header.Set("ce-"+k, v)
+	}
 	for k, v := range i.config.Headers {
 		req.Header.Set(k, v)
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := i.client.Do(req)
 	if err != nil {
-		return errors.Wrap(err, "http request error")
+		// the url.Error contains the full URL
+		if uErr, ok := err.(*url.Error); ok {
+			err = uErr.Err
+		}
+		return errors.Wrapf(err, "http request to %s error", logURL)
 	}
 	defer resp.Body.Close()
+	defer io.Copy(ioutil.Discard, io.LimitReader(resp.Body, maxResponseDrainSize))
 
 	// check that response is in 200 range
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("expected 2XX response, got: %d", resp.StatusCode)
+		return fmt.Errorf("expected 2XX response from %s, got: %d", logURL, resp.StatusCode)
 	}
 
 	return nil
 }
 
+// redactURL returns the given URL without query string and user
+// information.
+func redactURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return "invalid url"
+	}
+	u.User = nil
+	if u.RawQuery != "" {
+		u.RawQuery = "redacted"
+	}
+	return u.String()
+}
+
 // Close closes the handler.
 func (i *Integration) Close() error {
 	return nil
@@ -87,14 +288,10 @@ func (i *Integration) Close() error {
 // SendDataUp sends a data-up payload.
 func (i *Integration) SendDataUp(pl integration.DataUpPayload) error {
 	if i.config.DataUpURL == "" {
-		return nil
+		return integration.ErrNotHandled
 	}
 
-	log.WithFields(log.Fields{
-		"url":     i.config.DataUpURL,
-		"dev_eui": pl.DevEUI,
-	}).Info("integration/http: publishing data-up payload")
-	if err := i.send(i.config.DataUpURL, pl); err != nil {
+	if err := i.send(marshaler.Uplink, "data-up payload", pl.DevEUI, pl); err != nil {
 		return errors.Wrap(err, "send error")
 	}
 	return nil
@@ -103,14 +300,10 @@ func (i *Integration) SendDataUp(pl integration.DataUpPayload) error {
 // SendJoinNotification sends a join notification.
 func (i *Integration) SendJoinNotification(pl integration.JoinNotification) error {
 	if i.config.JoinNotificationURL == "" {
-		return nil
+		return integration.ErrNotHandled
 	}
 
-	log.WithFields(log.Fields{
-		"url":     i.config.JoinNotificationURL,
-		"dev_eui": pl.DevEUI,
-	}).Info("integration/http: publishing join notification")
-	if err := i.send(i.config.JoinNotificationURL, pl); err != nil {
+	if err := i.send(marshaler.Join, "join notification", pl.DevEUI, pl); err != nil {
 		return errors.Wrap(err, "send error")
 	}
 	return nil
@@ -119,14 +312,10 @@ func (i *Integration) SendJoinNotification(pl integration.JoinNotification) erro
 // SendACKNotification sends an ACK notification.
 func (i *Integration) SendACKNotification(pl integration.ACKNotification) error {
 	if i.config.ACKNotificationURL == "" {
-		return nil
+		return integration.ErrNotHandled
 	}
 
-	log.WithFields(log.Fields{
-		"url":     i.config.ACKNotificationURL,
-		"dev_eui": pl.DevEUI,
-	}).Info("integration/http: publishing ack notification")
-	if err := i.send(i.config.ACKNotificationURL, pl); err != nil {
+	if err := i.send(marshaler.ACK, "ack notification", pl.DevEUI, pl); err != nil {
 		return errors.Wrap(err, "send error")
 	}
 	return nil
@@ -135,14 +324,10 @@ func (i *Integration) SendACKNotification(pl integration.ACKNotification) error
 // SendErrorNotification sends an error notification.
 func (i *Integration) SendErrorNotification(pl integration.ErrorNotification) error {
 	if i.config.ErrorNotificationURL == "" {
-		return nil
+		return integration.ErrNotHandled
 	}
 
-	log.WithFields(log.Fields{
-		"url":     i.config.ErrorNotificationURL,
-		"dev_eui": pl.DevEUI,
-	}).Info("integration/http: publishing error notification")
-	if err := i.send(i.config.ErrorNotificationURL, pl); err != nil {
+	if err := i.send(marshaler.Error, "error notification", pl.DevEUI, pl); err != nil {
 		return errors.Wrap(err, "send error")
 	}
 	return nil
@@ -151,14 +336,10 @@ func (i *Integration) SendErrorNotification(pl integration.ErrorNotification) er
 // SendStatusNotification sends a status notification.
 func (i *Integration) SendStatusNotification(pl integration.StatusNotification) error {
 	if i.config.StatusNotificationURL == "" {
-		return nil
+		return integration.ErrNotHandled
 	}
 
-	log.WithFields(log.Fields{
-		"url":     i.config.StatusNotificationURL,
-		"dev_eui": pl.DevEUI,
-	}).Info("integration/http: publishing status notification")
-	if err := i.send(i.config.StatusNotificationURL, pl); err != nil {
+	if err := i.send(marshaler.Status, "status notification", pl.DevEUI, pl); err != nil {
 		return errors.Wrap(err, "send error")
 	}
 	return nil
@@ -167,14 +348,10 @@ func (i *Integration) SendStatusNotification(pl integration.StatusNotification)
 // SendLocationNotification sends a location notification.
 func (i *Integration) SendLocationNotification(pl integration.LocationNotification) error {
 	if i.config.LocationNotificationURL == "" {
-		return nil
+		return integration.ErrNotHandled
 	}
 
-	log.WithFields(log.Fields{
-		"url":     i.config.LocationNotificationURL,
-		"dev_eui": pl.DevEUI,
-	}).Info("integration/http: publishing location notification")
-	if err := i.send(i.config.LocationNotificationURL, pl); err != nil {
+	if err := i.send(marshaler.Location, "location notification", pl.DevEUI, pl); err != nil {
 		return errors.Wrap(err, "send error")
 	}
 	return nil
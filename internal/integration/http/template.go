@@ -0,0 +1,81 @@
+package http
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"text/template"
+
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lora-app-server/internal/codec"
+)
+
+// templateFuncs contains the functions available within the URL and body
+// templates.
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	"base64": func(b []byte) string {
+		return base64.StdEncoding.EncodeToString(b)
+	},
+	"hex": func(b []byte) string {
+		return hex.EncodeToString(b)
+	},
+}
+
+func newTemplate(name, text string) (*template.Template, error) {
+	return template.New(name).Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+}
+
+// executeTemplate executes the given template using the given payload.
+func executeTemplate(tmpl *template.Template, payload interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, payload); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// executeJS executes the given script within the codec JS sandbox. The
+// script must implement a Transform(event, payload) function. The payload
+// is passed as JSON object. When a string is returned, it is used as-is,
+// any other value is JSON encoded.
+func executeJS(script, event string, payload interface{}) ([]byte, error) {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal json error")
+	}
+
+	var obj map[string]interface{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, errors.Wrap(err, "unmarshal json error")
+	}
+
+	out, err := codec.ExecuteJS(jsTransformScript(script), map[string]interface{}{
+		"event":   event,
+		"payload": obj,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if s, ok := out.(string); ok {
+		return []byte(s), nil
+	}
+
+	b, err = json.Marshal(out)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal json error")
+	}
+	return b, nil
+}
+
+// jsTransformScript returns the given script, followed by the call of the
+// Transform function.
+func jsTransformScript(script string) string {
+	return script + "\n\nTransform(event, payload);\n"
+}
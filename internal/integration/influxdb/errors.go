@@ -4,5 +4,10 @@ import "errors"
 
 // errors
 var (
-	ErrInvalidPrecision = errors.New("invalid precision value")
+	ErrInvalidPrecision   = errors.New("invalid precision value")
+	ErrInvalidVersion     = errors.New("invalid version value")
+	ErrInvalidV2Config    = errors.New("organization and bucket must be set for InfluxDB 2.x")
+	ErrInvalidBatchConfig = errors.New("batch size, batch interval and retry buffer size must be >= 0 and retry buffer size must be <= 100000")
+	ErrInvalidTemplate    = errors.New("invalid tag or measurement template")
+	ErrClosed             = errors.New("integration is closed")
 )
@@ -3,6 +3,7 @@ package influxdb
 
 import (
 	"bytes"
+	"compress/gzip"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -12,6 +13,9 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"text/template"
+	"time"
 
 	"github.com/mmcloughlin/geohash"
 	"github.com/pkg/errors"
@@ -22,6 +26,32 @@ import (
 
 var precisionValidator = regexp.MustCompile(`^(ns|u|ms|s|m|h)$`)
 
+// tagEscaper escapes the tag keys and values (line protocol).
+var tagEscaper = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `)
+
+// measurementEscaper escapes the measurement names (line protocol).
+var measurementEscaper = strings.NewReplacer(",", `\,`, " ", `\ `)
+
+// defaultBatchInterval defines the flush interval used when batching is
+// enabled without an interval.
+const defaultBatchInterval = time.Second
+
+// defaultRetryBufferSize defines the retry buffer size used when batching is
+// enabled without a retry buffer size.
+const defaultRetryBufferSize = 10000
+
+// maxRetryBufferSize defines the max. configurable retry buffer size.
+const maxRetryBufferSize = 100000
+
+// Version defines the InfluxDB (API) version.
+type Version string
+
+// InfluxDB versions.
+const (
+	Version1 Version = "v1"
+	Version2 Version = "v2"
+)
+
 // Config contains the configuration for the InfluxDB integration.
 type Config struct {
 	Endpoint            string `json:"endpoint"`
@@ -30,6 +60,34 @@ type Config struct {
 	Password            string `json:"password"`
 	RetentionPolicyName string `json:"retentionPolicyName"`
 	Precision           string `json:"precision"`
+
+	// InfluxDB 2.x options. When Version is left blank, Version1 is used.
+	Version      Version `json:"version"`
+	Organization string  `json:"organization"`
+	Bucket       string  `json:"bucket"`
+	Token        string  `json:"token"`
+
+	// BatchSize and BatchInterval enable batching. The measurements are
+	// written once BatchSize measurements are pending or each BatchInterval.
+	// RetryBufferSize defines the max. number of measurements that are kept
+	// for a next write after a failed write (10000 when not set, max.
+	// 100000).
+	BatchSize       int           `json:"batchSize"`
+	BatchInterval   time.Duration `json:"batchInterval"`
+	RetryBufferSize int           `json:"retryBufferSize"`
+	Gzip            bool          `json:"gzip"`
+
+	// Tags contains the additional tags added to each measurement. The
+	// values are Go templates, executed using the event payload
+	// (e.g. {{.ApplicationName}} or {{index .Variables "site"}} for a
+	// device variable).
+	Tags map[string]string `json:"tags"`
+
+	// MeasurementTemplate defines the Go template for the names of the
+	// measurements of the decoded payload object. It is executed using the
+	// uplink payload, with .Key set to the (flattened) object key. When left
+	// blank, device_frmpayload_data_{{.Key}} is used.
+	MeasurementTemplate string `json:"measurementTemplate"`
 }
 
 // Validate validates the HandlerConfig data.
@@ -37,9 +95,63 @@ func (c Config) Validate() error {
 	if !precisionValidator.MatchString(c.Precision) {
 		return ErrInvalidPrecision
 	}
+
+	switch c.Version {
+	case "", Version1:
+	case Version2:
+		if c.Organization == "" || c.Bucket == "" {
+			return ErrInvalidV2Config
+		}
+		if c.Precision == "m" || c.Precision == "h" {
+			return ErrInvalidPrecision
+		}
+	default:
+		return ErrInvalidVersion
+	}
+
+	if c.BatchSize < 0 || c.BatchInterval < 0 || c.RetryBufferSize < 0 || c.RetryBufferSize > maxRetryBufferSize {
+		return ErrInvalidBatchConfig
+	}
+
+	if _, _, err := c.templates(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// templates parses and returns the tag and measurement templates.
+func (c Config) templates() (map[string]*template.Template, *template.Template, error) {
+	tags := make(map[string]*template.Template)
+	for k, v := range c.Tags {
+		if k == "" {
+			return nil, nil, ErrInvalidTemplate
+		}
+
+		tmpl, err := template.New(k).Option("missingkey=error").Parse(v)
+		if err != nil {
+			return nil, nil, errors.Wrap(ErrInvalidTemplate, err.Error())
+		}
+		tags[k] = tmpl
+	}
+
+	if c.MeasurementTemplate == "" {
+		return tags, nil, nil
+	}
+
+	meas, err := template.New("measurement").Option("missingkey=error").Parse(c.MeasurementTemplate)
+	if err != nil {
+		return nil, nil, errors.Wrap(ErrInvalidTemplate, err.Error())
+	}
+
+	return tags, meas, nil
+}
+
+// batching returns true when batching is enabled.
+func (c Config) batching() bool {
+	return c.BatchSize > 1 || c.BatchInterval > 0
+}
+
 type measurement struct {
 	Name   string
 	Tags   map[string]string
@@ -51,7 +163,7 @@ func (m measurement) String() string {
 	var values []string
 
 	for k, v := range m.Tags {
-		tags = append(tags, fmt.Sprintf("%s=%v", k, formatInfluxValue(v, false)))
+		tags = append(tags, fmt.Sprintf("%s=%v", tagEscaper.Replace(k), tagEscaper.Replace(formatInfluxValue(v, false))))
 	}
 
 	for k, v := range m.Values {
@@ -63,7 +175,28 @@ func (m measurement) String() string {
 	sort.Strings(tags)
 	sort.Strings(values)
 
-	return fmt.Sprintf("%s,%s %s", m.Name, strings.Join(tags, ","), strings.Join(values, ","))
+	return fmt.Sprintf("%s,%s %s", measurementEscaper.Replace(m.Name), strings.Join(tags, ","), strings.Join(values, ","))
+}
+
+// timestamp returns the given time in the given precision.
+func timestamp(t time.Time, precision string) string {
+	var d time.Duration
+	switch precision {
+	case "u":
+		d = time.Microsecond
+	case "ms":
+		d = time.Millisecond
+	case "s":
+		d = time.Second
+	case "m":
+		d = time.Minute
+	case "h":
+		d = time.Hour
+	default:
+		d = time.Nanosecond
+	}
+
+	return strconv.FormatInt(t.UnixNano()/int64(d), 10)
 }
 
 func formatInfluxValue(v interface{}, quote bool) string {
@@ -87,28 +220,291 @@ func formatInfluxValue(v interface{}, quote bool) string {
 // Integration implements an InfluxDB integration.
 type Integration struct {
 	config Config
+	client *http.Client
+
+	tagTemplates        map[string]*template.Template
+	measurementTemplate *template.Template
+
+	// batching
+	mu           sync.Mutex
+	flushMu      sync.Mutex
+	pending      []string
+	retry        []string
+	dropped      int64
+	deliveryFunc integration.DeliveryFunc
+	flushChan    chan struct{}
+	closeOnce    sync.Once
+	closed       chan struct{}
+	done         chan struct{}
 }
 
 // New creates a new InfluxDB integration.
 func New(conf Config) (*Integration, error) {
-	return &Integration{
-		config: conf,
-	}, nil
+	tags, meas, err := conf.templates()
+	if err != nil {
+		return nil, err
+	}
+
+	i := Integration{
+		config:              conf,
+		client:              http.DefaultClient,
+		tagTemplates:        tags,
+		measurementTemplate: meas,
+	}
+
+	if conf.batching() {
+		if i.config.RetryBufferSize == 0 {
+			i.config.RetryBufferSize = defaultRetryBufferSize
+		}
+
+		i.flushChan = make(chan struct{}, 1)
+		i.closed = make(chan struct{})
+		i.done = make(chan struct{})
+		go i.flushLoop()
+	}
+
+	return &i, nil
+}
+
+// SetHTTPClient sets the HTTP client used for making requests.
+func (i *Integration) SetHTTPClient(c *http.Client) {
+	i.client = c
+}
+
+// SetDeliveryFunc sets the function which is called with the result of each
+// batch write.
+func (i *Integration) SetDeliveryFunc(f integration.DeliveryFunc) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.deliveryFunc = f
+}
+
+// Dropped returns the number of measurements which have been dropped because
+// the retry buffer was full.
+func (i *Integration) Dropped() int64 {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.dropped
 }
 
-func (i *Integration) send(measurements []measurement) error {
+// send writes the given measurements, after adding the configured tags
+// (executed using the given payload). When batching is enabled, the
+// measurements are added to the pending batch (with the current timestamp)
+// and written by the background flusher, in which case ErrDeferred is
+// returned. After Close, ErrClosed is returned as these measurements would
+// not be written anymore.
+func (i *Integration) send(pl interface{}, measurements []measurement) error {
+	tags := i.tags(pl)
+
 	var measStr []string
 	for _, m := range measurements {
+		for k, v := range tags {
+			m.Tags[k] = v
+		}
 		measStr = append(measStr, m.String())
 	}
 	sort.Strings(measStr)
 
-	b := []byte(strings.Join(measStr, "\n"))
+	if !i.config.batching() {
+		return i.write(measStr)
+	}
+
+	ts := timestamp(time.Now(), i.config.Precision)
+	for j := range measStr {
+		measStr[j] += " " + ts
+	}
+
+	i.mu.Lock()
+	select {
+	case <-i.closed:
+		i.mu.Unlock()
+		return ErrClosed
+	default:
+	}
+	i.pending = append(i.pending, measStr...)
+	full := i.config.BatchSize > 0 && len(i.pending) >= i.config.BatchSize
+	i.mu.Unlock()
+
+	if full {
+		select {
+		case i.flushChan <- struct{}{}:
+		default:
+		}
+	}
+
+	return integration.ErrDeferred
+}
+
+// tags returns the configured tags, executed using the given payload.
+func (i *Integration) tags(pl interface{}) map[string]string {
+	out := make(map[string]string)
+	for k, tmpl := range i.tagTemplates {
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, pl); err != nil {
+			log.WithError(err).WithField("tag", k).Error("integration/influxdb: execute tag template error")
+			continue
+		}
+		if buf.Len() != 0 {
+			out[k] = buf.String()
+		}
+	}
+	return out
+}
+
+// measurementName returns the name of the decoded object measurement
+// using the configured measurement template. When no template is
+// configured, or on error, the given name is returned.
+func (i *Integration) measurementName(pl integration.DataUpPayload, name string) string {
+	if i.measurementTemplate == nil {
+		return name
+	}
+
+	var buf bytes.Buffer
+	err := i.measurementTemplate.Execute(&buf, struct {
+		integration.DataUpPayload
+		Key string
+	}{
+		DataUpPayload: pl,
+		Key:           strings.TrimPrefix(name, "device_frmpayload_data_"),
+	})
+	if err != nil || buf.Len() == 0 {
+		log.WithError(err).Error("integration/influxdb: execute measurement template error")
+		return name
+	}
+
+	return buf.String()
+}
+
+// Flush writes the pending measurements, including the measurements of
+// previously failed writes. On error, the measurements are kept in the
+// retry buffer (up to RetryBufferSize measurements). The result of each
+// write is reported to the DeliveryFunc.
+func (i *Integration) Flush() error {
+	i.flushMu.Lock()
+	defer i.flushMu.Unlock()
+
+	i.mu.Lock()
+	lines := append(i.retry, i.pending...)
+	i.retry = nil
+	i.pending = nil
+	i.mu.Unlock()
+
+	size := i.config.BatchSize
+	if size <= 0 {
+		size = len(lines)
+	}
+
+	for start := 0; start < len(lines); start += size {
+		end := start + size
+		if end > len(lines) {
+			end = len(lines)
+		}
+
+		writeStart := time.Now()
+		err := i.write(lines[start:end])
+		latency := time.Since(writeStart)
+
+		if err != nil {
+			if n := i.bufferRetry(lines[start:]); n > 0 {
+				err = errors.Wrapf(err, "retry buffer full, %d measurements dropped", n)
+			}
+			i.reportDelivery(latency, err)
+			return err
+		}
+
+		i.reportDelivery(latency, nil)
+	}
+
+	return nil
+}
+
+// bufferRetry keeps the given lines for the next write. It returns the number
+// of lines dropped because of the retry buffer size.
+func (i *Integration) bufferRetry(lines []string) int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	n := len(lines) - i.config.RetryBufferSize
+	if n > 0 {
+		i.dropped += int64(n)
+		log.WithFields(log.Fields{
+			"count": n,
+			"total": i.dropped,
+		}).Warning("integration/influxdb: retry buffer full, measurements dropped")
+		lines = lines[n:]
+	} else {
+		n = 0
+	}
+
+	i.retry = append([]string{}, lines...)
+
+	return n
+}
+
+func (i *Integration) reportDelivery(latency time.Duration, err error) {
+	i.mu.Lock()
+	f := i.deliveryFunc
+	i.mu.Unlock()
+
+	if f != nil {
+		f(latency, err)
+	}
+}
+
+func (i *Integration) flushLoop() {
+	defer close(i.done)
+
+	interval := i.config.BatchInterval
+	if interval == 0 {
+		interval = defaultBatchInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-i.flushChan:
+		case <-i.closed:
+			if err := i.Flush(); err != nil {
+				log.WithError(err).Error("integration/influxdb: flush measurements error")
+			}
+			return
+		}
+
+		if err := i.Flush(); err != nil {
+			log.WithError(err).Error("integration/influxdb: flush measurements error")
+		}
+	}
+}
+
+// write writes the given lines (line protocol) to InfluxDB.
+func (i *Integration) write(lines []string) error {
+	b := []byte(strings.Join(lines, "\n"))
+
+	if i.config.Gzip {
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(b); err != nil {
+			return errors.Wrap(err, "gzip error")
+		}
+		if err := w.Close(); err != nil {
+			return errors.Wrap(err, "gzip error")
+		}
+		b = buf.Bytes()
+	}
 
 	args := url.Values{}
-	args.Set("db", i.config.DB)
-	args.Set("precision", i.config.Precision)
-	args.Set("rp", i.config.RetentionPolicyName)
+	if i.config.Version == Version2 {
+		args.Set("org", i.config.Organization)
+		args.Set("bucket", i.config.Bucket)
+		args.Set("precision", v2Precision(i.config.Precision))
+	} else {
+		args.Set("db", i.config.DB)
+		args.Set("precision", i.config.Precision)
+		args.Set("rp", i.config.RetentionPolicyName)
+	}
 
 	req, err := http.NewRequest("POST", i.config.Endpoint+"?"+args.Encode(), bytes.NewReader(b))
 	if err != nil {
@@ -116,12 +512,19 @@ func (i *Integration) send(measurements []measurement) error {
 	}
 
 	req.Header.Set("Content-Type", "text/plain")
+	if i.config.Gzip {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
 
-	if i.config.Username != "" || i.config.Password != "" {
+	if i.config.Version == Version2 {
+		if i.config.Token != "" {
+			req.Header.Set("Authorization", "Token "+i.config.Token)
+		}
+	} else if i.config.Username != "" || i.config.Password != "" {
 		req.SetBasicAuth(i.config.Username, i.config.Password)
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := i.client.Do(req)
 	if err != nil {
 		return errors.Wrap(err, "http request error")
 	}
@@ -136,15 +539,33 @@ func (i *Integration) send(measurements []measurement) error {
 	return nil
 }
 
-// Close closes the handler.
+// v2Precision returns the InfluxDB 2.x precision value.
+func v2Precision(p string) string {
+	if p == "u" {
+		return "us"
+	}
+	return p
+}
+
+// Close closes the handler. When batching is enabled, the pending
+// measurements are written first.
 func (i *Integration) Close() error {
+	if i.closed == nil {
+		return nil
+	}
+
+	i.closeOnce.Do(func() {
+		close(i.closed)
+	})
+	<-i.done
+
 	return nil
 }
 
 // SendDataUp stores the uplink data into InfluxDB.
 func (i *Integration) SendDataUp(pl integration.DataUpPayload) error {
 	if pl.Object == nil {
-		return nil
+		return integration.ErrNotHandled
 	}
 
 	var measurements []measurement
@@ -185,13 +606,16 @@ func (i *Integration) SendDataUp(pl integration.DataUpPayload) error {
 	}
 
 	// parse object to measurements
-	measurements = append(measurements, objectToMeasurements(pl, "device_frmpayload_data", pl.Object)...)
+	for _, m := range objectToMeasurements(pl, "device_frmpayload_data", pl.Object) {
+		m.Name = i.measurementName(pl, m.Name)
+		measurements = append(measurements, m)
+	}
 
 	if len(measurements) == 0 {
 		return nil
 	}
 
-	if err := i.send(measurements); err != nil {
+	if err := i.send(pl, measurements); err != nil {
 		return errors.Wrap(err, "sending measurements error")
 	}
 
@@ -244,7 +668,7 @@ func (i *Integration) SendStatusNotification(pl integration.StatusNotification)
 		},
 	})
 
-	if err := i.send(measurements); err != nil {
+	if err := i.send(pl, measurements); err != nil {
 		return errors.Wrap(err, "sending measurements error")
 	}
 
@@ -255,23 +679,119 @@ func (i *Integration) SendStatusNotification(pl integration.StatusNotification)
 	return nil
 }
 
-// SendJoinNotification is not implemented.
+// SendJoinNotification writes the join.
 func (i *Integration) SendJoinNotification(pl integration.JoinNotification) error {
+	measurements := []measurement{
+		{
+			Name: "device_join",
+			Tags: map[string]string{
+				"application_name": pl.ApplicationName,
+				"device_name":      pl.DeviceName,
+				"dev_eui":          pl.DevEUI.String(),
+			},
+			Values: map[string]interface{}{
+				"value":    1,
+				"dev_addr": pl.DevAddr.String(),
+			},
+		},
+	}
+
+	if err := i.send(pl, measurements); err != nil {
+		return errors.Wrap(err, "sending measurements error")
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui": pl.DevEUI,
+	}).Info("integration/influxdb: join measurements written")
+
 	return nil
 }
 
-// SendACKNotification is not implemented.
+// SendACKNotification writes the (n)ack.
 func (i *Integration) SendACKNotification(pl integration.ACKNotification) error {
+	measurements := []measurement{
+		{
+			Name: "device_ack",
+			Tags: map[string]string{
+				"application_name": pl.ApplicationName,
+				"device_name":      pl.DeviceName,
+				"dev_eui":          pl.DevEUI.String(),
+			},
+			Values: map[string]interface{}{
+				"value":        1,
+				"acknowledged": pl.Acknowledged,
+				"f_cnt":        pl.FCnt,
+			},
+		},
+	}
+
+	if err := i.send(pl, measurements); err != nil {
+		return errors.Wrap(err, "sending measurements error")
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui": pl.DevEUI,
+	}).Info("integration/influxdb: ack measurements written")
+
 	return nil
 }
 
-// SendErrorNotification is not implemented.
+// SendErrorNotification writes the error.
 func (i *Integration) SendErrorNotification(pl integration.ErrorNotification) error {
+	measurements := []measurement{
+		{
+			Name: "device_error",
+			Tags: map[string]string{
+				"application_name": pl.ApplicationName,
+				"device_name":      pl.DeviceName,
+				"dev_eui":          pl.DevEUI.String(),
+				"type":             pl.Type,
+			},
+			Values: map[string]interface{}{
+				"value": 1,
+				"error": pl.Error,
+			},
+		},
+	}
+
+	if err := i.send(pl, measurements); err != nil {
+		return errors.Wrap(err, "sending measurements error")
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui": pl.DevEUI,
+	}).Info("integration/influxdb: error measurements written")
+
 	return nil
 }
 
-// SendLocationNotification is not implemented.
+// SendLocationNotification writes the device location.
 func (i *Integration) SendLocationNotification(pl integration.LocationNotification) error {
+	measurements := []measurement{
+		{
+			Name: "device_location",
+			Tags: map[string]string{
+				"application_name": pl.ApplicationName,
+				"device_name":      pl.DeviceName,
+				"dev_eui":          pl.DevEUI.String(),
+			},
+			Values: map[string]interface{}{
+				"latitude":  pl.Location.Latitude,
+				"longitude": pl.Location.Longitude,
+				"altitude":  pl.Location.Altitude,
+				"geohash":   geohash.Encode(pl.Location.Latitude, pl.Location.Longitude),
+			},
+		},
+	}
+
+	if err := i.send(pl, measurements); err != nil {
+		return errors.Wrap(err, "sending measurements error")
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui": pl.DevEUI,
+	}).Info("integration/influxdb: location measurements written")
+
 	return nil
 }
 
@@ -2,12 +2,17 @@ package influxdb
 
 import (
 	"bytes"
+	"compress/gzip"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -310,6 +315,37 @@ device_uplink,application_name=test-app,dev_eui=0102030405060708,device_name=tes
 			},
 			ExpectedBody: `device_frmpayload_data_gps_location_10_altitude,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev,f_port=20 value=3.123000
 device_frmpayload_data_gps_location_10_location,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev,f_port=20 geohash="s01w2k3vvqre",latitude=1.123000,longitude=2.123000
+device_uplink,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev,dr=2,frequency=868100000 f_cnt=10i,value=1i`,
+		},
+		{
+			Name: "Cayenne LPP extended types",
+			Payload: integration.DataUpPayload{
+				ApplicationName: "test-app",
+				DeviceName:      "test-dev",
+				DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				FCnt:            10,
+				FPort:           20,
+				TXInfo: integration.TXInfo{
+					Frequency: 868100000,
+					DR:        2,
+				},
+				Object: &codec.CayenneLPP{
+					Voltage: map[byte]float64{
+						1: 3.3,
+					},
+					Colour: map[byte]codec.Colour{
+						2: {R: 255, G: 128, B: 0},
+					},
+					Switch: map[byte]uint8{
+						3: 1,
+					},
+				},
+			},
+			ExpectedBody: `device_frmpayload_data_colour_2_b,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev,f_port=20 value=0i
+device_frmpayload_data_colour_2_g,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev,f_port=20 value=128i
+device_frmpayload_data_colour_2_r,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev,f_port=20 value=255i
+device_frmpayload_data_switch_3,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev,f_port=20 value=1i
+device_frmpayload_data_voltage_1,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev,f_port=20 value=3.300000
 device_uplink,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev,dr=2,frequency=868100000 f_cnt=10i,value=1i`,
 		},
 	}
@@ -343,3 +379,435 @@ device_uplink,application_name=test-app,dev_eui=0102030405060708,device_name=tes
 func TestHandler(t *testing.T) {
 	suite.Run(t, new(HandlerTestSuite))
 }
+
+func TestInfluxDB2(t *testing.T) {
+	assert := require.New(t)
+
+	requests := make(chan *http.Request, 10)
+	server := httptest.NewServer(&testHTTPHandler{requests: requests})
+	defer server.Close()
+
+	conf := Config{
+		Endpoint:     server.URL + "/api/v2/write",
+		Version:      Version2,
+		Organization: "test-org",
+		Bucket:       "test-bucket",
+		Token:        "secret",
+		Precision:    "u",
+		Gzip:         true,
+	}
+	assert.NoError(conf.Validate())
+
+	i, err := New(conf)
+	assert.NoError(err)
+	defer i.Close()
+
+	assert.NoError(i.SendStatusNotification(integration.StatusNotification{
+		ApplicationName:         "test-app",
+		DevEUI:                  lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		DeviceName:              "test-device",
+		Battery:                 123,
+		Margin:                  10,
+		BatteryLevelUnavailable: true,
+	}))
+
+	req := <-requests
+	assert.Equal("/api/v2/write", req.URL.Path)
+	assert.Equal(url.Values{
+		"org":       []string{"test-org"},
+		"bucket":    []string{"test-bucket"},
+		"precision": []string{"us"},
+	}, req.URL.Query())
+	assert.Equal("Token secret", req.Header.Get("Authorization"))
+	assert.Equal("gzip", req.Header.Get("Content-Encoding"))
+
+	r, err := gzip.NewReader(req.Body)
+	assert.NoError(err)
+	b, err := ioutil.ReadAll(r)
+	assert.NoError(err)
+	assert.Equal(`device_status_battery,application_name=test-app,dev_eui=0102030405060708,device_name=test-device value=123i
+device_status_margin,application_name=test-app,dev_eui=0102030405060708,device_name=test-device value=10i`, string(b))
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		Name          string
+		Config        Config
+		ExpectedError error
+	}{
+		{
+			Name:   "valid v1 config",
+			Config: Config{Precision: "s"},
+		},
+		{
+			Name:          "invalid version",
+			Config:        Config{Precision: "s", Version: "v3"},
+			ExpectedError: ErrInvalidVersion,
+		},
+		{
+			Name:          "v2 without bucket",
+			Config:        Config{Precision: "s", Version: Version2, Organization: "test-org"},
+			ExpectedError: ErrInvalidV2Config,
+		},
+		{
+			Name:          "v2 with unsupported precision",
+			Config:        Config{Precision: "h", Version: Version2, Organization: "test-org", Bucket: "test-bucket"},
+			ExpectedError: ErrInvalidPrecision,
+		},
+		{
+			Name:          "negative batch size",
+			Config:        Config{Precision: "s", BatchSize: -1},
+			ExpectedError: ErrInvalidBatchConfig,
+		},
+		{
+			Name:          "retry buffer size too large",
+			Config:        Config{Precision: "s", BatchSize: 10, RetryBufferSize: maxRetryBufferSize + 1},
+			ExpectedError: ErrInvalidBatchConfig,
+		},
+		{
+			Name:          "invalid tag template",
+			Config:        Config{Precision: "s", Tags: map[string]string{"foo": "{{.Foo"}},
+			ExpectedError: ErrInvalidTemplate,
+		},
+		{
+			Name:          "invalid measurement template",
+			Config:        Config{Precision: "s", MeasurementTemplate: "{{.Key"},
+			ExpectedError: ErrInvalidTemplate,
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+			assert.Equal(tst.ExpectedError, errors.Cause(tst.Config.Validate()))
+		})
+	}
+}
+
+type testStatusHTTPHandler struct {
+	sync.Mutex
+
+	statusCode int
+	bodies     chan string
+}
+
+func (h *testStatusHTTPHandler) setStatusCode(code int) {
+	h.Lock()
+	defer h.Unlock()
+	h.statusCode = code
+}
+
+func (h *testStatusHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.Lock()
+	defer h.Unlock()
+
+	b, _ := ioutil.ReadAll(r.Body)
+	h.bodies <- string(b)
+	w.WriteHeader(h.statusCode)
+}
+
+func TestBatching(t *testing.T) {
+	assert := require.New(t)
+
+	h := testStatusHTTPHandler{
+		statusCode: http.StatusOK,
+		bodies:     make(chan string, 10),
+	}
+	server := httptest.NewServer(&h)
+	defer server.Close()
+
+	i, err := New(Config{
+		Endpoint:        server.URL + "/write",
+		DB:              "loraserver",
+		Precision:       "s",
+		BatchSize:       4,
+		BatchInterval:   time.Hour,
+		RetryBufferSize: 2,
+	})
+	assert.NoError(err)
+
+	results := make(chan error, 10)
+	i.SetDeliveryFunc(func(latency time.Duration, err error) {
+		results <- err
+	})
+
+	status := integration.StatusNotification{
+		ApplicationName:         "test-app",
+		DevEUI:                  lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		DeviceName:              "test-device",
+		BatteryLevelUnavailable: true,
+	}
+
+	t.Run("Flush by size", func(t *testing.T) {
+		assert := require.New(t)
+
+		// 2 measurements per notification
+		assert.Equal(integration.ErrDeferred, errors.Cause(i.SendStatusNotification(status)))
+		select {
+		case <-h.bodies:
+			t.Fatal("unexpected write")
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		assert.Equal(integration.ErrDeferred, errors.Cause(i.SendStatusNotification(status)))
+		select {
+		case body := <-h.bodies:
+			lines := strings.Split(body, "\n")
+			assert.Len(lines, 4)
+			for _, line := range lines {
+				// line protocol + timestamp
+				assert.Len(strings.Split(line, " "), 3)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for write")
+		}
+		assert.NoError(<-results)
+	})
+
+	t.Run("Retry buffer", func(t *testing.T) {
+		assert := require.New(t)
+
+		h.setStatusCode(http.StatusInternalServerError)
+		assert.Equal(integration.ErrDeferred, errors.Cause(i.SendStatusNotification(status)))
+		assert.Equal(integration.ErrDeferred, errors.Cause(i.SendStatusNotification(status)))
+		assert.Equal(integration.ErrDeferred, errors.Cause(i.SendStatusNotification(status)))
+		assert.Error(i.Flush())
+		for len(h.bodies) > 0 {
+			<-h.bodies
+		}
+
+		// 6 measurements were written, the retry buffer keeps 2
+		assert.EqualValues(4, i.Dropped())
+
+		var dropped bool
+		for len(results) > 0 {
+			err := <-results
+			assert.Error(err)
+			if strings.Contains(err.Error(), "measurements dropped") {
+				dropped = true
+			}
+		}
+		assert.True(dropped)
+
+		// only the last 2 measurements are kept
+		h.setStatusCode(http.StatusOK)
+		assert.NoError(i.Flush())
+		body := <-h.bodies
+		assert.Len(strings.Split(body, "\n"), 2)
+		assert.NoError(<-results)
+	})
+
+	t.Run("Close flushes pending measurements", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.Equal(integration.ErrDeferred, errors.Cause(i.SendStatusNotification(status)))
+		assert.NoError(i.Close())
+
+		body := <-h.bodies
+		assert.Len(strings.Split(body, "\n"), 2)
+		assert.NoError(<-results)
+	})
+
+	t.Run("Send after close", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.Equal(ErrClosed, errors.Cause(i.SendStatusNotification(status)))
+		assert.NoError(i.Close())
+	})
+}
+
+func TestDefaultRetryBufferSize(t *testing.T) {
+	assert := require.New(t)
+
+	i, err := New(Config{
+		Endpoint:  "http://localhost:8086/write",
+		Precision: "s",
+		BatchSize: 10,
+	})
+	assert.NoError(err)
+	defer i.Close()
+
+	assert.Equal(defaultRetryBufferSize, i.config.RetryBufferSize)
+}
+
+func TestEvents(t *testing.T) {
+	assert := require.New(t)
+
+	requests := make(chan *http.Request, 10)
+	server := httptest.NewServer(&testHTTPHandler{requests: requests})
+	defer server.Close()
+
+	i, err := New(Config{
+		Endpoint:  server.URL + "/write",
+		Precision: "s",
+		Tags: map[string]string{
+			"application_id": "{{.ApplicationID}}",
+			"site":           "north wing",
+		},
+		MeasurementTemplate: "{{.ApplicationName}}_{{.Key}}",
+	})
+	assert.NoError(err)
+
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	tags := "application_id=1,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev"
+
+	tests := []struct {
+		Name         string
+		Send         func() error
+		ExpectedBody string
+	}{
+		{
+			Name: "join",
+			Send: func() error {
+				return i.SendJoinNotification(integration.JoinNotification{
+					ApplicationID:   1,
+					ApplicationName: "test-app",
+					DeviceName:      "test-dev",
+					DevEUI:          devEUI,
+					DevAddr:         lorawan.DevAddr{1, 2, 3, 4},
+				})
+			},
+			ExpectedBody: `device_join,` + tags + `,site=north\ wing dev_addr="01020304",value=1i`,
+		},
+		{
+			Name: "ack",
+			Send: func() error {
+				return i.SendACKNotification(integration.ACKNotification{
+					ApplicationID:   1,
+					ApplicationName: "test-app",
+					DeviceName:      "test-dev",
+					DevEUI:          devEUI,
+					Acknowledged:    true,
+					FCnt:            10,
+				})
+			},
+			ExpectedBody: `device_ack,` + tags + `,site=north\ wing acknowledged=true,f_cnt=10i,value=1i`,
+		},
+		{
+			Name: "error",
+			Send: func() error {
+				return i.SendErrorNotification(integration.ErrorNotification{
+					ApplicationID:   1,
+					ApplicationName: "test-app",
+					DeviceName:      "test-dev",
+					DevEUI:          devEUI,
+					Type:            "CODEC",
+					Error:           "boom",
+				})
+			},
+			ExpectedBody: `device_error,` + tags + `,site=north\ wing,type=CODEC error="boom",value=1i`,
+		},
+		{
+			Name: "location",
+			Send: func() error {
+				return i.SendLocationNotification(integration.LocationNotification{
+					ApplicationID:   1,
+					ApplicationName: "test-app",
+					DeviceName:      "test-dev",
+					DevEUI:          devEUI,
+					Location: integration.Location{
+						Latitude:  1.123,
+						Longitude: 2.123,
+						Altitude:  3.123,
+					},
+				})
+			},
+			ExpectedBody: `device_location,` + tags + `,site=north\ wing altitude=3.123000,geohash="s01w2k3vvqre",latitude=1.123000,longitude=2.123000`,
+		},
+		{
+			Name: "uplink with measurement template",
+			Send: func() error {
+				return i.SendDataUp(integration.DataUpPayload{
+					ApplicationID:   1,
+					ApplicationName: "test-app",
+					DeviceName:      "test-dev",
+					DevEUI:          devEUI,
+					FCnt:            10,
+					FPort:           20,
+					TXInfo: integration.TXInfo{
+						Frequency: 868100000,
+						DR:        2,
+					},
+					Object: map[string]interface{}{
+						"temperature": 25.4,
+					},
+				})
+			},
+			ExpectedBody: `device_uplink,` + tags + `,dr=2,frequency=868100000,site=north\ wing f_cnt=10i,value=1i
+test-app_temperature,` + tags + `,f_port=20,site=north\ wing value=25.400000`,
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+			assert.NoError(tst.Send())
+
+			req := <-requests
+			b, err := ioutil.ReadAll(req.Body)
+			assert.NoError(err)
+			assert.Equal(tst.ExpectedBody, string(b))
+		})
+	}
+}
+
+func TestTagVariables(t *testing.T) {
+	assert := require.New(t)
+
+	requests := make(chan *http.Request, 10)
+	server := httptest.NewServer(&testHTTPHandler{requests: requests})
+	defer server.Close()
+
+	i, err := New(Config{
+		Endpoint:  server.URL + "/write",
+		Precision: "s",
+		Tags: map[string]string{
+			"site": `{{index .Variables "site"}}`,
+		},
+	})
+	assert.NoError(err)
+
+	t.Run("With variable", func(t *testing.T) {
+		assert := require.New(t)
+		assert.NoError(i.SendJoinNotification(integration.JoinNotification{
+			ApplicationName: "test-app",
+			DeviceName:      "test-dev",
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			Variables: map[string]string{
+				"site": "north",
+			},
+		}))
+
+		b, err := ioutil.ReadAll((<-requests).Body)
+		assert.NoError(err)
+		assert.Equal(`device_join,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev,site=north dev_addr="00000000",value=1i`, string(b))
+	})
+
+	t.Run("Without variable", func(t *testing.T) {
+		assert := require.New(t)
+		assert.NoError(i.SendJoinNotification(integration.JoinNotification{
+			ApplicationName: "test-app",
+			DeviceName:      "test-dev",
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		}))
+
+		b, err := ioutil.ReadAll((<-requests).Body)
+		assert.NoError(err)
+		assert.Equal(`device_join,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev dev_addr="00000000",value=1i`, string(b))
+	})
+}
+
+func TestMeasurementString(t *testing.T) {
+	assert := require.New(t)
+
+	m := measurement{
+		Name: "my app,north_temperature",
+		Tags: map[string]string{
+			"dev_eui": "0102030405060708",
+		},
+		Values: map[string]interface{}{
+			"value": 21.5,
+		},
+	}
+	assert.Equal(`my\ app\,north_temperature,dev_eui=0102030405060708 value=21.500000`, m.String())
+}
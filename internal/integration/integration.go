@@ -1,11 +1,43 @@
 package integration
 
+import (
+	"context"
+	"errors"
+	"time"
+)
+
 // Handler kinds
 const (
 	HTTP     = "HTTP"
 	InfluxDB = "INFLUXDB"
 )
 
+// Global handler kinds
+const (
+	AWSSNS          = "AWS_SNS"
+	AzureServiceBus = "AZURE_SERVICE_BUS"
+	GCPPubSub       = "GCP_PUB_SUB"
+	MQTT            = "MQTT"
+)
+
+// ErrNotHandled is returned by an integration when it did not deliver the
+// event, e.g. when it is not configured for the given event type.
+var ErrNotHandled = errors.New("event not handled by integration")
+
+// ErrDeferred is returned by an integration when the event has been accepted,
+// but will be delivered asynchronously (e.g. batched). The result of the
+// delivery is reported to the DeliveryFunc of the integration.
+var ErrDeferred = errors.New("event delivery deferred")
+
+// DeliveryFunc is called with the result of an asynchronous delivery.
+type DeliveryFunc func(latency time.Duration, err error)
+
+// AsyncIntegrator is implemented by integrations which are able to deliver
+// events asynchronously.
+type AsyncIntegrator interface {
+	SetDeliveryFunc(f DeliveryFunc) // sets the func called on async delivery
+}
+
 // Integrator defines the interface that an intergration must implement.
 type Integrator interface {
 	SendDataUp(payload DataUpPayload) error                      // send data-up payload
@@ -32,3 +64,24 @@ func Integration() Integrator {
 func SetIntegration(i Integrator) {
 	integration = i
 }
+
+// SendDataDown sends the given payload to the given channel and blocks until
+// the payload has been handled. It returns the result of handling the
+// payload, or the context error when the context is cancelled first.
+func SendDataDown(ctx context.Context, c chan DataDownPayload, pl DataDownPayload) error {
+	result := make(chan error, 1)
+	pl.Result = result
+
+	select {
+	case c <- pl:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
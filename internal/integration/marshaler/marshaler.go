@@ -0,0 +1,367 @@
+// Package marshaler implements the encoding of the integration payloads.
+package marshaler
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	pb "github.com/brocaar/lora-app-server/api/integration"
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lorawan"
+)
+
+// Type defines the marshaler type.
+type Type string
+
+// Marshaler types.
+const (
+	// JSON encodes the payloads as the JSON objects defined in the
+	// integration package.
+	JSON Type = "json"
+
+	// Protobuf encodes the payloads using the messages defined in
+	// api/integration/integration.proto.
+	Protobuf Type = "protobuf"
+
+	// CloudEvents encodes the payloads as CloudEvents 1.0 (structured
+	// content mode), with the JSON payload as event data.
+	CloudEvents Type = "cloudevents"
+
+	// CloudEventsBinary encodes the payloads as CloudEvents 1.0 (binary
+	// content mode). The event attributes are returned as headers, which
+	// must be set by the transport (e.g. the HTTP headers).
+	CloudEventsBinary Type = "cloudevents_binary"
+)
+
+// Event types.
+const (
+	Uplink   = "up"
+	Join     = "join"
+	ACK      = "ack"
+	Error    = "error"
+	Status   = "status"
+	Location = "location"
+)
+
+// Content types.
+const (
+	ContentTypeJSON        = "application/json"
+	ContentTypeProtobuf    = "application/x-protobuf"
+	ContentTypeCloudEvents = "application/cloudevents+json"
+)
+
+const (
+	cloudEventsSpecVersion = "1.0"
+	cloudEventsTypePrefix  = "io.loraserver.application."
+)
+
+// Message contains a marshaled payload.
+type Message struct {
+	// ContentType holds the content-type of the data.
+	ContentType string
+
+	// Headers holds the headers which must be set by the transport (only
+	// set for the CloudEventsBinary type). The header names are without
+	// transport-specific prefix (e.g. "ce-" for HTTP).
+	Headers map[string]string
+
+	// Data holds the marshaled payload.
+	Data []byte
+}
+
+// Validate validates the marshaler type. An empty type is valid and
+// defaults to JSON.
+func (t Type) Validate() error {
+	switch t {
+	case "", JSON, Protobuf, CloudEvents, CloudEventsBinary:
+		return nil
+	default:
+		return fmt.Errorf("unknown marshaler: %s", t)
+	}
+}
+
+// Marshal marshals the given payload using the given marshaler type.
+// The event must be one of the event types defined in this package.
+func Marshal(t Type, event string, v interface{}) (Message, error) {
+	switch t {
+	case "", JSON:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return Message{}, errors.Wrap(err, "marshal json error")
+		}
+		return Message{ContentType: ContentTypeJSON, Data: b}, nil
+	case Protobuf:
+		msg, err := ToProto(v)
+		if err != nil {
+			return Message{}, err
+		}
+		b, err := proto.Marshal(msg)
+		if err != nil {
+			return Message{}, errors.Wrap(err, "marshal protobuf error")
+		}
+		return Message{ContentType: ContentTypeProtobuf, Data: b}, nil
+	case CloudEvents:
+		ce, err := newCloudEvent(event, v)
+		if err != nil {
+			return Message{}, err
+		}
+		b, err := json.Marshal(ce)
+		if err != nil {
+			return Message{}, errors.Wrap(err, "marshal json error")
+		}
+		return Message{ContentType: ContentTypeCloudEvents, Data: b}, nil
+	case CloudEventsBinary:
+		ce, err := newCloudEvent(event, v)
+		if err != nil {
+			return Message{}, err
+		}
+		return Message{
+			ContentType: ce.DataContentType,
+			Headers: map[string]string{
+				"specversion": ce.SpecVersion,
+				"type":        ce.Type,
+				"source":      ce.Source,
+				"subject":     ce.Subject,
+				"id":          ce.ID,
+				"time":        ce.Time.Format(time.RFC3339Nano),
+			},
+			Data: ce.Data,
+		}, nil
+	default:
+		return Message{}, fmt.Errorf("unknown marshaler: %s", t)
+	}
+}
+
+// cloudEvent implements the CloudEvents 1.0 JSON event format.
+type cloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	Type            string          `json:"type"`
+	Source          string          `json:"source"`
+	Subject         string          `json:"subject"`
+	ID              string          `json:"id"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	Data            json.RawMessage `json:"data"`
+}
+
+func newCloudEvent(event string, v interface{}) (cloudEvent, error) {
+	applicationID, devEUI, err := getApplicationIDAndDevEUI(v)
+	if err != nil {
+		return cloudEvent{}, err
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return cloudEvent{}, errors.Wrap(err, "new uuid error")
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return cloudEvent{}, errors.Wrap(err, "marshal json error")
+	}
+
+	return cloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		Type:            cloudEventsTypePrefix + event,
+		Source:          fmt.Sprintf("/applications/%d/devices/%s", applicationID, devEUI),
+		Subject:         devEUI.String(),
+		ID:              id.String(),
+		Time:            time.Now().UTC(),
+		DataContentType: ContentTypeJSON,
+		Data:            b,
+	}, nil
+}
+
+func getApplicationIDAndDevEUI(v interface{}) (int64, lorawan.EUI64, error) {
+	switch v := v.(type) {
+	case integration.DataUpPayload:
+		return v.ApplicationID, v.DevEUI, nil
+	case integration.JoinNotification:
+		return v.ApplicationID, v.DevEUI, nil
+	case integration.ACKNotification:
+		return v.ApplicationID, v.DevEUI, nil
+	case integration.ErrorNotification:
+		return v.ApplicationID, v.DevEUI, nil
+	case integration.StatusNotification:
+		return v.ApplicationID, v.DevEUI, nil
+	case integration.LocationNotification:
+		return v.ApplicationID, v.DevEUI, nil
+	default:
+		return 0, lorawan.EUI64{}, fmt.Errorf("unexpected payload type: %T", v)
+	}
+}
+
+// ToProto converts the given integration payload into its protobuf message.
+func ToProto(v interface{}) (proto.Message, error) {
+	switch v := v.(type) {
+	case integration.DataUpPayload:
+		return dataUpPayloadToProto(v)
+	case integration.JoinNotification:
+		return &pb.JoinEvent{
+			ApplicationId:   v.ApplicationID,
+			ApplicationName: v.ApplicationName,
+			DeviceName:      v.DeviceName,
+			DevEui:          v.DevEUI[:],
+			DevAddr:         v.DevAddr[:],
+		}, nil
+	case integration.ACKNotification:
+		return &pb.AckEvent{
+			ApplicationId:   v.ApplicationID,
+			ApplicationName: v.ApplicationName,
+			DeviceName:      v.DeviceName,
+			DevEui:          v.DevEUI[:],
+			Acknowledged:    v.Acknowledged,
+			FCnt:            v.FCnt,
+		}, nil
+	case integration.ErrorNotification:
+		return &pb.ErrorEvent{
+			ApplicationId:   v.ApplicationID,
+			ApplicationName: v.ApplicationName,
+			DeviceName:      v.DeviceName,
+			DevEui:          v.DevEUI[:],
+			Type:            v.Type,
+			Error:           v.Error,
+			FCnt:            v.FCnt,
+		}, nil
+	case integration.StatusNotification:
+		return &pb.StatusEvent{
+			ApplicationId:           v.ApplicationID,
+			ApplicationName:         v.ApplicationName,
+			DeviceName:              v.DeviceName,
+			DevEui:                  v.DevEUI[:],
+			Battery:                 uint32(v.Battery),
+			Margin:                  int32(v.Margin),
+			ExternalPowerSource:     v.ExternalPowerSource,
+			BatteryLevel:            v.BatteryLevel,
+			BatteryLevelUnavailable: v.BatteryLevelUnavailable,
+		}, nil
+	case integration.LocationNotification:
+		return &pb.LocationEvent{
+			ApplicationId:   v.ApplicationID,
+			ApplicationName: v.ApplicationName,
+			DeviceName:      v.DeviceName,
+			DevEui:          v.DevEUI[:],
+			Location:        locationToProto(v.Location),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unexpected payload type: %T", v)
+	}
+}
+
+func dataUpPayloadToProto(v integration.DataUpPayload) (*pb.UplinkEvent, error) {
+	out := pb.UplinkEvent{
+		ApplicationId:   v.ApplicationID,
+		ApplicationName: v.ApplicationName,
+		DeviceName:      v.DeviceName,
+		DevEui:          v.DevEUI[:],
+		DevAddr:         v.DevAddr[:],
+		UplinkId:        v.UplinkID.Bytes(),
+		TxInfo: &pb.TXInfo{
+			Frequency:  uint32(v.TXInfo.Frequency),
+			Dr:         uint32(v.TXInfo.DR),
+			Modulation: v.TXInfo.Modulation,
+		},
+		Adr:   v.ADR,
+		FCnt:  v.FCnt,
+		FPort: uint32(v.FPort),
+		Data:  v.Data,
+	}
+
+	if !v.ReceivedAt.IsZero() {
+		ts, err := ptypes.TimestampProto(v.ReceivedAt)
+		if err != nil {
+			return nil, errors.Wrap(err, "timestamp proto error")
+		}
+		out.ReceivedAt = ts
+	}
+
+	if mi := v.TXInfo.LoRaModulationInfo; mi != nil {
+		out.TxInfo.ModulationInfo = &pb.TXInfo_LoraModulationInfo{
+			LoraModulationInfo: &pb.LoRaModulationInfo{
+				Bandwidth:             uint32(mi.Bandwidth),
+				SpreadingFactor:       uint32(mi.SpreadingFactor),
+				CodeRate:              mi.CodeRate,
+				PolarizationInversion: mi.PolarizationInversion,
+			},
+		}
+	}
+
+	if mi := v.TXInfo.FSKModulationInfo; mi != nil {
+		out.TxInfo.ModulationInfo = &pb.TXInfo_FskModulationInfo{
+			FskModulationInfo: &pb.FSKModulationInfo{
+				Bandwidth: uint32(mi.Bandwidth),
+				Bitrate:   uint32(mi.Bitrate),
+			},
+		}
+	}
+
+	if v.Object != nil {
+		b, err := json.Marshal(v.Object)
+		if err != nil {
+			return nil, errors.Wrap(err, "marshal json error")
+		}
+		out.ObjectJson = string(b)
+	}
+
+	for i := range v.RXInfo {
+		rx := v.RXInfo[i]
+		rxInfo := pb.RXInfo{
+			GatewayId:         rx.GatewayID[:],
+			Name:              rx.Name,
+			Timestamp:         rx.Timestamp,
+			FineTimestampType: rx.FineTimestampType,
+			Rssi:              int32(rx.RSSI),
+			LoraSnr:           rx.LoRaSNR,
+			Channel:           uint32(rx.Channel),
+			RfChain:           uint32(rx.RFChain),
+			Board:             uint32(rx.Board),
+			Antenna:           uint32(rx.Antenna),
+		}
+
+		if rx.Time != nil {
+			ts, err := ptypes.TimestampProto(*rx.Time)
+			if err != nil {
+				return nil, errors.Wrap(err, "timestamp proto error")
+			}
+			rxInfo.Time = ts
+		}
+
+		if rx.FineTimestamp != nil {
+			ts, err := ptypes.TimestampProto(*rx.FineTimestamp)
+			if err != nil {
+				return nil, errors.Wrap(err, "timestamp proto error")
+			}
+			rxInfo.FineTimestamp = ts
+		}
+
+		if fts := rx.EncryptedFineTimestamp; fts != nil {
+			rxInfo.EncryptedFineTimestamp = &pb.EncryptedFineTimestamp{
+				AesKeyIndex: uint32(fts.AESKeyIndex),
+				EncryptedNs: fts.EncryptedNS,
+				FpgaId:      fts.FPGAID,
+			}
+		}
+
+		if rx.Location != nil {
+			rxInfo.Location = locationToProto(*rx.Location)
+		}
+
+		out.RxInfo = append(out.RxInfo, &rxInfo)
+	}
+
+	return &out, nil
+}
+
+func locationToProto(l integration.Location) *pb.Location {
+	return &pb.Location{
+		Latitude:  l.Latitude,
+		Longitude: l.Longitude,
+		Altitude:  l.Altitude,
+	}
+}
@@ -0,0 +1,192 @@
+package marshaler
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/brocaar/lora-app-server/api/integration"
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lorawan"
+)
+
+func TestMarshal(t *testing.T) {
+	now := time.Now().UTC()
+
+	uplinkID, err := uuid.NewV4()
+	require.NoError(t, err)
+
+	pl := integration.DataUpPayload{
+		UplinkID:        uplinkID,
+		ApplicationID:   123,
+		ApplicationName: "test-app",
+		DeviceName:      "test-device",
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		DevAddr:         lorawan.DevAddr{1, 2, 3, 4},
+		RXInfo: []integration.RXInfo{
+			{
+				GatewayID:         lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+				Name:              "test-gateway",
+				Time:              &now,
+				Timestamp:         12345,
+				FineTimestampType: "PLAIN",
+				FineTimestamp:     &now,
+				RSSI:              -60,
+				LoRaSNR:           5.5,
+				Channel:           2,
+				RFChain:           1,
+				Board:             3,
+				Antenna:           4,
+				Location: &integration.Location{
+					Latitude:  1.123,
+					Longitude: 2.123,
+					Altitude:  3.123,
+				},
+			},
+		},
+		TXInfo: integration.TXInfo{
+			Frequency:  868100000,
+			DR:         5,
+			Modulation: "LORA",
+			LoRaModulationInfo: &integration.LoRaModulationInfo{
+				Bandwidth:       125,
+				SpreadingFactor: 7,
+				CodeRate:        "4/5",
+			},
+		},
+		ReceivedAt: now,
+		FCnt:       10,
+		FPort:      20,
+		Data:       []byte{1, 2, 3},
+		Object: map[string]int{
+			"temperature": 21,
+		},
+	}
+	jsonB, err := json.Marshal(pl)
+	require.NoError(t, err)
+
+	t.Run("JSON", func(t *testing.T) {
+		assert := require.New(t)
+
+		for _, typ := range []Type{"", JSON} {
+			msg, err := Marshal(typ, Uplink, pl)
+			assert.NoError(err)
+			assert.Equal(ContentTypeJSON, msg.ContentType)
+			assert.Nil(msg.Headers)
+			assert.Equal(jsonB, msg.Data)
+		}
+	})
+
+	t.Run("Protobuf", func(t *testing.T) {
+		assert := require.New(t)
+
+		msg, err := Marshal(Protobuf, Uplink, pl)
+		assert.NoError(err)
+		assert.Equal(ContentTypeProtobuf, msg.ContentType)
+
+		var up pb.UplinkEvent
+		assert.NoError(proto.Unmarshal(msg.Data, &up))
+
+		ts, err := ptypes.TimestampProto(now)
+		assert.NoError(err)
+
+		assert.Equal(pb.UplinkEvent{
+			ApplicationId:   123,
+			ApplicationName: "test-app",
+			DeviceName:      "test-device",
+			DevEui:          []byte{1, 2, 3, 4, 5, 6, 7, 8},
+			DevAddr:         []byte{1, 2, 3, 4},
+			UplinkId:        uplinkID.Bytes(),
+			ReceivedAt:      ts,
+			RxInfo: []*pb.RXInfo{
+				{
+					GatewayId:         []byte{8, 7, 6, 5, 4, 3, 2, 1},
+					Name:              "test-gateway",
+					Time:              ts,
+					Timestamp:         12345,
+					FineTimestampType: "PLAIN",
+					FineTimestamp:     ts,
+					Rssi:              -60,
+					LoraSnr:           5.5,
+					Channel:           2,
+					RfChain:           1,
+					Board:             3,
+					Antenna:           4,
+					Location: &pb.Location{
+						Latitude:  1.123,
+						Longitude: 2.123,
+						Altitude:  3.123,
+					},
+				},
+			},
+			TxInfo: &pb.TXInfo{
+				Frequency:  868100000,
+				Dr:         5,
+				Modulation: "LORA",
+				ModulationInfo: &pb.TXInfo_LoraModulationInfo{
+					LoraModulationInfo: &pb.LoRaModulationInfo{
+						Bandwidth:       125,
+						SpreadingFactor: 7,
+						CodeRate:        "4/5",
+					},
+				},
+			},
+			FCnt:       10,
+			FPort:      20,
+			Data:       []byte{1, 2, 3},
+			ObjectJson: `{"temperature":21}`,
+		}, up)
+	})
+
+	t.Run("CloudEvents", func(t *testing.T) {
+		assert := require.New(t)
+
+		msg, err := Marshal(CloudEvents, Uplink, pl)
+		assert.NoError(err)
+		assert.Equal(ContentTypeCloudEvents, msg.ContentType)
+		assert.Nil(msg.Headers)
+
+		var ce cloudEvent
+		assert.NoError(json.Unmarshal(msg.Data, &ce))
+		assert.Equal("1.0", ce.SpecVersion)
+		assert.Equal("io.loraserver.application.up", ce.Type)
+		assert.Equal("/applications/123/devices/0102030405060708", ce.Source)
+		assert.Equal("0102030405060708", ce.Subject)
+		assert.NotEqual("", ce.ID)
+		assert.Equal(ContentTypeJSON, ce.DataContentType)
+		assert.JSONEq(string(jsonB), string(ce.Data))
+	})
+
+	t.Run("CloudEvents binary", func(t *testing.T) {
+		assert := require.New(t)
+
+		msg, err := Marshal(CloudEventsBinary, Join, integration.JoinNotification{
+			ApplicationID: 123,
+			DevEUI:        lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		})
+		assert.NoError(err)
+		assert.Equal(ContentTypeJSON, msg.ContentType)
+		assert.Equal("1.0", msg.Headers["specversion"])
+		assert.Equal("io.loraserver.application.join", msg.Headers["type"])
+		assert.Equal("/applications/123/devices/0102030405060708", msg.Headers["source"])
+		assert.NotEqual("", msg.Headers["id"])
+		assert.NotEqual("", msg.Headers["time"])
+
+		var pl integration.JoinNotification
+		assert.NoError(json.Unmarshal(msg.Data, &pl))
+		assert.Equal(int64(123), pl.ApplicationID)
+	})
+
+	t.Run("Invalid type", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := Marshal("foo", Uplink, pl)
+		assert.Error(err)
+		assert.Error(Type("foo").Validate())
+	})
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/gofrs/uuid"
+
 	"github.com/brocaar/lorawan"
 )
 
@@ -24,35 +26,78 @@ type Location struct {
 	Altitude  float64 `json:"altitude"`
 }
 
+// EncryptedFineTimestamp contains the encrypted fine-timestamp.
+type EncryptedFineTimestamp struct {
+	AESKeyIndex int    `json:"aesKeyIndex"`
+	EncryptedNS []byte `json:"encryptedNS"`
+	FPGAID      []byte `json:"fpgaID"`
+}
+
 // RXInfo contains the RX information.
 type RXInfo struct {
-	GatewayID lorawan.EUI64 `json:"gatewayID"`
-	Name      string        `json:"name"`
-	Time      *time.Time    `json:"time,omitempty"`
-	RSSI      int           `json:"rssi"`
-	LoRaSNR   float64       `json:"loRaSNR"`
-	Location  *Location     `json:"location"`
+	GatewayID              lorawan.EUI64           `json:"gatewayID"`
+	Name                   string                  `json:"name"`
+	Time                   *time.Time              `json:"time,omitempty"`
+	Timestamp              uint32                  `json:"timestamp"`
+	FineTimestampType      string                  `json:"fineTimestampType,omitempty"`
+	FineTimestamp          *time.Time              `json:"fineTimestamp,omitempty"`
+	EncryptedFineTimestamp *EncryptedFineTimestamp `json:"encryptedFineTimestamp,omitempty"`
+	RSSI                   int                     `json:"rssi"`
+	LoRaSNR                float64                 `json:"loRaSNR"`
+	Channel                int                     `json:"channel"`
+	RFChain                int                     `json:"rfChain"`
+	Board                  int                     `json:"board"`
+	Antenna                int                     `json:"antenna"`
+	Location               *Location               `json:"location"`
+}
+
+// LoRaModulationInfo contains the LoRa modulation information.
+type LoRaModulationInfo struct {
+	Bandwidth             int    `json:"bandwidth"`
+	SpreadingFactor       int    `json:"spreadingFactor"`
+	CodeRate              string `json:"codeRate"`
+	PolarizationInversion bool   `json:"polarizationInversion"`
+}
+
+// FSKModulationInfo contains the FSK modulation information.
+type FSKModulationInfo struct {
+	Bandwidth int `json:"bandwidth"`
+	Bitrate   int `json:"bitrate"`
 }
 
 // TXInfo contains the TX information.
 type TXInfo struct {
-	Frequency int `json:"frequency"`
-	DR        int `json:"dr"`
+	Frequency          int                 `json:"frequency"`
+	DR                 int                 `json:"dr"`
+	Modulation         string              `json:"modulation,omitempty"`
+	LoRaModulationInfo *LoRaModulationInfo `json:"loRaModulationInfo,omitempty"`
+	FSKModulationInfo  *FSKModulationInfo  `json:"fskModulationInfo,omitempty"`
 }
 
 // DataUpPayload represents a data-up payload.
+//
+// TODO: add the confirmed flag once the HandleUplinkData request of the
+// LoRa Server application-server API exposes the message-type.
 type DataUpPayload struct {
-	ApplicationID   int64         `json:"applicationID,string"`
-	ApplicationName string        `json:"applicationName"`
-	DeviceName      string        `json:"deviceName"`
-	DevEUI          lorawan.EUI64 `json:"devEUI"`
-	RXInfo          []RXInfo      `json:"rxInfo,omitempty"`
-	TXInfo          TXInfo        `json:"txInfo"`
-	ADR             bool          `json:"adr"`
-	FCnt            uint32        `json:"fCnt"`
-	FPort           uint8         `json:"fPort"`
-	Data            []byte        `json:"data"`
-	Object          interface{}   `json:"object,omitempty"`
+	UplinkID          uuid.UUID       `json:"uplinkID"`
+	ApplicationID     int64           `json:"applicationID,string"`
+	ApplicationName   string          `json:"applicationName"`
+	DeviceName        string          `json:"deviceName"`
+	DevEUI            lorawan.EUI64   `json:"devEUI"`
+	DevAddr           lorawan.DevAddr `json:"devAddr"`
+	RXInfo            []RXInfo        `json:"rxInfo,omitempty"`
+	TXInfo            TXInfo          `json:"txInfo"`
+	ADR               bool            `json:"adr"`
+	FCnt              uint32          `json:"fCnt"`
+	FPort             uint8           `json:"fPort"`
+	Data              []byte          `json:"data"`
+	Object            interface{}     `json:"object,omitempty"`
+	ObjectSchemaError string          `json:"objectSchemaError,omitempty"`
+	ReceivedAt        time.Time       `json:"receivedAt"`
+
+	// Variables contains the device variables. These are not published,
+	// but are available to the integration templates.
+	Variables map[string]string `json:"-"`
 }
 
 // DataDownPayload represents a data-down payload.
@@ -63,6 +108,11 @@ type DataDownPayload struct {
 	FPort         uint8           `json:"fPort"`
 	Data          []byte          `json:"data"`
 	Object        json.RawMessage `json:"object"`
+
+	// Result is optional. When set, the result of handling the payload is
+	// sent to this (buffered) channel, e.g. to acknowledge the message to
+	// the queue it was received from. See also SendDataDown.
+	Result chan error `json:"-"`
 }
 
 // JoinNotification defines the payload sent to the application on
@@ -73,6 +123,9 @@ type JoinNotification struct {
 	DeviceName      string          `json:"deviceName"`
 	DevEUI          lorawan.EUI64   `json:"devEUI"`
 	DevAddr         lorawan.DevAddr `json:"devAddr"`
+
+	// Device variables (not published).
+	Variables map[string]string `json:"-"`
 }
 
 // ACKNotification defines the payload sent to the application
@@ -84,6 +137,9 @@ type ACKNotification struct {
 	DevEUI          lorawan.EUI64 `json:"devEUI"`
 	Acknowledged    bool          `json:"acknowledged"`
 	FCnt            uint32        `json:"fCnt"`
+
+	// Device variables (not published).
+	Variables map[string]string `json:"-"`
 }
 
 // ErrorNotification defines the payload sent to the application
@@ -96,6 +152,9 @@ type ErrorNotification struct {
 	Type            string        `json:"type"`
 	Error           string        `json:"error"`
 	FCnt            uint32        `json:"fCnt,omitempty"`
+
+	// Device variables (not published).
+	Variables map[string]string `json:"-"`
 }
 
 // StatusNotification defines the payload sent to the application
@@ -110,6 +169,9 @@ type StatusNotification struct {
 	ExternalPowerSource     bool          `json:"externalPowerSource"`
 	BatteryLevel            float32       `json:"batteryLevel"`
 	BatteryLevelUnavailable bool          `json:"batteryLevelUnavailable"`
+
+	// Device variables (not published).
+	Variables map[string]string `json:"-"`
 }
 
 // LocationNotification defines the payload sent to the application after
@@ -120,4 +182,7 @@ type LocationNotification struct {
 	DeviceName      string        `json:"deviceName"`
 	DevEUI          lorawan.EUI64 `json:"devEUI"`
 	Location        Location      `json:"location"`
+
+	// Device variables (not published).
+	Variables map[string]string `json:"-"`
 }
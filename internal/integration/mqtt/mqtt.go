@@ -20,6 +20,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/integration/marshaler"
 	"github.com/brocaar/lorawan"
 )
 
@@ -30,25 +31,26 @@ type Config struct {
 	Server                  string
 	Username                string
 	Password                string
-	QOS                     uint8  `mapstructure:"qos"`
-	CleanSession            bool   `mapstructure:"clean_session"`
-	ClientID                string `mapstructure:"client_id"`
-	CACert                  string `mapstructure:"ca_cert"`
-	TLSCert                 string `mapstructure:"tls_cert"`
-	TLSKey                  string `mapstructure:"tls_key"`
-	UplinkTopicTemplate     string `mapstructure:"uplink_topic_template"`
-	DownlinkTopicTemplate   string `mapstructure:"downlink_topic_template"`
-	JoinTopicTemplate       string `mapstructure:"join_topic_template"`
-	AckTopicTemplate        string `mapstructure:"ack_topic_template"`
-	ErrorTopicTemplate      string `mapstructure:"error_topic_template"`
-	StatusTopicTemplate     string `mapstructure:"status_topic_template"`
-	LocationTopicTemplate   string `mapstructure:"location_topic_template"`
-	UplinkRetainedMessage   bool   `mapstructure:"uplink_retained_message"`
-	JoinRetainedMessage     bool   `mapstructure:"join_retained_message"`
-	AckRetainedMessage      bool   `mapstructure:"ack_retained_message"`
-	ErrorRetainedMessage    bool   `mapstructure:"error_retained_message"`
-	StatusRetainedMessage   bool   `mapstructure:"status_retained_message"`
-	LocationRetainedMessage bool   `mapstructure:"location_retained_message"`
+	QOS                     uint8          `mapstructure:"qos"`
+	CleanSession            bool           `mapstructure:"clean_session"`
+	ClientID                string         `mapstructure:"client_id"`
+	CACert                  string         `mapstructure:"ca_cert"`
+	TLSCert                 string         `mapstructure:"tls_cert"`
+	TLSKey                  string         `mapstructure:"tls_key"`
+	UplinkTopicTemplate     string         `mapstructure:"uplink_topic_template"`
+	DownlinkTopicTemplate   string         `mapstructure:"downlink_topic_template"`
+	JoinTopicTemplate       string         `mapstructure:"join_topic_template"`
+	AckTopicTemplate        string         `mapstructure:"ack_topic_template"`
+	ErrorTopicTemplate      string         `mapstructure:"error_topic_template"`
+	StatusTopicTemplate     string         `mapstructure:"status_topic_template"`
+	LocationTopicTemplate   string         `mapstructure:"location_topic_template"`
+	UplinkRetainedMessage   bool           `mapstructure:"uplink_retained_message"`
+	JoinRetainedMessage     bool           `mapstructure:"join_retained_message"`
+	AckRetainedMessage      bool           `mapstructure:"ack_retained_message"`
+	ErrorRetainedMessage    bool           `mapstructure:"error_retained_message"`
+	StatusRetainedMessage   bool           `mapstructure:"status_retained_message"`
+	LocationRetainedMessage bool           `mapstructure:"location_retained_message"`
+	Marshaler               marshaler.Type `mapstructure:"marshaler"`
 }
 
 // Integration implements a MQTT integration.
@@ -84,6 +86,12 @@ func New(p *redis.Pool, conf Config) (*Integration, error) {
 		config:       conf,
 	}
 
+	switch i.config.Marshaler {
+	case "", marshaler.JSON, marshaler.Protobuf, marshaler.CloudEvents:
+	default:
+		return nil, fmt.Errorf("marshaler %s is not supported by the mqtt integration", i.config.Marshaler)
+	}
+
 	i.uplinkTemplate, err = template.New("uplink").Parse(i.config.UplinkTopicTemplate)
 	if err != nil {
 		return nil, errors.Wrap(err, "parse uplink template error")
@@ -232,35 +240,35 @@ func (i *Integration) Close() error {
 
 // SendDataUp sends a DataUpPayload.
 func (i *Integration) SendDataUp(payload integration.DataUpPayload) error {
-	return i.publish(payload.ApplicationID, payload.DevEUI, i.uplinkTemplate, i.uplinkRetained, payload)
+	return i.publish(marshaler.Uplink, payload.ApplicationID, payload.DevEUI, i.uplinkTemplate, i.uplinkRetained, payload)
 }
 
 // SendJoinNotification sends a JoinNotification.
 func (i *Integration) SendJoinNotification(payload integration.JoinNotification) error {
-	return i.publish(payload.ApplicationID, payload.DevEUI, i.joinTemplate, i.joinRetained, payload)
+	return i.publish(marshaler.Join, payload.ApplicationID, payload.DevEUI, i.joinTemplate, i.joinRetained, payload)
 }
 
 // SendACKNotification sends an ACKNotification.
 func (i *Integration) SendACKNotification(payload integration.ACKNotification) error {
-	return i.publish(payload.ApplicationID, payload.DevEUI, i.ackTemplate, i.ackRetained, payload)
+	return i.publish(marshaler.ACK, payload.ApplicationID, payload.DevEUI, i.ackTemplate, i.ackRetained, payload)
 }
 
 // SendErrorNotification sends an ErrorNotification.
 func (i *Integration) SendErrorNotification(payload integration.ErrorNotification) error {
-	return i.publish(payload.ApplicationID, payload.DevEUI, i.errorTemplate, i.errorRetained, payload)
+	return i.publish(marshaler.Error, payload.ApplicationID, payload.DevEUI, i.errorTemplate, i.errorRetained, payload)
 }
 
 // SendStatusNotification sends a StatusNotification.
 func (i *Integration) SendStatusNotification(payload integration.StatusNotification) error {
-	return i.publish(payload.ApplicationID, payload.DevEUI, i.statusTemplate, i.statusRetained, payload)
+	return i.publish(marshaler.Status, payload.ApplicationID, payload.DevEUI, i.statusTemplate, i.statusRetained, payload)
 }
 
 // SendLocationNotification sends a LocationNotification.
 func (i *Integration) SendLocationNotification(payload integration.LocationNotification) error {
-	return i.publish(payload.ApplicationID, payload.DevEUI, i.locationTemplate, i.locationRetained, payload)
+	return i.publish(marshaler.Location, payload.ApplicationID, payload.DevEUI, i.locationTemplate, i.locationRetained, payload)
 }
 
-func (i *Integration) publish(applicationID int64, devEUI lorawan.EUI64, topicTemplate *template.Template, retained bool, v interface{}) error {
+func (i *Integration) publish(event string, applicationID int64, devEUI lorawan.EUI64, topicTemplate *template.Template, retained bool, v interface{}) error {
 	topic := bytes.NewBuffer(nil)
 	err := topicTemplate.Execute(topic, struct {
 		ApplicationID int64
@@ -270,16 +278,16 @@ func (i *Integration) publish(applicationID int64, devEUI lorawan.EUI64, topicTe
 		return errors.Wrap(err, "execute template error")
 	}
 
-	jsonB, err := json.Marshal(v)
+	msg, err := marshaler.Marshal(i.config.Marshaler, event, v)
 	if err != nil {
-		return err
+		return errors.Wrap(err, "marshal payload error")
 	}
 
 	log.WithFields(log.Fields{
 		"topic": topic.String(),
 		"qos":   i.config.QOS,
 	}).Info("integration/mqtt: publishing message")
-	if token := i.conn.Publish(topic.String(), i.config.QOS, retained, jsonB); token.Wait() && token.Error() != nil {
+	if token := i.conn.Publish(topic.String(), i.config.QOS, retained, msg.Data); token.Wait() && token.Error() != nil {
 		return token.Error()
 	}
 
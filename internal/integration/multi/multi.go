@@ -4,6 +4,8 @@ package multi
 
 import (
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -15,38 +17,70 @@ import (
 	"github.com/brocaar/lora-app-server/internal/integration/http"
 	"github.com/brocaar/lora-app-server/internal/integration/influxdb"
 	"github.com/brocaar/lora-app-server/internal/integration/mqtt"
+	"github.com/brocaar/lora-app-server/internal/integration/stats"
 	"github.com/brocaar/lora-app-server/internal/storage"
 )
 
 // Integration implements the multi integration.
 type Integration struct {
-	integrations []integration.Integrator
+	applicationID int64
+	integrations  []item
+
+	// sending tracks the sends which are in progress, Close waits for these
+	// before closing the integrations.
+	sending sync.WaitGroup
+
+	dataDownOnce sync.Once
+	dataDownChan chan integration.DataDownPayload
+}
+
+// item holds an integration and its kind, used for recording the delivery
+// statistics. The kind is empty for integrations added using Add.
+type item struct {
+	kind       string
+	integrator integration.Integrator
 }
 
 // New create a new multi integration.
 // The argument that must be given is a slice of configuration objects for
 // the handlers to setup.
 func New(confs []interface{}) (*Integration, error) {
-	var integrations []integration.Integrator
+	return NewForApplication(0, confs)
+}
+
+// NewForApplication creates a new multi integration for the integrations
+// of the given application. The delivery statistics are recorded for the
+// given application ID.
+func NewForApplication(applicationID int64, confs []interface{}) (*Integration, error) {
+	out := Integration{
+		applicationID: applicationID,
+	}
 
 	for i := range confs {
 		conf := confs[i]
 		var ii integration.Integrator
+		var kind string
 		var err error
 
 		switch v := conf.(type) {
 		case awssns.Config:
 			ii, err = awssns.New(v)
+			kind = integration.AWSSNS
 		case azureservicebus.Config:
 			ii, err = azureservicebus.New(v)
+			kind = integration.AzureServiceBus
 		case gcppubsub.Config:
 			ii, err = gcppubsub.New(v)
+			kind = integration.GCPPubSub
 		case http.Config:
 			ii, err = http.New(v)
+			kind = integration.HTTP
 		case influxdb.Config:
 			ii, err = influxdb.New(v)
+			kind = integration.InfluxDB
 		case mqtt.Config:
 			ii, err = mqtt.New(storage.RedisPool(), v)
+			kind = integration.MQTT
 		default:
 			return nil, fmt.Errorf("unknown configuration type %T", conf)
 		}
@@ -55,111 +89,173 @@ func New(confs []interface{}) (*Integration, error) {
 			return nil, errors.Wrap(err, "new integration error")
 		}
 
-		integrations = append(integrations, ii)
+		// the results of asynchronous deliveries are reported afterwards
+		if a, ok := ii.(integration.AsyncIntegrator); ok {
+			kind := kind
+			a.SetDeliveryFunc(func(latency time.Duration, err error) {
+				out.record(kind, latency, err)
+			})
+		}
+
+		out.integrations = append(out.integrations, item{kind: kind, integrator: ii})
 	}
 
-	return &Integration{
-		integrations: integrations,
-	}, nil
+	return &out, nil
 }
 
 // Add appends a new integration to the list.
 func (i *Integration) Add(intg integration.Integrator) {
-	i.integrations = append(i.integrations, intg)
+	i.integrations = append(i.integrations, item{integrator: intg})
 }
 
-// SendDataUp sends a data-up payload.
-func (i *Integration) SendDataUp(pl integration.DataUpPayload) error {
+// send calls f for each integration in a separate goroutine and records
+// the delivery statistics. Events not handled by an integration are not
+// recorded, deferred deliveries are recorded by the DeliveryFunc.
+func (i *Integration) send(f func(integration.Integrator) error) {
 	for _, ii := range i.integrations {
-		go func(i integration.Integrator) {
-			if err := i.SendDataUp(pl); err != nil {
-				log.WithError(err).Errorf("integration/multi: integration %T error", i)
+		i.sending.Add(1)
+		go func(ii item) {
+			defer i.sending.Done()
+
+			start := time.Now()
+			err := f(ii.integrator)
+			latency := time.Since(start)
+
+			// the integration did not deliver the event (yet), e.g. because
+			// it is not configured for this event type or because the
+			// result is reported asynchronously
+			switch errors.Cause(err) {
+			case integration.ErrNotHandled, integration.ErrDeferred:
+				return
+			}
+
+			if err != nil {
+				log.WithError(err).Errorf("integration/multi: integration %T error", ii.integrator)
 			}
+
+			i.record(ii.kind, latency, err)
 		}(ii)
 	}
+}
+
+// record records the delivery statistics for the given integration kind.
+func (i *Integration) record(kind string, latency time.Duration, err error) {
+	if kind == "" {
+		return
+	}
+
+	if err := stats.Record(i.applicationID, kind, latency, err); err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"application_id": i.applicationID,
+			"kind":           kind,
+		}).Error("integration/multi: record stats error")
+	}
+}
+
+// SendDataUp sends a data-up payload.
+func (i *Integration) SendDataUp(pl integration.DataUpPayload) error {
+	i.send(func(ii integration.Integrator) error {
+		return ii.SendDataUp(pl)
+	})
 
 	return nil
 }
 
 // SendJoinNotification sends a join notification.
 func (i *Integration) SendJoinNotification(pl integration.JoinNotification) error {
-	for _, ii := range i.integrations {
-		go func(i integration.Integrator) {
-			if err := i.SendJoinNotification(pl); err != nil {
-				log.WithError(err).Errorf("integration/multi: integration %T error", i)
-			}
-		}(ii)
-	}
+	i.send(func(ii integration.Integrator) error {
+		return ii.SendJoinNotification(pl)
+	})
 
 	return nil
 }
 
 // SendACKNotification sends an ACK notification.
 func (i *Integration) SendACKNotification(pl integration.ACKNotification) error {
-	for _, ii := range i.integrations {
-		go func(i integration.Integrator) {
-			if err := i.SendACKNotification(pl); err != nil {
-				log.WithError(err).Errorf("integration/multi: integration %T error", i)
-			}
-		}(ii)
-	}
+	i.send(func(ii integration.Integrator) error {
+		return ii.SendACKNotification(pl)
+	})
 
 	return nil
 }
 
 // SendErrorNotification sends an error notification.
 func (i *Integration) SendErrorNotification(pl integration.ErrorNotification) error {
-	for _, ii := range i.integrations {
-		go func(i integration.Integrator) {
-			if err := i.SendErrorNotification(pl); err != nil {
-				log.WithError(err).Errorf("integration/multi: integration %T error", i)
-			}
-		}(ii)
-	}
+	i.send(func(ii integration.Integrator) error {
+		return ii.SendErrorNotification(pl)
+	})
 
 	return nil
 }
 
 // SendStatusNotification sends a status notification.
 func (i *Integration) SendStatusNotification(pl integration.StatusNotification) error {
-	for _, ii := range i.integrations {
-		go func(i integration.Integrator) {
-			if err := i.SendStatusNotification(pl); err != nil {
-				log.WithError(err).Errorf("integration/multi: integration %T error", i)
-			}
-		}(ii)
-	}
+	i.send(func(ii integration.Integrator) error {
+		return ii.SendStatusNotification(pl)
+	})
 
 	return nil
 }
 
 // SendLocationNotification sends a location notification.
 func (i *Integration) SendLocationNotification(pl integration.LocationNotification) error {
-	for _, ii := range i.integrations {
-		go func(i integration.Integrator) {
-			if err := i.SendLocationNotification(pl); err != nil {
-				log.WithError(err).Errorf("integration/multi: integration %T error", i)
-			}
-		}(ii)
-	}
+	i.send(func(ii integration.Integrator) error {
+		return ii.SendLocationNotification(pl)
+	})
 
 	return nil
 }
 
-// DataDownChan returns the channel containing the received DataDownPayload.
+// DataDownChan returns the channel containing the received DataDownPayload
+// of all the integrations. It returns nil when none of the integrations
+// receives downlink payloads.
 func (i *Integration) DataDownChan() chan integration.DataDownPayload {
-	for _, ii := range i.integrations {
-		if c := ii.DataDownChan(); c != nil {
-			return c
+	i.dataDownOnce.Do(func() {
+		var chans []chan integration.DataDownPayload
+		for _, ii := range i.integrations {
+			if c := ii.integrator.DataDownChan(); c != nil {
+				chans = append(chans, c)
+			}
 		}
-	}
-	return nil
+
+		switch len(chans) {
+		case 0:
+			return
+		case 1:
+			i.dataDownChan = chans[0]
+			return
+		}
+
+		// merge the channels, the merged channel is closed once all
+		// integration channels are closed
+		var wg sync.WaitGroup
+		i.dataDownChan = make(chan integration.DataDownPayload)
+
+		for _, c := range chans {
+			wg.Add(1)
+			go func(c chan integration.DataDownPayload) {
+				defer wg.Done()
+				for pl := range c {
+					i.dataDownChan <- pl
+				}
+			}(c)
+		}
+
+		go func() {
+			wg.Wait()
+			close(i.dataDownChan)
+		}()
+	})
+
+	return i.dataDownChan
 }
 
-// Close closes the handlers.
+// Close closes the handlers, after the sends in progress have completed.
 func (i *Integration) Close() error {
+	i.sending.Wait()
+
 	for _, ii := range i.integrations {
-		if err := ii.Close(); err != nil {
+		if err := ii.integrator.Close(); err != nil {
 			return err
 		}
 	}
@@ -13,7 +13,9 @@ import (
 
 	"github.com/brocaar/lora-app-server/internal/integration"
 	httpint "github.com/brocaar/lora-app-server/internal/integration/http"
+	"github.com/brocaar/lora-app-server/internal/integration/mock"
 	mqttint "github.com/brocaar/lora-app-server/internal/integration/mqtt"
+	"github.com/brocaar/lora-app-server/internal/integration/stats"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lora-app-server/internal/test"
 	"github.com/brocaar/lorawan"
@@ -187,3 +189,95 @@ func (ts *IntegrationTestSuite) TestLocationNotification() {
 func TestIntegration(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
+
+func TestDataDownChan(t *testing.T) {
+	t.Run("No downlink integrations", func(t *testing.T) {
+		assert := require.New(t)
+
+		i, err := New(nil)
+		assert.NoError(err)
+		assert.Nil(i.DataDownChan())
+	})
+
+	t.Run("Multiple downlink integrations", func(t *testing.T) {
+		assert := require.New(t)
+
+		m1 := mock.New()
+		m2 := mock.New()
+
+		i, err := New(nil)
+		assert.NoError(err)
+		i.Add(m1)
+		i.Add(m2)
+
+		m1.DataDownPayloadChan <- integration.DataDownPayload{ApplicationID: 1}
+		assert.EqualValues(1, (<-i.DataDownChan()).ApplicationID)
+
+		m2.DataDownPayloadChan <- integration.DataDownPayload{ApplicationID: 2}
+		assert.EqualValues(2, (<-i.DataDownChan()).ApplicationID)
+
+		close(m1.DataDownPayloadChan)
+		close(m2.DataDownPayloadChan)
+		_, ok := <-i.DataDownChan()
+		assert.False(ok)
+	})
+}
+
+func TestStats(t *testing.T) {
+	assert := require.New(t)
+
+	conf := test.GetConfig()
+	assert.NoError(storage.Setup(conf))
+	test.MustFlushRedis(storage.RedisPool())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	t.Run("Not handled", func(t *testing.T) {
+		assert := require.New(t)
+
+		i, err := NewForApplication(1, []interface{}{
+			httpint.Config{
+				DataUpURL: server.URL,
+			},
+		})
+		assert.NoError(err)
+
+		assert.NoError(i.SendErrorNotification(integration.ErrorNotification{
+			ApplicationID: 1,
+			DevEUI:        lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		}))
+		assert.NoError(i.Close())
+
+		s, err := stats.Get(1, integration.HTTP)
+		assert.NoError(err)
+		assert.EqualValues(0, s.SuccessCount)
+		assert.EqualValues(0, s.ErrorCount)
+		assert.Nil(s.LastSuccessAt)
+	})
+
+	t.Run("Delivered", func(t *testing.T) {
+		assert := require.New(t)
+
+		i, err := NewForApplication(1, []interface{}{
+			httpint.Config{
+				DataUpURL: server.URL,
+			},
+		})
+		assert.NoError(err)
+
+		assert.NoError(i.SendDataUp(integration.DataUpPayload{
+			ApplicationID: 1,
+			DevEUI:        lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		}))
+		assert.NoError(i.Close())
+
+		s, err := stats.Get(1, integration.HTTP)
+		assert.NoError(err)
+		assert.EqualValues(1, s.SuccessCount)
+		assert.EqualValues(0, s.ErrorCount)
+		assert.NotNil(s.LastSuccessAt)
+	})
+}
@@ -0,0 +1,205 @@
+// Package stats implements the integration delivery statistics.
+// The statistics are stored in Redis, per integration kind, for the global
+// integrations and for each application.
+package stats
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+const (
+	globalStatsKeyTempl      = "lora:as:integration:%s:stats"
+	applicationStatsKeyTempl = "lora:as:application:%d:integration:%s:stats"
+	statsIndexKey            = "lora:as:integration:stats:index"
+)
+
+// hash fields
+const (
+	successCountField  = "success_count"
+	errorCountField    = "error_count"
+	latencySumField    = "latency_sum"
+	lastSuccessAtField = "last_success_at"
+	lastErrorAtField   = "last_error_at"
+	lastErrorField     = "last_error"
+)
+
+// Stats contains the delivery statistics of an integration.
+// An ApplicationID of 0 indicates a global integration.
+type Stats struct {
+	ApplicationID  int64
+	Kind           string
+	SuccessCount   int64
+	ErrorCount     int64
+	LastSuccessAt  *time.Time
+	LastErrorAt    *time.Time
+	LastError      string
+	AverageLatency time.Duration
+}
+
+// Record records the result of a single delivery. For global integrations,
+// applicationID must be set to 0.
+func Record(applicationID int64, kind string, latency time.Duration, deliveryErr error) error {
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	key := statsKey(applicationID, kind)
+	now := time.Now().UnixNano()
+
+	c.Send("MULTI")
+	if deliveryErr == nil {
+		c.Send("HINCRBY", key, successCountField, 1)
+		c.Send("HSET", key, lastSuccessAtField, now)
+	} else {
+		c.Send("HINCRBY", key, errorCountField, 1)
+		c.Send("HMSET", key, lastErrorAtField, now, lastErrorField, deliveryErr.Error())
+	}
+	c.Send("HINCRBY", key, latencySumField, int64(latency))
+	c.Send("SADD", statsIndexKey, indexMember(applicationID, kind))
+	if _, err := c.Do("EXEC"); err != nil {
+		return errors.Wrap(err, "exec error")
+	}
+
+	return nil
+}
+
+// Get returns the statistics for the given integration. When no statistics
+// have been recorded yet, the counters are set to 0.
+func Get(applicationID int64, kind string) (Stats, error) {
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	return get(c, applicationID, kind)
+}
+
+// List returns the statistics of all integrations, ordered by application ID
+// and kind. The global integrations are returned first.
+func List() ([]Stats, error) {
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	members, err := redis.Strings(c.Do("SMEMBERS", statsIndexKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "smembers error")
+	}
+
+	var out []Stats
+	for _, m := range members {
+		applicationID, kind, err := parseIndexMember(m)
+		if err != nil {
+			return nil, err
+		}
+
+		s, err := get(c, applicationID, kind)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, s)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].ApplicationID == out[j].ApplicationID {
+			return out[i].Kind < out[j].Kind
+		}
+		return out[i].ApplicationID < out[j].ApplicationID
+	})
+
+	return out, nil
+}
+
+// Delete deletes the statistics for the given integration.
+func Delete(applicationID int64, kind string) error {
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	c.Send("MULTI")
+	c.Send("DEL", statsKey(applicationID, kind))
+	c.Send("SREM", statsIndexKey, indexMember(applicationID, kind))
+	if _, err := c.Do("EXEC"); err != nil {
+		return errors.Wrap(err, "exec error")
+	}
+
+	return nil
+}
+
+func get(c redis.Conn, applicationID int64, kind string) (Stats, error) {
+	s := Stats{
+		ApplicationID: applicationID,
+		Kind:          kind,
+	}
+
+	values, err := redis.StringMap(c.Do("HGETALL", statsKey(applicationID, kind)))
+	if err != nil {
+		return s, errors.Wrap(err, "hgetall error")
+	}
+
+	var latencySum int64
+	for k, v := range values {
+		switch k {
+		case successCountField:
+			s.SuccessCount, err = strconv.ParseInt(v, 10, 64)
+		case errorCountField:
+			s.ErrorCount, err = strconv.ParseInt(v, 10, 64)
+		case latencySumField:
+			latencySum, err = strconv.ParseInt(v, 10, 64)
+		case lastSuccessAtField:
+			s.LastSuccessAt, err = parseTime(v)
+		case lastErrorAtField:
+			s.LastErrorAt, err = parseTime(v)
+		case lastErrorField:
+			s.LastError = v
+		}
+		if err != nil {
+			return s, errors.Wrapf(err, "parse %s error", k)
+		}
+	}
+
+	if count := s.SuccessCount + s.ErrorCount; count != 0 {
+		s.AverageLatency = time.Duration(latencySum / count)
+	}
+
+	return s, nil
+}
+
+func statsKey(applicationID int64, kind string) string {
+	if applicationID == 0 {
+		return fmt.Sprintf(globalStatsKeyTempl, kind)
+	}
+	return fmt.Sprintf(applicationStatsKeyTempl, applicationID, kind)
+}
+
+func indexMember(applicationID int64, kind string) string {
+	return fmt.Sprintf("%d:%s", applicationID, kind)
+}
+
+func parseIndexMember(m string) (int64, string, error) {
+	parts := strings.SplitN(m, ":", 2)
+	if len(parts) != 2 {
+		return 0, "", fmt.Errorf("invalid index member: %s", m)
+	}
+
+	applicationID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, "", errors.Wrap(err, "parse application id error")
+	}
+
+	return applicationID, parts[1], nil
+}
+
+func parseTime(v string) (*time.Time, error) {
+	ns, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	t := time.Unix(0, ns)
+	return &t, nil
+}
@@ -0,0 +1,71 @@
+package stats
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func TestStats(t *testing.T) {
+	assert := require.New(t)
+
+	conf := test.GetConfig()
+	assert.NoError(storage.Setup(conf))
+	test.MustFlushRedis(storage.RedisPool())
+
+	t.Run("No stats", func(t *testing.T) {
+		assert := require.New(t)
+
+		s, err := Get(1, "HTTP")
+		assert.NoError(err)
+		assert.Equal(Stats{ApplicationID: 1, Kind: "HTTP"}, s)
+	})
+
+	t.Run("Record", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.NoError(Record(1, "HTTP", 10*time.Millisecond, nil))
+		assert.NoError(Record(1, "HTTP", 30*time.Millisecond, errors.New("boom")))
+		assert.NoError(Record(0, "MQTT", time.Millisecond, nil))
+
+		s, err := Get(1, "HTTP")
+		assert.NoError(err)
+		assert.EqualValues(1, s.SuccessCount)
+		assert.EqualValues(1, s.ErrorCount)
+		assert.Equal("boom", s.LastError)
+		assert.Equal(20*time.Millisecond, s.AverageLatency)
+		assert.NotNil(s.LastSuccessAt)
+		assert.NotNil(s.LastErrorAt)
+
+		t.Run("List", func(t *testing.T) {
+			assert := require.New(t)
+
+			items, err := List()
+			assert.NoError(err)
+			assert.Len(items, 2)
+			assert.EqualValues(0, items[0].ApplicationID)
+			assert.Equal("MQTT", items[0].Kind)
+			assert.EqualValues(1, items[1].ApplicationID)
+			assert.Equal("HTTP", items[1].Kind)
+		})
+
+		t.Run("Delete", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(Delete(1, "HTTP"))
+
+			s, err := Get(1, "HTTP")
+			assert.NoError(err)
+			assert.EqualValues(0, s.SuccessCount)
+
+			items, err := List()
+			assert.NoError(err)
+			assert.Len(items, 1)
+		})
+	})
+}
@@ -0,0 +1,324 @@
+// Package stream implements an integration publishing the events of each
+// application to a Redis backed event stream. Each event gets a sequence
+// number (per application) and the last events are kept in a backlog, so
+// that subscribers are able to resume after a disconnect.
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/eventlog"
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+const (
+	seqKeyTempl     = "lora:as:application:%d:stream:seq"
+	backlogKeyTempl = "lora:as:application:%d:stream:backlog"
+	pubSubKeyTempl  = "lora:as:application:%d:stream:pubsub"
+
+	// subscriptionPingInterval defines the interval in which the Redis
+	// connection of a subscription is checked.
+	subscriptionPingInterval = 30 * time.Second
+)
+
+var (
+	enabled     bool
+	backlogSize = 1000
+	backlogTTL  = 24 * time.Hour
+)
+
+// Setup configures the stream package.
+func Setup(conf config.Config) error {
+	enabled = conf.ApplicationServer.EventStream.Enabled
+	if conf.ApplicationServer.EventStream.BacklogSize > 0 {
+		backlogSize = conf.ApplicationServer.EventStream.BacklogSize
+	}
+	if conf.ApplicationServer.EventStream.BacklogTTL > 0 {
+		backlogTTL = conf.ApplicationServer.EventStream.BacklogTTL
+	}
+	return nil
+}
+
+// Enabled returns true when the event stream is enabled.
+func Enabled() bool {
+	return enabled
+}
+
+// publishScript increments the sequence number, appends the event to the
+// backlog and publishes it to the subscribers in a single atomic operation,
+// so that the order of the sequence numbers matches the order of the
+// backlog and published messages.
+var publishScript = redis.NewScript(3, `
+	local seq = redis.call("INCR", KEYS[1])
+	local msg = seq .. " " .. ARGV[1]
+	redis.call("RPUSH", KEYS[2], msg)
+	redis.call("LTRIM", KEYS[2], -tonumber(ARGV[2]), -1)
+	redis.call("PEXPIRE", KEYS[2], ARGV[3])
+	redis.call("PUBLISH", KEYS[3], msg)
+	return seq
+`)
+
+// Event contains a single application event.
+type Event struct {
+	Seq     uint64          `json:"-"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// Integration implements the stream integration.
+type Integration struct{}
+
+// New creates a new stream integration.
+func New() *Integration {
+	return &Integration{}
+}
+
+// SendDataUp publishes the data-up payload.
+func (i *Integration) SendDataUp(pl integration.DataUpPayload) error {
+	return Publish(pl.ApplicationID, eventlog.Uplink, pl)
+}
+
+// SendJoinNotification publishes the join notification.
+func (i *Integration) SendJoinNotification(pl integration.JoinNotification) error {
+	return Publish(pl.ApplicationID, eventlog.Join, pl)
+}
+
+// SendACKNotification publishes the ACK notification.
+func (i *Integration) SendACKNotification(pl integration.ACKNotification) error {
+	return Publish(pl.ApplicationID, eventlog.ACK, pl)
+}
+
+// SendErrorNotification publishes the error notification.
+func (i *Integration) SendErrorNotification(pl integration.ErrorNotification) error {
+	return Publish(pl.ApplicationID, eventlog.Error, pl)
+}
+
+// SendStatusNotification publishes the status notification.
+func (i *Integration) SendStatusNotification(pl integration.StatusNotification) error {
+	return Publish(pl.ApplicationID, eventlog.Status, pl)
+}
+
+// SendLocationNotification publishes the location notification.
+func (i *Integration) SendLocationNotification(pl integration.LocationNotification) error {
+	return Publish(pl.ApplicationID, eventlog.Location, pl)
+}
+
+// DataDownChan returns nil.
+func (i *Integration) DataDownChan() chan integration.DataDownPayload {
+	return nil
+}
+
+// Close closes the integration.
+func (i *Integration) Close() error {
+	return nil
+}
+
+// Publish publishes the given event for the given application.
+func Publish(applicationID int64, typ string, payload interface{}) error {
+	pl, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "marshal payload error")
+	}
+
+	b, err := json.Marshal(Event{
+		Type:    typ,
+		Payload: pl,
+	})
+	if err != nil {
+		return errors.Wrap(err, "marshal event error")
+	}
+
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	_, err = publishScript.Do(c,
+		fmt.Sprintf(seqKeyTempl, applicationID),
+		fmt.Sprintf(backlogKeyTempl, applicationID),
+		fmt.Sprintf(pubSubKeyTempl, applicationID),
+		b,
+		backlogSize,
+		int64(backlogTTL/time.Millisecond),
+	)
+	if err != nil {
+		return errors.Wrap(err, "publish event error")
+	}
+
+	return nil
+}
+
+// Subscribe subscribes to the events of the given application and sends
+// these to the given channel until the given context is cancelled. When
+// lastSeq is > 0, the events from the backlog with a sequence number greater
+// than lastSeq are sent first.
+func Subscribe(ctx context.Context, applicationID int64, lastSeq uint64, events chan Event) error {
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	psc := redis.PubSubConn{Conn: c}
+	if err := psc.Subscribe(fmt.Sprintf(pubSubKeyTempl, applicationID)); err != nil {
+		return errors.Wrap(err, "subscribe error")
+	}
+
+	live := make(chan Event, 100)
+	subscribed := make(chan struct{})
+	done := make(chan error, 1)
+
+	go func() {
+		for {
+			switch v := psc.Receive().(type) {
+			case redis.Message:
+				el, err := parseEvent(v.Data)
+				if err != nil {
+					log.WithError(err).Error("integration/stream: decode event error")
+					continue
+				}
+				live <- el
+			case redis.Subscription:
+				if v.Count == 0 {
+					done <- nil
+					return
+				}
+				close(subscribed)
+			case error:
+				done <- v
+				return
+			}
+		}
+	}()
+
+	select {
+	case <-subscribed:
+	case err := <-done:
+		return err
+	}
+
+	// the backlog is read after subscribing, so that no events are missed
+	// in between
+	if lastSeq > 0 {
+		backlog, err := getBacklog(applicationID)
+		if err != nil {
+			if err := unsubscribe(psc, live, done); err != nil {
+				log.WithError(err).Error("integration/stream: unsubscribe error")
+			}
+			return err
+		}
+
+		for _, el := range backlog {
+			if el.Seq <= lastSeq {
+				continue
+			}
+
+			select {
+			case events <- el:
+				lastSeq = el.Seq
+			case <-ctx.Done():
+				return unsubscribe(psc, live, done)
+			}
+		}
+	}
+
+	ticker := time.NewTicker(subscriptionPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case el := <-live:
+			// skip events that have already been sent from the backlog
+			if el.Seq <= lastSeq {
+				continue
+			}
+
+			select {
+			case events <- el:
+				lastSeq = el.Seq
+			case <-ctx.Done():
+				return unsubscribe(psc, live, done)
+			}
+		case <-ticker.C:
+			if err := psc.Ping(""); err != nil {
+				log.WithError(err).Error("integration/stream: subscription ping error")
+				return unsubscribe(psc, live, done)
+			}
+		case <-ctx.Done():
+			return unsubscribe(psc, live, done)
+		case err := <-done:
+			return err
+		}
+	}
+}
+
+// unsubscribe unsubscribes the given connection and waits until the receive
+// goroutine has exited. Pending live events are discarded, so that the
+// receive goroutine is never blocked on sending to live. When unsubscribing
+// fails, the connection is closed so that the receive goroutine returns.
+func unsubscribe(psc redis.PubSubConn, live chan Event, done chan error) error {
+	var unsubErr error
+	if err := psc.Unsubscribe(); err != nil {
+		unsubErr = errors.Wrap(err, "unsubscribe error")
+		psc.Close()
+	}
+
+	for {
+		select {
+		case <-live:
+		case err := <-done:
+			if unsubErr != nil {
+				return unsubErr
+			}
+			return err
+		}
+	}
+}
+
+func getBacklog(applicationID int64) ([]Event, error) {
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	values, err := redis.ByteSlices(c.Do("LRANGE", fmt.Sprintf(backlogKeyTempl, applicationID), 0, -1))
+	if err != nil {
+		return nil, errors.Wrap(err, "read backlog error")
+	}
+
+	var out []Event
+	for _, v := range values {
+		el, err := parseEvent(v)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, el)
+	}
+
+	return out, nil
+}
+
+func parseEvent(b []byte) (Event, error) {
+	var el Event
+
+	parts := strings.SplitN(string(b), " ", 2)
+	if len(parts) != 2 {
+		return el, errors.New("invalid event")
+	}
+
+	seq, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return el, errors.Wrap(err, "parse sequence number error")
+	}
+
+	if err := json.Unmarshal([]byte(parts[1]), &el); err != nil {
+		return el, errors.Wrap(err, "unmarshal event error")
+	}
+	el.Seq = seq
+
+	return el, nil
+}
@@ -0,0 +1,80 @@
+package stream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/eventlog"
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestStream(t *testing.T) {
+	assert := require.New(t)
+
+	conf := test.GetConfig()
+	assert.NoError(storage.Setup(conf))
+	test.MustFlushRedis(storage.RedisPool())
+
+	i := New()
+
+	t.Run("Publish to backlog", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.NoError(i.SendDataUp(integration.DataUpPayload{
+			ApplicationID: 1,
+			DevEUI:        lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		}))
+		assert.NoError(i.SendJoinNotification(integration.JoinNotification{
+			ApplicationID: 1,
+			DevEUI:        lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		}))
+
+		backlog, err := getBacklog(1)
+		assert.NoError(err)
+		assert.Len(backlog, 2)
+		assert.EqualValues(1, backlog[0].Seq)
+		assert.Equal(eventlog.Uplink, backlog[0].Type)
+		assert.EqualValues(2, backlog[1].Seq)
+		assert.Equal(eventlog.Join, backlog[1].Type)
+	})
+
+	t.Run("Subscribe with resume", func(t *testing.T) {
+		assert := require.New(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		events := make(chan Event)
+		done := make(chan error, 1)
+
+		go func() {
+			done <- Subscribe(ctx, 1, 1, events)
+		}()
+
+		// event from the backlog
+		el := <-events
+		assert.EqualValues(2, el.Seq)
+		assert.Equal(eventlog.Join, el.Type)
+
+		// live event
+		assert.NoError(i.SendACKNotification(integration.ACKNotification{
+			ApplicationID: 1,
+			DevEUI:        lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		}))
+
+		select {
+		case el = <-events:
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for live event")
+		}
+		assert.EqualValues(3, el.Seq)
+		assert.Equal(eventlog.ACK, el.Type)
+
+		cancel()
+		assert.NoError(<-done)
+	})
+}
@@ -0,0 +1,249 @@
+// Package tester implements sending synthetic events through an integration.
+package tester
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/integration/marshaler"
+)
+
+// maxBodySize defines the max. number of response body bytes returned
+// in the test result.
+const maxBodySize = 1024
+
+// timeout defines the max. duration of a test request.
+const timeout = 10 * time.Second
+
+// Errors
+var (
+	ErrNotSupported = errors.New("integration does not support testing")
+	ErrNoRequest    = errors.New("integration did not make a request for this event")
+)
+
+// HTTPClientSetter is implemented by integrations making HTTP requests.
+type HTTPClientSetter interface {
+	SetHTTPClient(c *http.Client)
+}
+
+// Result contains the result of an integration test.
+type Result struct {
+	StatusCode int
+	Body       string
+	Latency    time.Duration
+}
+
+// Test sends a synthetic event of the given type through the given
+// integration. The returned error contains the error returned by the
+// integration (if any). In case of an error, the result still contains
+// the response of the last request (if any).
+func Test(i integration.Integrator, event string, applicationID int64, applicationName string) (Result, error) {
+	setter, ok := i.(HTTPClientSetter)
+	if !ok {
+		return Result{}, ErrNotSupported
+	}
+
+	rec := recorder{
+		transport: http.DefaultTransport,
+	}
+	setter.SetHTTPClient(&http.Client{
+		Transport: &rec,
+		Timeout:   timeout,
+	})
+
+	err := send(i, event, applicationID, applicationName)
+	result := rec.result()
+	switch errors.Cause(err) {
+	case integration.ErrNotHandled, integration.ErrDeferred:
+		return result, ErrNoRequest
+	}
+	if err != nil {
+		return result, err
+	}
+
+	if !rec.called() {
+		return result, ErrNoRequest
+	}
+
+	return result, nil
+}
+
+func send(i integration.Integrator, event string, applicationID int64, applicationName string) error {
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	deviceName := "test-device"
+
+	switch event {
+	case marshaler.Uplink:
+		uplinkID, err := uuid.NewV4()
+		if err != nil {
+			return errors.Wrap(err, "new uuid error")
+		}
+		now := time.Now()
+
+		return i.SendDataUp(integration.DataUpPayload{
+			UplinkID:        uplinkID,
+			ApplicationID:   applicationID,
+			ApplicationName: applicationName,
+			DeviceName:      deviceName,
+			DevEUI:          devEUI,
+			DevAddr:         lorawan.DevAddr{1, 2, 3, 4},
+			RXInfo: []integration.RXInfo{
+				{
+					GatewayID: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+					Name:      "test-gateway",
+					Time:      &now,
+					RSSI:      -60,
+					LoRaSNR:   7,
+				},
+			},
+			TXInfo: integration.TXInfo{
+				Frequency:  868100000,
+				DR:         5,
+				Modulation: "LORA",
+				LoRaModulationInfo: &integration.LoRaModulationInfo{
+					Bandwidth:       125,
+					SpreadingFactor: 7,
+					CodeRate:        "4/5",
+				},
+			},
+			FCnt:  10,
+			FPort: 1,
+			Data:  []byte{1, 2, 3, 4},
+			Object: map[string]interface{}{
+				"temperature": 21.5,
+			},
+			ReceivedAt: now,
+		})
+	case marshaler.Join:
+		return i.SendJoinNotification(integration.JoinNotification{
+			ApplicationID:   applicationID,
+			ApplicationName: applicationName,
+			DeviceName:      deviceName,
+			DevEUI:          devEUI,
+			DevAddr:         lorawan.DevAddr{1, 2, 3, 4},
+		})
+	case marshaler.ACK:
+		return i.SendACKNotification(integration.ACKNotification{
+			ApplicationID:   applicationID,
+			ApplicationName: applicationName,
+			DeviceName:      deviceName,
+			DevEUI:          devEUI,
+			Acknowledged:    true,
+			FCnt:            10,
+		})
+	case marshaler.Error:
+		return i.SendErrorNotification(integration.ErrorNotification{
+			ApplicationID:   applicationID,
+			ApplicationName: applicationName,
+			DeviceName:      deviceName,
+			DevEUI:          devEUI,
+			Type:            "TEST",
+			Error:           "test error",
+			FCnt:            10,
+		})
+	case marshaler.Status:
+		return i.SendStatusNotification(integration.StatusNotification{
+			ApplicationID:   applicationID,
+			ApplicationName: applicationName,
+			DeviceName:      deviceName,
+			DevEUI:          devEUI,
+			Battery:         200,
+			Margin:          6,
+			BatteryLevel:    78.74,
+		})
+	case marshaler.Location:
+		return i.SendLocationNotification(integration.LocationNotification{
+			ApplicationID:   applicationID,
+			ApplicationName: applicationName,
+			DeviceName:      deviceName,
+			DevEUI:          devEUI,
+			Location: integration.Location{
+				Latitude:  52.3740364,
+				Longitude: 4.9144401,
+				Altitude:  10.5,
+			},
+		})
+	default:
+		return fmt.Errorf("unknown event type: %s", event)
+	}
+}
+
+// recorder implements http.RoundTripper and records the response of the
+// last request.
+type recorder struct {
+	sync.Mutex
+	transport http.RoundTripper
+
+	count      int
+	statusCode int
+	body       []byte
+	latency    time.Duration
+}
+
+func (r *recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := r.transport.RoundTrip(req)
+	latency := time.Since(start)
+
+	r.Lock()
+	defer r.Unlock()
+
+	r.count++
+	r.latency = latency
+	r.statusCode = 0
+	r.body = nil
+
+	if err != nil {
+		return resp, err
+	}
+
+	b, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	if err != nil {
+		resp.Body.Close()
+		return nil, errors.Wrap(err, "read response body error")
+	}
+
+	r.statusCode = resp.StatusCode
+	r.body = b
+
+	// re-construct the body so that the integration is still able to
+	// read the complete response
+	resp.Body = readCloser{
+		Reader: io.MultiReader(bytes.NewReader(b), resp.Body),
+		Closer: resp.Body,
+	}
+
+	return resp, nil
+}
+
+func (r *recorder) called() bool {
+	r.Lock()
+	defer r.Unlock()
+	return r.count != 0
+}
+
+func (r *recorder) result() Result {
+	r.Lock()
+	defer r.Unlock()
+	return Result{
+		StatusCode: r.statusCode,
+		Body:       string(r.body),
+		Latency:    r.latency,
+	}
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
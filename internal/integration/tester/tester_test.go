@@ -0,0 +1,62 @@
+package tester
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	httpint "github.com/brocaar/lora-app-server/internal/integration/http"
+	"github.com/brocaar/lora-app-server/internal/integration/marshaler"
+	"github.com/brocaar/lora-app-server/internal/integration/mock"
+)
+
+func TestTest(t *testing.T) {
+	var statusCode int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(statusCode)
+		w.Write([]byte("hello"))
+	}))
+	defer server.Close()
+
+	i, err := httpint.New(httpint.Config{
+		DataUpURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	t.Run("Success", func(t *testing.T) {
+		assert := require.New(t)
+		statusCode = http.StatusOK
+
+		result, err := Test(i, marshaler.Uplink, 1, "test-app")
+		assert.NoError(err)
+		assert.Equal(http.StatusOK, result.StatusCode)
+		assert.Equal("hello", result.Body)
+		assert.NotZero(result.Latency)
+	})
+
+	t.Run("Error response", func(t *testing.T) {
+		assert := require.New(t)
+		statusCode = http.StatusInternalServerError
+
+		result, err := Test(i, marshaler.Uplink, 1, "test-app")
+		assert.Error(err)
+		assert.Equal(http.StatusInternalServerError, result.StatusCode)
+		assert.Equal("hello", result.Body)
+	})
+
+	t.Run("No request", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := Test(i, marshaler.Join, 1, "test-app")
+		assert.Equal(ErrNoRequest, err)
+	})
+
+	t.Run("Not supported", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := Test(mock.New(), marshaler.Uplink, 1, "test-app")
+		assert.Equal(ErrNotSupported, err)
+	})
+}
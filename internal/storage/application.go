@@ -6,6 +6,7 @@ import (
 
 	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	uuid "github.com/gofrs/uuid"
 	log "github.com/sirupsen/logrus"
@@ -23,6 +24,7 @@ type Application struct {
 	PayloadCodec         codec.Type `db:"payload_codec"`
 	PayloadEncoderScript string     `db:"payload_encoder_script"`
 	PayloadDecoderScript string     `db:"payload_decoder_script"`
+	UplinkHistoryDays    int        `db:"uplink_history_days"`
 }
 
 // ApplicationListItem devices the application as a list item.
@@ -54,8 +56,9 @@ func CreateApplication(db sqlx.Queryer, item *Application) error {
 			service_profile_id,
 			payload_codec,
 			payload_encoder_script,
-			payload_decoder_script
-		) values ($1, $2, $3, $4, $5, $6, $7) returning id`,
+			payload_decoder_script,
+			uplink_history_days
+		) values ($1, $2, $3, $4, $5, $6, $7, $8) returning id`,
 		item.Name,
 		item.Description,
 		item.OrganizationID,
@@ -63,6 +66,7 @@ func CreateApplication(db sqlx.Queryer, item *Application) error {
 		item.PayloadCodec,
 		item.PayloadEncoderScript,
 		item.PayloadDecoderScript,
+		item.UplinkHistoryDays,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -87,6 +91,35 @@ func GetApplication(db sqlx.Queryer, id int64) (Application, error) {
 	return app, nil
 }
 
+// GetApplicationNames returns the names of the given applications, by
+// application id. Applications which do not exist are not included.
+func GetApplicationNames(db sqlx.Queryer, ids []int64) (map[int64]string, error) {
+	var apps []Application
+	err := sqlx.Select(db, &apps, "select id, name from application where id = any($1)", pq.Array(ids))
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	out := make(map[int64]string)
+	for _, app := range apps {
+		out[app.ID] = app.Name
+	}
+
+	return out, nil
+}
+
+// GetApplicationIDsForOrganizationID returns the ids of the applications of
+// the given organization.
+func GetApplicationIDsForOrganizationID(db sqlx.Queryer, organizationID int64) ([]int64, error) {
+	var ids []int64
+	err := sqlx.Select(db, &ids, "select id from application where organization_id = $1 order by id", organizationID)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return ids, nil
+}
+
 // GetApplicationCount returns the total number of applications.
 func GetApplicationCount(db sqlx.Queryer, search string) (int, error) {
 	var count int
@@ -294,7 +327,8 @@ func UpdateApplication(db sqlx.Execer, item Application) error {
 			service_profile_id = $5,
 			payload_codec = $6,
 			payload_encoder_script = $7,
-			payload_decoder_script = $8
+			payload_decoder_script = $8,
+			uplink_history_days = $9
 		where id = $1`,
 		item.ID,
 		item.Name,
@@ -304,6 +338,7 @@ func UpdateApplication(db sqlx.Execer, item Application) error {
 		item.PayloadCodec,
 		item.PayloadEncoderScript,
 		item.PayloadDecoderScript,
+		item.UplinkHistoryDays,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
@@ -105,6 +105,18 @@ func TestApplication(t *testing.T) {
 				So(apps[0].ServiceProfileName, ShouldEqual, sp.Name)
 			})
 
+			Convey("Then the application names can be retrieved by id", func() {
+				names, err := GetApplicationNames(db, []int64{app.ID, app.ID + 1})
+				So(err, ShouldBeNil)
+				So(names, ShouldResemble, map[int64]string{app.ID: app.Name})
+			})
+
+			Convey("Then the application ids for the organization can be retrieved", func() {
+				ids, err := GetApplicationIDsForOrganizationID(db, org.ID)
+				So(err, ShouldBeNil)
+				So(ids, ShouldResemble, []int64{app.ID})
+			})
+
 			Convey("When updating the application", func() {
 				app.Description = "some new description"
 				So(UpdateApplication(db, app), ShouldBeNil)
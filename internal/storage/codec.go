@@ -0,0 +1,468 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/codec"
+)
+
+// Codec defines a codec of the codec library. A codec is either owned by an
+// organization, or global (OrganizationID is nil) in which case it can be
+// used by all organizations.
+type Codec struct {
+	ID             int64     `db:"id"`
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
+	OrganizationID *int64    `db:"organization_id"`
+	Name           string    `db:"name"`
+	Description    string    `db:"description"`
+}
+
+// Validate validates the codec data.
+func (c Codec) Validate() error {
+	if c.Name == "" {
+		return ErrCodecInvalidName
+	}
+	return nil
+}
+
+// CodecListItem defines the codec as list item.
+type CodecListItem struct {
+	Codec
+	LatestVersion          int `db:"latest_version"`
+	LatestPublishedVersion int `db:"latest_published_version"`
+}
+
+// CodecVersion defines a version of a codec. Versions are immutable, a
+// change to a codec results in a new version. Only published versions are
+// used by the device-profiles using the latest version of the codec,
+// unpublished (draft) versions can be tested by pinning a device-profile
+// to the version.
+type CodecVersion struct {
+	CodecID              int64      `db:"codec_id"`
+	Version              int        `db:"version"`
+	CreatedAt            time.Time  `db:"created_at"`
+	Published            bool       `db:"published"`
+	Description          string     `db:"description"`
+	PayloadCodec         codec.Type `db:"payload_codec"`
+	PayloadEncoderScript string     `db:"payload_encoder_script"`
+	PayloadDecoderScript string     `db:"payload_decoder_script"`
+	DescriptorSet        []byte     `db:"descriptor_set"`
+	WASMModule           []byte     `db:"wasm_module"`
+}
+
+// Validate validates the codec version data.
+func (v CodecVersion) Validate() error {
+	if v.PayloadCodec == "" {
+		return ErrCodecVersionInvalidCodec
+	}
+
+	if len(v.DescriptorSet) != 0 {
+		if _, err := codec.ParseDescriptorSet(v.DescriptorSet); err != nil {
+			return ErrDeviceProfileInvalidProtobuf
+		}
+	}
+
+	if len(v.WASMModule) != 0 {
+		if err := codec.ValidateWASMModule(v.WASMModule); err != nil {
+			return ErrDeviceProfileInvalidWASM
+		}
+	}
+
+	if err := validateBinarySchema(v.PayloadCodec, v.PayloadDecoderScript); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CodecUsage defines a device-profile using a codec.
+type CodecUsage struct {
+	DeviceProfileID uuid.UUID `db:"device_profile_id"`
+	OrganizationID  int64     `db:"organization_id"`
+	Name            string    `db:"name"`
+	CodecVersion    *int      `db:"codec_version"`
+}
+
+// CreateCodec creates the given codec.
+func CreateCodec(db sqlx.Queryer, c *Codec) error {
+	if err := c.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	now := time.Now()
+	c.CreatedAt = now
+	c.UpdatedAt = now
+
+	err := sqlx.Get(db, &c.ID, `
+		insert into codec (
+			created_at,
+			updated_at,
+			organization_id,
+			name,
+			description
+		) values ($1, $2, $3, $4, $5)
+		returning id`,
+		c.CreatedAt,
+		c.UpdatedAt,
+		c.OrganizationID,
+		c.Name,
+		c.Description,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":   c.ID,
+		"name": c.Name,
+	}).Info("codec created")
+
+	return nil
+}
+
+// GetCodec returns the codec matching the given id.
+// When forUpdate is set to true, then db must be a db transaction.
+func GetCodec(db sqlx.Queryer, id int64, forUpdate bool) (Codec, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var c Codec
+	err := sqlx.Get(db, &c, "select * from codec where id = $1"+fu, id)
+	if err != nil {
+		return c, handlePSQLError(Select, err, "select error")
+	}
+
+	return c, nil
+}
+
+// UpdateCodec updates the given codec. Note that the organization of a codec
+// can not be changed.
+func UpdateCodec(db sqlx.Execer, c *Codec) error {
+	if err := c.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	c.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update codec
+		set
+			updated_at = $2,
+			name = $3,
+			description = $4
+		where id = $1`,
+		c.ID,
+		c.UpdatedAt,
+		c.Name,
+		c.Description,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", c.ID).Info("codec updated")
+
+	return nil
+}
+
+// DeleteCodec deletes the codec (and all its versions) matching the given id.
+// A codec which is used by device-profiles can not be deleted.
+func DeleteCodec(db sqlx.Execer, id int64) error {
+	res, err := db.Exec("delete from codec where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("codec deleted")
+
+	return nil
+}
+
+// GetCodecCount returns the total number of codecs available to the given
+// organization id (the codecs of the organization and the global codecs).
+// When the organization id is 0, only the global codecs are counted.
+func GetCodecCount(db sqlx.Queryer, organizationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select
+			count(*)
+		from codec
+		where
+			organization_id is null
+			or organization_id = $1`,
+		organizationID,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return count, nil
+}
+
+// GetCodecs returns a slice of codecs available to the given organization id
+// (the codecs of the organization and the global codecs).
+// When the organization id is 0, only the global codecs are returned.
+func GetCodecs(db sqlx.Queryer, organizationID int64, limit, offset int) ([]CodecListItem, error) {
+	var codecs []CodecListItem
+	err := sqlx.Select(db, &codecs, `
+		select
+			c.*,
+			coalesce((select max(version) from codec_version cv where cv.codec_id = c.id), 0) as latest_version,
+			coalesce((select max(version) from codec_version cv where cv.codec_id = c.id and cv.published), 0) as latest_published_version
+		from codec c
+		where
+			c.organization_id is null
+			or c.organization_id = $1
+		order by c.name, c.id
+		limit $2 offset $3`,
+		organizationID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return codecs, nil
+}
+
+// CreateCodecVersion creates the given codec version. The version number is
+// set to the latest version number of the codec + 1. To avoid concurrent
+// creation of the same version number, db should be a db transaction in
+// which the codec has been locked (see GetCodec).
+func CreateCodecVersion(db sqlx.Ext, v *CodecVersion) error {
+	if err := v.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	err := sqlx.Get(db, &v.Version, `
+		select
+			coalesce(max(version), 0) + 1
+		from codec_version
+		where
+			codec_id = $1`,
+		v.CodecID,
+	)
+	if err != nil {
+		return handlePSQLError(Select, err, "select error")
+	}
+
+	v.CreatedAt = time.Now()
+
+	_, err = db.Exec(`
+		insert into codec_version (
+			codec_id,
+			version,
+			created_at,
+			description,
+			payload_codec,
+			payload_encoder_script,
+			payload_decoder_script,
+			descriptor_set,
+			wasm_module,
+			published
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		v.CodecID,
+		v.Version,
+		v.CreatedAt,
+		v.Description,
+		v.PayloadCodec,
+		v.PayloadEncoderScript,
+		v.PayloadDecoderScript,
+		v.DescriptorSet,
+		v.WASMModule,
+		v.Published,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"codec_id":  v.CodecID,
+		"version":   v.Version,
+		"published": v.Published,
+	}).Info("codec version created")
+
+	return nil
+}
+
+// PublishCodecVersion publishes the given version of the codec matching the
+// given codec id. Once published, the version is used by the device-profiles
+// using the latest version of the codec (unless a later version has been
+// published).
+func PublishCodecVersion(db sqlx.Execer, codecID int64, version int) error {
+	res, err := db.Exec(`
+		update codec_version
+		set
+			published = true
+		where
+			codec_id = $1
+			and version = $2`,
+		codecID,
+		version,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"codec_id": codecID,
+		"version":  version,
+	}).Info("codec version published")
+
+	return nil
+}
+
+// GetCodecVersion returns the given version of the codec matching the given
+// codec id. When version is 0, the latest published version is returned.
+func GetCodecVersion(db sqlx.Queryer, codecID int64, version int) (CodecVersion, error) {
+	var v CodecVersion
+	err := sqlx.Get(db, &v, `
+		select *
+		from codec_version
+		where
+			codec_id = $1
+			and (($2 = 0 and published) or version = $2)
+		order by version desc
+		limit 1`,
+		codecID,
+		version,
+	)
+	if err != nil {
+		return v, handlePSQLError(Select, err, "select error")
+	}
+
+	return v, nil
+}
+
+// GetCodecVersionCount returns the total number of versions of the codec
+// matching the given codec id.
+func GetCodecVersionCount(db sqlx.Queryer, codecID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, "select count(*) from codec_version where codec_id = $1", codecID)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return count, nil
+}
+
+// GetCodecVersions returns a slice of versions of the codec matching the
+// given codec id, sorted by version (latest first).
+func GetCodecVersions(db sqlx.Queryer, codecID int64, limit, offset int) ([]CodecVersion, error) {
+	var versions []CodecVersion
+	err := sqlx.Select(db, &versions, `
+		select *
+		from codec_version
+		where
+			codec_id = $1
+		order by version desc
+		limit $2 offset $3`,
+		codecID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return versions, nil
+}
+
+// GetCodecUsage returns the device-profiles using the codec matching the
+// given codec id. A nil CodecVersion means that the device-profile uses the
+// latest published version.
+func GetCodecUsage(db sqlx.Queryer, codecID int64) ([]CodecUsage, error) {
+	var usage []CodecUsage
+	err := sqlx.Select(db, &usage, `
+		select
+			device_profile_id,
+			organization_id,
+			name,
+			codec_version
+		from device_profile
+		where
+			codec_id = $1
+		order by name, device_profile_id`,
+		codecID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return usage, nil
+}
+
+// GetDeviceProfilePayloadCodec returns the payload codec for the given
+// device-profile and fPort. When the fPort is within one of the fPort ranges,
+// the codec of this range is returned. Else, when the device-profile uses a
+// codec of the codec library, the used (pinned or latest published) version
+// of this codec is returned.
+// Else, the codec of the device-profile is returned.
+//
+// When the used codec version does not exist (e.g. the codec does not have
+// a published version), an error with ErrDoesNotExist as cause is returned.
+// In this case the uplink and downlink handling fall back to the application
+// codec, after sending a CODEC error notification. Any other error must be
+// handled as internal error.
+func GetDeviceProfilePayloadCodec(db sqlx.Queryer, dp DeviceProfile, fPort uint8) (CodecVersion, error) {
+	if c, ok := dp.getFPortPayloadCodec(fPort); ok {
+		return CodecVersion{
+			PayloadCodec:         c.PayloadCodec,
+			PayloadEncoderScript: c.PayloadEncoderScript,
+			PayloadDecoderScript: c.PayloadDecoderScript,
+			DescriptorSet:        dp.DescriptorSet,
+			WASMModule:           dp.WASMModule,
+		}, nil
+	}
+
+	if dp.CodecID != nil {
+		var version int
+		if dp.CodecVersion != nil {
+			version = *dp.CodecVersion
+		}
+
+		v, err := GetCodecVersion(db, *dp.CodecID, version)
+		if err != nil {
+			return v, errors.Wrap(err, "get codec version error")
+		}
+		return v, nil
+	}
+
+	return CodecVersion{
+		PayloadCodec:         dp.PayloadCodec,
+		PayloadEncoderScript: dp.PayloadEncoderScript,
+		PayloadDecoderScript: dp.PayloadDecoderScript,
+		DescriptorSet:        dp.DescriptorSet,
+		WASMModule:           dp.WASMModule,
+	}, nil
+}
@@ -0,0 +1,321 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lora-app-server/internal/codec"
+)
+
+func (ts *StorageTestSuite) TestCodec() {
+	assert := require.New(ts.T())
+
+	nsClient := mock.NewClient()
+	networkserver.SetPool(mock.NewPool(nsClient))
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	orgs := []Organization{
+		{Name: "test-org-1"},
+		{Name: "test-org-2"},
+	}
+	for i := range orgs {
+		assert.NoError(CreateOrganization(ts.Tx(), &orgs[i]))
+	}
+
+	ts.T().Run("Delete", func(t *testing.T) {
+		assert := require.New(t)
+
+		c := Codec{
+			Name: "delete-codec",
+		}
+		assert.NoError(CreateCodec(ts.Tx(), &c))
+		assert.NoError(CreateCodecVersion(ts.Tx(), &CodecVersion{CodecID: c.ID, PayloadCodec: codec.CayenneLPPType}))
+
+		assert.NoError(DeleteCodec(ts.Tx(), c.ID))
+		_, err := GetCodec(ts.Tx(), c.ID, false)
+		assert.Equal(ErrDoesNotExist, err)
+		assert.Equal(ErrDoesNotExist, DeleteCodec(ts.Tx(), c.ID))
+	})
+
+	ts.T().Run("Draft versions", func(t *testing.T) {
+		assert := require.New(t)
+
+		c := Codec{
+			OrganizationID: &orgs[0].ID,
+			Name:           "draft-codec",
+		}
+		assert.NoError(CreateCodec(ts.Tx(), &c))
+
+		draft := CodecVersion{CodecID: c.ID, PayloadCodec: codec.CayenneLPPType}
+		assert.NoError(CreateCodecVersion(ts.Tx(), &draft))
+
+		_, err := GetCodecVersion(ts.Tx(), c.ID, 0)
+		assert.Equal(ErrDoesNotExist, err)
+
+		v, err := GetCodecVersion(ts.Tx(), c.ID, draft.Version)
+		assert.NoError(err)
+		assert.False(v.Published)
+
+		dp := DeviceProfile{
+			Name:            "draft-dp",
+			OrganizationID:  orgs[0].ID,
+			NetworkServerID: n.ID,
+			CodecID:         &c.ID,
+		}
+		assert.Equal(ErrDeviceProfileNoCodecVersion, errors.Cause(CreateDeviceProfile(ts.Tx(), &dp)))
+
+		// a draft version can be used by pinning it
+		dp.CodecVersion = &draft.Version
+		assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+
+		assert.NoError(PublishCodecVersion(ts.Tx(), c.ID, draft.Version))
+		v, err = GetCodecVersion(ts.Tx(), c.ID, 0)
+		assert.NoError(err)
+		assert.Equal(draft.Version, v.Version)
+		assert.True(v.Published)
+
+		assert.Equal(ErrDoesNotExist, PublishCodecVersion(ts.Tx(), c.ID, draft.Version+1))
+	})
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		c := Codec{
+			OrganizationID: &orgs[0].ID,
+			Name:           "test-codec",
+			Description:    "test codec",
+		}
+		assert.NoError(CreateCodec(ts.Tx(), &c))
+		c.CreatedAt = c.CreatedAt.Round(time.Second).UTC()
+		c.UpdatedAt = c.UpdatedAt.Round(time.Second).UTC()
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+
+			c2, err := GetCodec(ts.Tx(), c.ID, false)
+			assert.NoError(err)
+			c2.CreatedAt = c2.CreatedAt.Round(time.Second).UTC()
+			c2.UpdatedAt = c2.UpdatedAt.Round(time.Second).UTC()
+			assert.Equal(c, c2)
+		})
+
+		t.Run("Update", func(t *testing.T) {
+			assert := require.New(t)
+
+			c.Name = "updated-codec"
+			c.Description = "updated codec"
+			assert.NoError(UpdateCodec(ts.Tx(), &c))
+
+			c2, err := GetCodec(ts.Tx(), c.ID, false)
+			assert.NoError(err)
+			assert.Equal("updated-codec", c2.Name)
+			assert.Equal("updated codec", c2.Description)
+		})
+
+		t.Run("Create versions", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := GetCodecVersion(ts.Tx(), c.ID, 0)
+			assert.Equal(ErrDoesNotExist, err)
+
+			versions := []CodecVersion{
+				{CodecID: c.ID, PayloadCodec: codec.CustomJSType, PayloadDecoderScript: "decode-1", Published: true},
+				{CodecID: c.ID, PayloadCodec: codec.CustomJSType, PayloadDecoderScript: "decode-2", Published: true},
+			}
+			for i := range versions {
+				assert.NoError(CreateCodecVersion(ts.Tx(), &versions[i]))
+				assert.Equal(i+1, versions[i].Version)
+			}
+
+			assert.Equal(ErrCodecVersionInvalidCodec, errors.Cause(CreateCodecVersion(ts.Tx(), &CodecVersion{CodecID: c.ID})))
+			assert.Equal(ErrCodecInvalidBinarySchema, errors.Cause(CreateCodecVersion(ts.Tx(), &CodecVersion{
+				CodecID:              c.ID,
+				PayloadCodec:         codec.BinarySchemaType,
+				PayloadDecoderScript: `{"fields": []}`,
+			})))
+
+			v, err := GetCodecVersion(ts.Tx(), c.ID, 0)
+			assert.NoError(err)
+			assert.Equal(2, v.Version)
+			assert.Equal("decode-2", v.PayloadDecoderScript)
+
+			v, err = GetCodecVersion(ts.Tx(), c.ID, 1)
+			assert.NoError(err)
+			assert.Equal("decode-1", v.PayloadDecoderScript)
+
+			count, err := GetCodecVersionCount(ts.Tx(), c.ID)
+			assert.NoError(err)
+			assert.Equal(2, count)
+
+			items, err := GetCodecVersions(ts.Tx(), c.ID, 10, 0)
+			assert.NoError(err)
+			assert.Len(items, 2)
+			assert.Equal(2, items[0].Version)
+			assert.Equal(1, items[1].Version)
+		})
+
+		t.Run("List", func(t *testing.T) {
+			assert := require.New(t)
+
+			global := Codec{
+				Name: "global-codec",
+			}
+			assert.NoError(CreateCodec(ts.Tx(), &global))
+
+			tests := []struct {
+				Name           string
+				OrganizationID int64
+				ExpectedNames  []string
+			}{
+				{
+					Name:           "organization and global codecs",
+					OrganizationID: orgs[0].ID,
+					ExpectedNames:  []string{"global-codec", "updated-codec"},
+				},
+				{
+					Name:           "other organization",
+					OrganizationID: orgs[1].ID,
+					ExpectedNames:  []string{"global-codec"},
+				},
+				{
+					Name:          "global codecs only",
+					ExpectedNames: []string{"global-codec"},
+				},
+			}
+
+			for _, tst := range tests {
+				t.Run(tst.Name, func(t *testing.T) {
+					assert := require.New(t)
+
+					count, err := GetCodecCount(ts.Tx(), tst.OrganizationID)
+					assert.NoError(err)
+					assert.Equal(len(tst.ExpectedNames), count)
+
+					items, err := GetCodecs(ts.Tx(), tst.OrganizationID, 10, 0)
+					assert.NoError(err)
+
+					var names []string
+					for _, item := range items {
+						names = append(names, item.Name)
+						if item.ID == c.ID {
+							assert.Equal(2, item.LatestVersion)
+							assert.Equal(2, item.LatestPublishedVersion)
+						}
+					}
+					assert.Equal(tst.ExpectedNames, names)
+				})
+			}
+		})
+
+		t.Run("Device-profile", func(t *testing.T) {
+			assert := require.New(t)
+
+			version := 1
+			dp := DeviceProfile{
+				Name:            "test-dp",
+				OrganizationID:  orgs[0].ID,
+				NetworkServerID: n.ID,
+				PayloadCodec:    codec.CayenneLPPType,
+				CodecID:         &c.ID,
+				CodecVersion:    &version,
+				PayloadCodecFPorts: PayloadCodecs{
+					{FPortFrom: 10, FPortTo: 10, PayloadCodec: codec.BinarySchemaType},
+				},
+			}
+			assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+			dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+			assert.NoError(err)
+
+			t.Run("Codec must be available to organization", func(t *testing.T) {
+				assert := require.New(t)
+
+				dp2 := DeviceProfile{
+					Name:            "test-dp-2",
+					OrganizationID:  orgs[1].ID,
+					NetworkServerID: n.ID,
+					CodecID:         &c.ID,
+				}
+				assert.Equal(ErrDeviceProfileInvalidCodec, errors.Cause(CreateDeviceProfile(ts.Tx(), &dp2)))
+			})
+
+			t.Run("Codec version must exist", func(t *testing.T) {
+				assert := require.New(t)
+
+				missing := 3
+				dp2 := DeviceProfile{
+					Name:            "test-dp-2",
+					OrganizationID:  orgs[0].ID,
+					NetworkServerID: n.ID,
+					CodecID:         &c.ID,
+					CodecVersion:    &missing,
+				}
+				assert.Equal(ErrDeviceProfileNoCodecVersion, errors.Cause(CreateDeviceProfile(ts.Tx(), &dp2)))
+
+				empty := Codec{
+					OrganizationID: &orgs[0].ID,
+					Name:           "empty-codec",
+				}
+				assert.NoError(CreateCodec(ts.Tx(), &empty))
+
+				dp2.CodecID = &empty.ID
+				dp2.CodecVersion = nil
+				assert.Equal(ErrDeviceProfileNoCodecVersion, errors.Cause(CreateDeviceProfile(ts.Tx(), &dp2)))
+			})
+
+			t.Run("GetDeviceProfilePayloadCodec", func(t *testing.T) {
+				assert := require.New(t)
+
+				// pinned version
+				v, err := GetDeviceProfilePayloadCodec(ts.Tx(), dp, 1)
+				assert.NoError(err)
+				assert.Equal(codec.CustomJSType, v.PayloadCodec)
+				assert.Equal("decode-1", v.PayloadDecoderScript)
+
+				// fPort range
+				v, err = GetDeviceProfilePayloadCodec(ts.Tx(), dp, 10)
+				assert.NoError(err)
+				assert.Equal(codec.BinarySchemaType, v.PayloadCodec)
+
+				// latest version
+				dp.CodecVersion = nil
+				v, err = GetDeviceProfilePayloadCodec(ts.Tx(), dp, 1)
+				assert.NoError(err)
+				assert.Equal("decode-2", v.PayloadDecoderScript)
+
+				// no codec
+				dp.CodecID = nil
+				v, err = GetDeviceProfilePayloadCodec(ts.Tx(), dp, 1)
+				assert.NoError(err)
+				assert.Equal(codec.CayenneLPPType, v.PayloadCodec)
+			})
+
+			t.Run("GetCodecUsage", func(t *testing.T) {
+				assert := require.New(t)
+
+				usage, err := GetCodecUsage(ts.Tx(), c.ID)
+				assert.NoError(err)
+				assert.Equal([]CodecUsage{
+					{DeviceProfileID: dpID, OrganizationID: orgs[0].ID, Name: "test-dp", CodecVersion: &version},
+				}, usage)
+			})
+
+			// note: this aborts the transaction and must be the last test
+			t.Run("Delete codec in use", func(t *testing.T) {
+				assert := require.New(t)
+
+				assert.Equal(ErrUsedByOtherObjects, DeleteCodec(ts.Tx(), c.ID))
+			})
+		})
+	})
+}
@@ -2,6 +2,9 @@ package storage
 
 import (
 	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
@@ -36,6 +39,35 @@ type Device struct {
 	Latitude                  *float64      `db:"latitude"`
 	Longitude                 *float64      `db:"longitude"`
 	Altitude                  *float64      `db:"altitude"`
+	Variables                 Variables     `db:"variables"`
+}
+
+// Variables defines the (user-defined) device variables, e.g. calibration
+// values. These are exposed to the payload codec.
+type Variables map[string]string
+
+// Value implements the driver.Valuer interface.
+func (v Variables) Value() (driver.Value, error) {
+	if v == nil {
+		v = Variables{}
+	}
+	return json.Marshal(v)
+}
+
+// Scan implements the sql.Scanner interface.
+func (v *Variables) Scan(src interface{}) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("expected []byte, got %T", src)
+	}
+
+	if err := json.Unmarshal(b, v); err != nil {
+		return err
+	}
+	if len(*v) == 0 {
+		*v = nil
+	}
+	return nil
 }
 
 // DeviceListItem defines the Device as list item.
@@ -93,8 +125,9 @@ func CreateDevice(db sqlx.Ext, d *Device) error {
 			last_seen_at,
 			latitude,
 			longitude,
-			altitude
-        ) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`,
+			altitude,
+			variables
+        ) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`,
 		d.DevEUI[:],
 		d.CreatedAt,
 		d.UpdatedAt,
@@ -109,6 +142,7 @@ func CreateDevice(db sqlx.Ext, d *Device) error {
 		d.Latitude,
 		d.Longitude,
 		d.Altitude,
+		d.Variables,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -330,7 +364,8 @@ func UpdateDevice(db sqlx.Ext, d *Device, localOnly bool) error {
 			latitude = $10,
 			longitude = $11,
 			altitude = $12,
-			device_status_external_power_source = $13
+			device_status_external_power_source = $13,
+			variables = $14
         where
             dev_eui = $1`,
 		d.DevEUI[:],
@@ -346,6 +381,7 @@ func UpdateDevice(db sqlx.Ext, d *Device, localOnly bool) error {
 		d.Longitude,
 		d.Altitude,
 		d.DeviceStatusExternalPower,
+		d.Variables,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+)
+
+const (
+	deviceCodecStateKeyTempl = "lora:as:device:%s:codec:state"
+
+	// deviceCodecStateMaxAttempts defines the max. number of attempts to
+	// update the codec state when it is modified concurrently.
+	deviceCodecStateMaxAttempts = 3
+)
+
+// GetDeviceCodecState returns the (JSON encoded) codec state of the given
+// device. It returns nil when the device does not have a state.
+func GetDeviceCodecState(p *redis.Pool, devEUI lorawan.EUI64) ([]byte, error) {
+	c := p.Get()
+	defer c.Close()
+
+	state, err := redis.Bytes(c.Do("GET", fmt.Sprintf(deviceCodecStateKeyTempl, devEUI)))
+	if err != nil {
+		if err == redis.ErrNil {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "get codec state error")
+	}
+
+	return state, nil
+}
+
+// UpdateDeviceCodecState updates the codec state of the given device. The
+// function f is called with the current state and must return the new
+// state (nil removes the state). The state is only updated when it has not
+// been modified by a concurrent update in the meantime, in which case f is
+// called again with the updated state. An error returned by f is returned
+// as-is and the state is not updated.
+func UpdateDeviceCodecState(p *redis.Pool, devEUI lorawan.EUI64, f func(state []byte) ([]byte, error)) error {
+	c := p.Get()
+	defer c.Close()
+
+	key := fmt.Sprintf(deviceCodecStateKeyTempl, devEUI)
+
+	for i := 0; i < deviceCodecStateMaxAttempts; i++ {
+		if _, err := c.Do("WATCH", key); err != nil {
+			return errors.Wrap(err, "watch codec state error")
+		}
+
+		state, err := redis.Bytes(c.Do("GET", key))
+		if err != nil && err != redis.ErrNil {
+			c.Do("UNWATCH")
+			return errors.Wrap(err, "get codec state error")
+		}
+
+		newState, err := f(state)
+		if err != nil {
+			c.Do("UNWATCH")
+			return err
+		}
+
+		if bytes.Equal(state, newState) {
+			_, err := c.Do("UNWATCH")
+			return errors.Wrap(err, "unwatch codec state error")
+		}
+
+		c.Send("MULTI")
+		if len(newState) == 0 {
+			c.Send("DEL", key)
+		} else {
+			c.Send("SET", key, newState)
+		}
+		reply, err := c.Do("EXEC")
+		if err != nil {
+			return errors.Wrap(err, "update codec state error")
+		}
+
+		// a nil reply means that the state has been modified after WATCH
+		if reply != nil {
+			return nil
+		}
+	}
+
+	return ErrDeviceCodecStateConflict
+}
+
+// DeleteDeviceCodecState deletes the codec state of the given device.
+func DeleteDeviceCodecState(p *redis.Pool, devEUI lorawan.EUI64) error {
+	c := p.Get()
+	defer c.Close()
+
+	_, err := c.Do("DEL", fmt.Sprintf(deviceCodecStateKeyTempl, devEUI))
+	if err != nil {
+		return errors.Wrap(err, "delete codec state error")
+	}
+
+	return nil
+}
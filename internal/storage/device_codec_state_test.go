@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeviceCodecState() {
+	assert := require.New(ts.T())
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+	state, err := GetDeviceCodecState(RedisPool(), devEUI)
+	assert.NoError(err)
+	assert.Nil(state)
+
+	assert.NoError(UpdateDeviceCodecState(RedisPool(), devEUI, func(state []byte) ([]byte, error) {
+		assert.Nil(state)
+		return []byte(`{"counter":1}`), nil
+	}))
+
+	state, err = GetDeviceCodecState(RedisPool(), devEUI)
+	assert.NoError(err)
+	assert.Equal(`{"counter":1}`, string(state))
+
+	// an error does not update the state
+	testErr := errors.New("test error")
+	assert.Equal(testErr, UpdateDeviceCodecState(RedisPool(), devEUI, func(state []byte) ([]byte, error) {
+		return []byte(`{"counter":2}`), testErr
+	}))
+
+	state, err = GetDeviceCodecState(RedisPool(), devEUI)
+	assert.NoError(err)
+	assert.Equal(`{"counter":1}`, string(state))
+
+	// a concurrent update results in a retry
+	var calls int
+	assert.NoError(UpdateDeviceCodecState(RedisPool(), devEUI, func(state []byte) ([]byte, error) {
+		calls++
+		if calls == 1 {
+			c := RedisPool().Get()
+			defer c.Close()
+			_, err := c.Do("SET", "lora:as:device:0102030405060708:codec:state", `{"counter":5}`)
+			assert.NoError(err)
+		}
+		return []byte(fmt.Sprintf(`{"counter":%d}`, calls*10)), nil
+	}))
+	assert.Equal(2, calls)
+
+	state, err = GetDeviceCodecState(RedisPool(), devEUI)
+	assert.NoError(err)
+	assert.Equal(`{"counter":20}`, string(state))
+
+	// a nil state removes the state
+	assert.NoError(UpdateDeviceCodecState(RedisPool(), devEUI, func(state []byte) ([]byte, error) {
+		return nil, nil
+	}))
+	state, err = GetDeviceCodecState(RedisPool(), devEUI)
+	assert.NoError(err)
+	assert.Nil(state)
+
+	assert.NoError(UpdateDeviceCodecState(RedisPool(), devEUI, func(state []byte) ([]byte, error) {
+		return []byte(`{"counter":1}`), nil
+	}))
+	assert.NoError(DeleteDeviceCodecState(RedisPool(), devEUI))
+	state, err = GetDeviceCodecState(RedisPool(), devEUI)
+	assert.NoError(err)
+	assert.Nil(state)
+}
@@ -2,6 +2,9 @@ package storage
 
 import (
 	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -26,9 +29,52 @@ type DeviceProfile struct {
 	PayloadCodec         codec.Type       `db:"payload_codec"`
 	PayloadEncoderScript string           `db:"payload_encoder_script"`
 	PayloadDecoderScript string           `db:"payload_decoder_script"`
+	PayloadCodecFPorts   PayloadCodecs    `db:"payload_codec_fports"`
+	DescriptorSet        []byte           `db:"payload_codec_descriptor_set"`
+	WASMModule           []byte           `db:"payload_codec_wasm_module"`
+	CodecID              *int64           `db:"codec_id"`
+	CodecVersion         *int             `db:"codec_version"`
+	PayloadJSONSchema    string           `db:"payload_json_schema"`
+	DropInvalidPayloads  bool             `db:"payload_json_schema_drop_invalid"`
 	DeviceProfile        ns.DeviceProfile `db:"-"`
 }
 
+// PayloadCodec defines the payload codec for a fPort range.
+type PayloadCodec struct {
+	FPortFrom            uint8      `json:"fPortFrom"`
+	FPortTo              uint8      `json:"fPortTo"`
+	PayloadCodec         codec.Type `json:"payloadCodec"`
+	PayloadEncoderScript string     `json:"payloadEncoderScript"`
+	PayloadDecoderScript string     `json:"payloadDecoderScript"`
+}
+
+// PayloadCodecs defines a slice of fPort range payload codecs.
+type PayloadCodecs []PayloadCodec
+
+// Value implements the driver.Valuer interface.
+func (p PayloadCodecs) Value() (driver.Value, error) {
+	if p == nil {
+		p = PayloadCodecs{}
+	}
+	return json.Marshal(p)
+}
+
+// Scan implements the sql.Scanner interface.
+func (p *PayloadCodecs) Scan(src interface{}) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("expected []byte, got %T", src)
+	}
+
+	if err := json.Unmarshal(b, p); err != nil {
+		return err
+	}
+	if len(*p) == 0 {
+		*p = nil
+	}
+	return nil
+}
+
 // DeviceProfileMeta defines the device-profile meta record.
 type DeviceProfileMeta struct {
 	DeviceProfileID uuid.UUID `db:"device_profile_id"`
@@ -44,9 +90,125 @@ func (dp DeviceProfile) Validate() error {
 	if dp.Name == "" {
 		return ErrDeviceProfileInvalidName
 	}
+
+	for i, c := range dp.PayloadCodecFPorts {
+		if c.FPortFrom == 0 || c.FPortFrom > c.FPortTo {
+			return ErrDeviceProfileInvalidFPorts
+		}
+
+		for _, other := range dp.PayloadCodecFPorts[i+1:] {
+			if c.FPortFrom <= other.FPortTo && other.FPortFrom <= c.FPortTo {
+				return ErrDeviceProfileInvalidFPorts
+			}
+		}
+
+		if err := validateBinarySchema(c.PayloadCodec, c.PayloadDecoderScript); err != nil {
+			return err
+		}
+	}
+
+	if err := validateBinarySchema(dp.PayloadCodec, dp.PayloadDecoderScript); err != nil {
+		return err
+	}
+
+	if len(dp.DescriptorSet) != 0 {
+		if _, err := codec.ParseDescriptorSet(dp.DescriptorSet); err != nil {
+			return ErrDeviceProfileInvalidProtobuf
+		}
+	}
+
+	if len(dp.WASMModule) != 0 {
+		if err := codec.ValidateWASMModule(dp.WASMModule); err != nil {
+			return ErrDeviceProfileInvalidWASM
+		}
+	}
+
+	if dp.CodecVersion != nil && dp.CodecID == nil {
+		return ErrDeviceProfileInvalidCodec
+	}
+
+	if dp.PayloadJSONSchema != "" {
+		if _, err := codec.ParseJSONSchema(dp.PayloadJSONSchema); err != nil {
+			return ErrDeviceProfileInvalidJSONSchema
+		}
+	}
+
+	return nil
+}
+
+// validateBinarySchema validates the schema (given as decoder script) when
+// the given payload codec is the binary schema codec.
+func validateBinarySchema(t codec.Type, schema string) error {
+	if t != codec.BinarySchemaType {
+		return nil
+	}
+
+	if _, err := codec.ParseSchema(schema); err != nil {
+		return ErrCodecInvalidBinarySchema
+	}
+
 	return nil
 }
 
+// validateCodec validates that the codec used by the given device-profile
+// is available to the organization of the device-profile and that the used
+// version (or a published version when using the latest version) exists.
+func (dp DeviceProfile) validateCodec(db sqlx.Queryer) error {
+	if dp.CodecID == nil {
+		return nil
+	}
+
+	c, err := GetCodec(db, *dp.CodecID, false)
+	if err != nil {
+		if err == ErrDoesNotExist {
+			return ErrDeviceProfileInvalidCodec
+		}
+		return errors.Wrap(err, "get codec error")
+	}
+
+	if c.OrganizationID != nil && *c.OrganizationID != dp.OrganizationID {
+		return ErrDeviceProfileInvalidCodec
+	}
+
+	var version int
+	if dp.CodecVersion != nil {
+		version = *dp.CodecVersion
+	}
+
+	if _, err := GetCodecVersion(db, c.ID, version); err != nil {
+		if err == ErrDoesNotExist {
+			return ErrDeviceProfileNoCodecVersion
+		}
+		return errors.Wrap(err, "get codec version error")
+	}
+
+	return nil
+}
+
+// GetPayloadCodec returns the payload codec and scripts for the given fPort.
+// When the fPort is not within any of the fPort ranges, the default payload
+// codec of the device-profile is returned. Note that the DescriptorSet and
+// WASMModule are shared by all payload codecs of the device-profile.
+func (dp DeviceProfile) GetPayloadCodec(fPort uint8) (codec.Type, string, string) {
+	if c, ok := dp.getFPortPayloadCodec(fPort); ok {
+		return c.PayloadCodec, c.PayloadEncoderScript, c.PayloadDecoderScript
+	}
+
+	return dp.PayloadCodec, dp.PayloadEncoderScript, dp.PayloadDecoderScript
+}
+
+// getFPortPayloadCodec returns the payload codec of the fPort range matching
+// the given fPort.
+func (dp DeviceProfile) getFPortPayloadCodec(fPort uint8) (PayloadCodec, bool) {
+	for _, c := range dp.PayloadCodecFPorts {
+		if fPort >= c.FPortFrom && fPort <= c.FPortTo {
+			return c, true
+		}
+	}
+
+	return PayloadCodec{}, false
+}
+
 // CreateDeviceProfile creates the given device-profile.
 // This will create the device-profile at the network-server side and will
 // create a local reference record.
@@ -55,6 +217,10 @@ func CreateDeviceProfile(db sqlx.Ext, dp *DeviceProfile) error {
 		return errors.Wrap(err, "validate error")
 	}
 
+	if err := dp.validateCodec(db); err != nil {
+		return errors.Wrap(err, "validate codec error")
+	}
+
 	dpID, err := uuid.NewV4()
 	if err != nil {
 		return errors.Wrap(err, "new uuid v4 error")
@@ -75,8 +241,15 @@ func CreateDeviceProfile(db sqlx.Ext, dp *DeviceProfile) error {
             name,
 			payload_codec,
 			payload_encoder_script,
-			payload_decoder_script
-		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			payload_decoder_script,
+			payload_codec_fports,
+			payload_codec_descriptor_set,
+			codec_id,
+			codec_version,
+			payload_json_schema,
+			payload_json_schema_drop_invalid,
+			payload_codec_wasm_module
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`,
 		dpID,
 		dp.NetworkServerID,
 		dp.OrganizationID,
@@ -86,6 +259,13 @@ func CreateDeviceProfile(db sqlx.Ext, dp *DeviceProfile) error {
 		dp.PayloadCodec,
 		dp.PayloadEncoderScript,
 		dp.PayloadDecoderScript,
+		dp.PayloadCodecFPorts,
+		dp.DescriptorSet,
+		dp.CodecID,
+		dp.CodecVersion,
+		dp.PayloadJSONSchema,
+		dp.DropInvalidPayloads,
+		dp.WASMModule,
 	)
 	if err != nil {
 		log.WithField("id", dpID).Errorf("create device-profile error: %s", err)
@@ -137,7 +317,14 @@ func GetDeviceProfile(db sqlx.Queryer, id uuid.UUID, forUpdate, localOnly bool)
 			name,
 			payload_codec,
 			payload_encoder_script,
-			payload_decoder_script
+			payload_decoder_script,
+			payload_codec_fports,
+			payload_codec_descriptor_set,
+			codec_id,
+			codec_version,
+			payload_json_schema,
+			payload_json_schema_drop_invalid,
+			payload_codec_wasm_module
 		from device_profile
 		where
 			device_profile_id = $1`+fu,
@@ -156,6 +343,13 @@ func GetDeviceProfile(db sqlx.Queryer, id uuid.UUID, forUpdate, localOnly bool)
 		&dp.PayloadCodec,
 		&dp.PayloadEncoderScript,
 		&dp.PayloadDecoderScript,
+		&dp.PayloadCodecFPorts,
+		&dp.DescriptorSet,
+		&dp.CodecID,
+		&dp.CodecVersion,
+		&dp.PayloadJSONSchema,
+		&dp.DropInvalidPayloads,
+		&dp.WASMModule,
 	)
 	if err != nil {
 		return dp, handlePSQLError(Scan, err, "scan error")
@@ -196,6 +390,10 @@ func UpdateDeviceProfile(db sqlx.Ext, dp *DeviceProfile) error {
 		return errors.Wrap(err, "validate error")
 	}
 
+	if err := dp.validateCodec(db); err != nil {
+		return errors.Wrap(err, "validate codec error")
+	}
+
 	dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
 	if err != nil {
 		return errors.Wrap(err, "uuid from bytes error")
@@ -227,7 +425,14 @@ func UpdateDeviceProfile(db sqlx.Ext, dp *DeviceProfile) error {
             name = $3,
 			payload_codec = $4,
 			payload_encoder_script = $5,
-			payload_decoder_script = $6
+			payload_decoder_script = $6,
+			payload_codec_fports = $7,
+			payload_codec_descriptor_set = $8,
+			codec_id = $9,
+			codec_version = $10,
+			payload_json_schema = $11,
+			payload_json_schema_drop_invalid = $12,
+			payload_codec_wasm_module = $13
 		where device_profile_id = $1`,
 		dpID,
 		dp.UpdatedAt,
@@ -235,6 +440,13 @@ func UpdateDeviceProfile(db sqlx.Ext, dp *DeviceProfile) error {
 		dp.PayloadCodec,
 		dp.PayloadEncoderScript,
 		dp.PayloadDecoderScript,
+		dp.PayloadCodecFPorts,
+		dp.DescriptorSet,
+		dp.CodecID,
+		dp.CodecVersion,
+		dp.PayloadJSONSchema,
+		dp.DropInvalidPayloads,
+		dp.WASMModule,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
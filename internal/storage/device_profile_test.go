@@ -10,6 +10,7 @@ import (
 
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/loraserver/api/ns"
 	"github.com/brocaar/lorawan/backend"
 )
@@ -30,6 +31,94 @@ func TestDeviceProfileValidate(t *testing.T) {
 			},
 			Error: ErrDeviceProfileInvalidName,
 		},
+		{
+			DeviceProfile: DeviceProfile{
+				Name: "valid-name",
+				PayloadCodecFPorts: PayloadCodecs{
+					{FPortFrom: 1, FPortTo: 9},
+					{FPortFrom: 10, FPortTo: 10},
+				},
+			},
+		},
+		{
+			DeviceProfile: DeviceProfile{
+				Name: "valid-name",
+				PayloadCodecFPorts: PayloadCodecs{
+					{FPortFrom: 0, FPortTo: 9},
+				},
+			},
+			Error: ErrDeviceProfileInvalidFPorts,
+		},
+		{
+			DeviceProfile: DeviceProfile{
+				Name: "valid-name",
+				PayloadCodecFPorts: PayloadCodecs{
+					{FPortFrom: 10, FPortTo: 9},
+				},
+			},
+			Error: ErrDeviceProfileInvalidFPorts,
+		},
+		{
+			DeviceProfile: DeviceProfile{
+				Name: "valid-name",
+				PayloadCodecFPorts: PayloadCodecs{
+					{FPortFrom: 1, FPortTo: 10},
+					{FPortFrom: 10, FPortTo: 20},
+				},
+			},
+			Error: ErrDeviceProfileInvalidFPorts,
+		},
+		{
+			DeviceProfile: DeviceProfile{
+				Name:          "valid-name",
+				DescriptorSet: []byte{0x0a, 0x05},
+			},
+			Error: ErrDeviceProfileInvalidProtobuf,
+		},
+		{
+			DeviceProfile: DeviceProfile{
+				Name:              "valid-name",
+				PayloadJSONSchema: `{"type": "object"}`,
+			},
+		},
+		{
+			DeviceProfile: DeviceProfile{
+				Name:              "valid-name",
+				PayloadJSONSchema: `{"type": `,
+			},
+			Error: ErrDeviceProfileInvalidJSONSchema,
+		},
+		{
+			DeviceProfile: DeviceProfile{
+				Name:       "valid-name",
+				WASMModule: []byte{0x00, 0x61, 0x73, 0x6d},
+			},
+			Error: ErrDeviceProfileInvalidWASM,
+		},
+		{
+			DeviceProfile: DeviceProfile{
+				Name:                 "valid-name",
+				PayloadCodec:         codec.BinarySchemaType,
+				PayloadDecoderScript: `{"fields": [{"name": "a", "type": "u8"}]}`,
+			},
+		},
+		{
+			DeviceProfile: DeviceProfile{
+				Name:                 "valid-name",
+				PayloadCodec:         codec.BinarySchemaType,
+				PayloadDecoderScript: `{"fields": [{"name": "a", "type": "u24"}]}`,
+			},
+			Error: ErrCodecInvalidBinarySchema,
+		},
+		{
+			DeviceProfile: DeviceProfile{
+				Name: "valid-name",
+				PayloadCodecFPorts: PayloadCodecs{
+					{FPortFrom: 1, FPortTo: 9, PayloadCodec: codec.BinarySchemaType, PayloadDecoderScript: `{"fields": [{"name": "a", "type": "u16"}, {"name": "b", "type": "u8", "offset": 1}]}`},
+				},
+			},
+			Error: ErrCodecInvalidBinarySchema,
+		},
 	}
 
 	assert := require.New(t)
@@ -39,6 +128,39 @@ func TestDeviceProfileValidate(t *testing.T) {
 	}
 }
 
+func TestDeviceProfileGetPayloadCodec(t *testing.T) {
+	dp := DeviceProfile{
+		PayloadCodec:         codec.CustomJSType,
+		PayloadEncoderScript: "encode",
+		PayloadDecoderScript: "decode",
+		PayloadCodecFPorts: PayloadCodecs{
+			{FPortFrom: 1, FPortTo: 1, PayloadCodec: codec.CayenneLPPType},
+			{FPortFrom: 10, FPortTo: 20, PayloadCodec: codec.CustomJSType, PayloadEncoderScript: "encode-10", PayloadDecoderScript: "decode-10"},
+		},
+	}
+
+	tests := []struct {
+		FPort                uint8
+		PayloadCodec         codec.Type
+		PayloadEncoderScript string
+		PayloadDecoderScript string
+	}{
+		{1, codec.CayenneLPPType, "", ""},
+		{2, codec.CustomJSType, "encode", "decode"},
+		{10, codec.CustomJSType, "encode-10", "decode-10"},
+		{20, codec.CustomJSType, "encode-10", "decode-10"},
+	}
+
+	assert := require.New(t)
+
+	for _, tst := range tests {
+		c, enc, dec := dp.GetPayloadCodec(tst.FPort)
+		assert.Equal(tst.PayloadCodec, c)
+		assert.Equal(tst.PayloadEncoderScript, enc)
+		assert.Equal(tst.PayloadDecoderScript, dec)
+	}
+}
+
 func (ts *StorageTestSuite) TestDeviceProfile() {
 	assert := require.New(ts.T())
 
@@ -76,6 +198,9 @@ func (ts *StorageTestSuite) TestDeviceProfile() {
 			PayloadCodec:         "CUSTOM_JS",
 			PayloadEncoderScript: "Encode() {}",
 			PayloadDecoderScript: "Decode() {}",
+			PayloadCodecFPorts: PayloadCodecs{
+				{FPortFrom: 10, FPortTo: 20, PayloadCodec: "CAYENNE_LPP"},
+			},
 			DeviceProfile: ns.DeviceProfile{
 				SupportsClassB:     true,
 				ClassBTimeout:      10,
@@ -0,0 +1,191 @@
+package storage
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// minDepletionTime and maxDepletionTime define the range (in seconds since
+// epoch) of a battery depletion prediction, equal to the range of a
+// protobuf timestamp (0001-01-01 until 9999-12-31).
+const (
+	minDepletionTime = -62135596800
+	maxDepletionTime = 253402300799
+)
+
+// aggregationIntervals contains the intervals by which the device-status
+// history can be aggregated.
+var aggregationIntervals = map[string]struct{}{
+	"second":  {},
+	"minute":  {},
+	"hour":    {},
+	"day":     {},
+	"week":    {},
+	"month":   {},
+	"quarter": {},
+	"year":    {},
+}
+
+// DeviceStatus defines a device-status report.
+// When the BatteryLevel is nil and ExternalPowerSource is false, the device
+// was not able to measure the battery level.
+type DeviceStatus struct {
+	ID                  int64         `db:"id"`
+	DevEUI              lorawan.EUI64 `db:"dev_eui"`
+	CreatedAt           time.Time     `db:"created_at"`
+	Margin              int           `db:"margin"`
+	BatteryLevel        *float32      `db:"battery_level"`
+	ExternalPowerSource bool          `db:"external_power_source"`
+}
+
+// DeviceStatusAggregate defines the device-status reports aggregated over
+// an interval.
+type DeviceStatusAggregate struct {
+	Timestamp           time.Time `db:"timestamp"`
+	Count               int       `db:"count"`
+	Margin              int       `db:"margin"`
+	BatteryLevel        *float32  `db:"battery_level"`
+	ExternalPowerSource bool      `db:"external_power_source"`
+}
+
+// CreateDeviceStatus creates the given device-status report.
+func CreateDeviceStatus(db sqlx.Queryer, s *DeviceStatus) error {
+	err := sqlx.Get(db, &s.ID, `
+		insert into device_status (
+			dev_eui,
+			created_at,
+			margin,
+			battery_level,
+			external_power_source
+		) values ($1, $2, $3, $4, $5)
+		returning id`,
+		s.DevEUI[:],
+		s.CreatedAt,
+		s.Margin,
+		s.BatteryLevel,
+		s.ExternalPowerSource,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":      s.ID,
+		"dev_eui": s.DevEUI,
+	}).Debug("device-status created")
+
+	return nil
+}
+
+// GetDeviceStatusHistory returns the device-status reports of the given
+// device within the given time range (end exclusive), aggregated by the
+// given interval. Per interval, the margin and battery level are averaged.
+// The battery level is nil when none of the reports within the interval
+// contained a battery level.
+func GetDeviceStatusHistory(db sqlx.Queryer, devEUI lorawan.EUI64, interval string, start, end time.Time) ([]DeviceStatusAggregate, error) {
+	interval = strings.ToLower(interval)
+	if _, ok := aggregationIntervals[interval]; !ok {
+		return nil, ErrInvalidAggregationInterval
+	}
+
+	var items []DeviceStatusAggregate
+	err := sqlx.Select(db, &items, `
+		select
+			date_trunc($2, created_at) as timestamp,
+			count(*) as count,
+			round(avg(margin))::integer as margin,
+			avg(battery_level)::real as battery_level,
+			bool_or(external_power_source) as external_power_source
+		from device_status
+		where
+			dev_eui = $1
+			and created_at >= $3
+			and created_at < $4
+		group by
+			1
+		order by
+			1`,
+		devEUI[:],
+		interval,
+		start,
+		end,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return items, nil
+}
+
+// GetDeviceBatteryDepletion returns the predicted time at which the battery
+// of the given device will be depleted. The prediction is based on a linear
+// regression of the battery levels reported within the given time range
+// (end exclusive). It returns nil when there is not enough data or when the
+// battery level is not decreasing.
+func GetDeviceBatteryDepletion(db sqlx.Queryer, devEUI lorawan.EUI64, start, end time.Time) (*time.Time, error) {
+	var trend struct {
+		Slope     *float64 `db:"slope"`
+		Intercept *float64 `db:"intercept"`
+	}
+
+	err := sqlx.Get(db, &trend, `
+		select
+			regr_slope(battery_level, extract(epoch from created_at)::double precision) as slope,
+			regr_intercept(battery_level, extract(epoch from created_at)::double precision) as intercept
+		from device_status
+		where
+			dev_eui = $1
+			and created_at >= $2
+			and created_at < $3
+			and battery_level is not null`,
+		devEUI[:],
+		start,
+		end,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	if trend.Slope == nil || trend.Intercept == nil || *trend.Slope >= 0 {
+		return nil, nil
+	}
+
+	// the time (in seconds since epoch) at which the battery level reaches 0,
+	// a nearly flat slope results in a time which can not be represented
+	sec := -*trend.Intercept / *trend.Slope
+	if math.IsNaN(sec) || sec < minDepletionTime || sec > maxDepletionTime {
+		return nil, nil
+	}
+	t := time.Unix(int64(sec), 0).UTC()
+
+	return &t, nil
+}
+
+// DeleteExpiredDeviceStatus deletes the device-status reports which are
+// older than the given number of days. It returns the number of deleted
+// reports.
+func DeleteExpiredDeviceStatus(db sqlx.Execer, days int) (int64, error) {
+	res, err := db.Exec(`
+		delete from device_status
+		where
+			created_at < now() - $1 * interval '1 day'`,
+		days,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Delete, err, "delete error")
+	}
+
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "get rows affected error")
+	}
+
+	return ra, nil
+}
@@ -0,0 +1,198 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeviceStatus() {
+	assert := require.New(ts.T())
+
+	nsClient := mock.NewClient()
+	networkserver.SetPool(mock.NewPool(nsClient))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	n := NetworkServer{
+		Name:   "test-ns",
+		Server: "test-ns:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	sp := ServiceProfile{
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+		Name:            "test-sp",
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+	spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+	assert.NoError(err)
+
+	dp := DeviceProfile{
+		NetworkServerID: n.ID,
+		OrganizationID:  org.ID,
+		Name:            "test-dp",
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+	assert.NoError(err)
+
+	app := Application{
+		OrganizationID:   org.ID,
+		Name:             "test-app",
+		ServiceProfileID: spID,
+	}
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	d := Device{
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		ApplicationID:   app.ID,
+		DeviceProfileID: dpID,
+		Name:            "test-device",
+	}
+	assert.NoError(CreateDevice(ts.Tx(), &d))
+
+	battery := func(f float32) *float32 {
+		return &f
+	}
+
+	// the battery level decreases by 1% per hour, from 80% to 77%
+	start := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	statuses := []DeviceStatus{
+		{DevEUI: d.DevEUI, CreatedAt: start, Margin: 10, BatteryLevel: battery(80)},
+		{DevEUI: d.DevEUI, CreatedAt: start.Add(30 * time.Minute), Margin: 20, BatteryLevel: battery(79.5)},
+		{DevEUI: d.DevEUI, CreatedAt: start.Add(time.Hour), Margin: 5, BatteryLevel: battery(79)},
+		{DevEUI: d.DevEUI, CreatedAt: start.Add(2 * time.Hour), Margin: 5, BatteryLevel: battery(78)},
+		{DevEUI: d.DevEUI, CreatedAt: start.Add(3 * time.Hour), Margin: 5, BatteryLevel: battery(77)},
+		{DevEUI: d.DevEUI, CreatedAt: start.Add(4 * time.Hour), Margin: 7, ExternalPowerSource: true},
+	}
+	for i := range statuses {
+		assert.NoError(CreateDeviceStatus(ts.Tx(), &statuses[i]))
+		assert.NotEqual(0, statuses[i].ID)
+	}
+
+	ts.T().Run("GetDeviceStatusHistory", func(t *testing.T) {
+		t.Run("Invalid interval", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := GetDeviceStatusHistory(ts.Tx(), d.DevEUI, "decade", start, start.Add(24*time.Hour))
+			assert.Equal(ErrInvalidAggregationInterval, err)
+		})
+
+		t.Run("Per hour", func(t *testing.T) {
+			assert := require.New(t)
+
+			items, err := GetDeviceStatusHistory(ts.Tx(), d.DevEUI, "HOUR", start, start.Add(24*time.Hour))
+			assert.NoError(err)
+			assert.Len(items, 5)
+
+			for i := range items {
+				items[i].Timestamp = items[i].Timestamp.UTC()
+			}
+
+			assert.Equal(DeviceStatusAggregate{
+				Timestamp:    start,
+				Count:        2,
+				Margin:       15,
+				BatteryLevel: battery(79.75),
+			}, items[0])
+
+			assert.Equal(DeviceStatusAggregate{
+				Timestamp:           start.Add(4 * time.Hour),
+				Count:               1,
+				Margin:              7,
+				ExternalPowerSource: true,
+			}, items[4])
+		})
+
+		t.Run("Time range", func(t *testing.T) {
+			assert := require.New(t)
+
+			items, err := GetDeviceStatusHistory(ts.Tx(), d.DevEUI, "day", start.Add(time.Hour), start.Add(3*time.Hour))
+			assert.NoError(err)
+			assert.Len(items, 1)
+			assert.Equal(2, items[0].Count)
+		})
+	})
+
+	ts.T().Run("GetDeviceBatteryDepletion", func(t *testing.T) {
+		t.Run("Decreasing", func(t *testing.T) {
+			assert := require.New(t)
+
+			depletion, err := GetDeviceBatteryDepletion(ts.Tx(), d.DevEUI, start, start.Add(24*time.Hour))
+			assert.NoError(err)
+			assert.NotNil(depletion)
+			assert.WithinDuration(start.Add(80*time.Hour), *depletion, time.Minute)
+		})
+
+		t.Run("Not enough data", func(t *testing.T) {
+			assert := require.New(t)
+
+			depletion, err := GetDeviceBatteryDepletion(ts.Tx(), d.DevEUI, start, start.Add(time.Minute))
+			assert.NoError(err)
+			assert.Nil(depletion)
+		})
+
+		t.Run("Not decreasing", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(CreateDeviceStatus(ts.Tx(), &DeviceStatus{
+				DevEUI:       d.DevEUI,
+				CreatedAt:    start.Add(5 * time.Hour),
+				BatteryLevel: battery(100),
+			}))
+
+			depletion, err := GetDeviceBatteryDepletion(ts.Tx(), d.DevEUI, start.Add(3*time.Hour), start.Add(24*time.Hour))
+			assert.NoError(err)
+			assert.Nil(depletion)
+		})
+
+		t.Run("Out of range", func(t *testing.T) {
+			assert := require.New(t)
+
+			// decreasing by 0.01% in ten years
+			flatStart := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+			for i, level := range []float32{50, 49.99} {
+				assert.NoError(CreateDeviceStatus(ts.Tx(), &DeviceStatus{
+					DevEUI:       d.DevEUI,
+					CreatedAt:    flatStart.AddDate(10*i, 0, 0),
+					BatteryLevel: battery(level),
+				}))
+			}
+
+			depletion, err := GetDeviceBatteryDepletion(ts.Tx(), d.DevEUI, flatStart, flatStart.AddDate(11, 0, 0))
+			assert.NoError(err)
+			assert.Nil(depletion)
+		})
+	})
+
+	ts.T().Run("DeleteExpiredDeviceStatus", func(t *testing.T) {
+		assert := require.New(t)
+
+		recent := DeviceStatus{
+			DevEUI:    d.DevEUI,
+			CreatedAt: time.Now(),
+			Margin:    10,
+		}
+		assert.NoError(CreateDeviceStatus(ts.Tx(), &recent))
+
+		count, err := DeleteExpiredDeviceStatus(ts.Tx(), 90)
+		assert.NoError(err)
+		assert.EqualValues(len(statuses)+3, count)
+
+		items, err := GetDeviceStatusHistory(ts.Tx(), d.DevEUI, "year", recent.CreatedAt.AddDate(-1, 0, 0), recent.CreatedAt.Add(time.Hour))
+		assert.NoError(err)
+		assert.Len(items, 1)
+		assert.Equal(1, items[0].Count)
+	})
+}
@@ -117,6 +117,9 @@ func (ts *StorageTestSuite) TestDevice() {
 			DeviceStatusMargin:  &eleven,
 			SkipFCntCheck:       true,
 			ReferenceAltitude:   5.6,
+			Variables: Variables{
+				"temperature_offset": "1.5",
+			},
 		}
 		assert.NoError(CreateDevice(ts.Tx(), &d))
 		d.CreatedAt = d.CreatedAt.UTC().Truncate(time.Millisecond)
@@ -0,0 +1,174 @@
+package storage
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceUplink defines a stored device uplink.
+type DeviceUplink struct {
+	ID         int64           `db:"id"`
+	DevEUI     lorawan.EUI64   `db:"dev_eui"`
+	ReceivedAt time.Time       `db:"received_at"`
+	FCnt       uint32          `db:"f_cnt"`
+	FPort      uint8           `db:"f_port"`
+	DR         int             `db:"dr"`
+	Data       []byte          `db:"data"`
+	Object     json.RawMessage `db:"object"`
+	RXInfo     json.RawMessage `db:"rx_info"`
+}
+
+// DeviceUplinkFilters provide filters that can be used to filter on device
+// uplinks. Note that empty values are not used as filter.
+type DeviceUplinkFilters struct {
+	DevEUI lorawan.EUI64 `db:"dev_eui"`
+	Start  time.Time     `db:"start"`
+	End    time.Time     `db:"end"`
+
+	// Limit and Offset are added for convenience so that this struct can
+	// be given as the arguments.
+	Limit  int `db:"limit"`
+	Offset int `db:"offset"`
+}
+
+// SQL returns the SQL filter.
+func (f DeviceUplinkFilters) SQL() string {
+	filters := []string{"dev_eui = :dev_eui"}
+
+	if !f.Start.IsZero() {
+		filters = append(filters, "received_at >= :start")
+	}
+
+	if !f.End.IsZero() {
+		filters = append(filters, "received_at < :end")
+	}
+
+	return "where " + strings.Join(filters, " and ")
+}
+
+// CreateDeviceUplink creates the given device uplink.
+func CreateDeviceUplink(db sqlx.Queryer, u *DeviceUplink) error {
+	if u.RXInfo == nil {
+		u.RXInfo = json.RawMessage("[]")
+	}
+	// an uplink without FRMPayload has no data
+	if u.Data == nil {
+		u.Data = []byte{}
+	}
+
+	err := sqlx.Get(db, &u.ID, `
+		insert into device_uplink (
+			dev_eui,
+			received_at,
+			f_cnt,
+			f_port,
+			dr,
+			data,
+			object,
+			rx_info
+		) values ($1, $2, $3, $4, $5, $6, $7, $8)
+		returning id`,
+		u.DevEUI[:],
+		u.ReceivedAt,
+		u.FCnt,
+		u.FPort,
+		u.DR,
+		u.Data,
+		u.Object,
+		u.RXInfo,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":      u.ID,
+		"dev_eui": u.DevEUI,
+		"f_cnt":   u.FCnt,
+	}).Debug("device uplink created")
+
+	return nil
+}
+
+// GetDeviceUplinkCount returns the number of stored uplinks matching the
+// given filters.
+func GetDeviceUplinkCount(db sqlx.Queryer, filters DeviceUplinkFilters) (int, error) {
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			count(*)
+		from device_uplink
+		`+filters.SQL(), filters)
+	if err != nil {
+		return 0, errors.Wrap(err, "named query error")
+	}
+
+	var count int
+	err = sqlx.Get(db, &count, query, args...)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return count, nil
+}
+
+// GetDeviceUplinks returns a slice of stored uplinks matching the given
+// filters, sorted by received at timestamp (newest first).
+func GetDeviceUplinks(db sqlx.Queryer, filters DeviceUplinkFilters) ([]DeviceUplink, error) {
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			*
+		from device_uplink
+		`+filters.SQL()+`
+		order by
+			received_at desc,
+			id desc
+		limit :limit
+		offset :offset
+	`, filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "named query error")
+	}
+
+	var uplinks []DeviceUplink
+	err = sqlx.Select(db, &uplinks, query, args...)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return uplinks, nil
+}
+
+// DeleteExpiredDeviceUplinks deletes the stored uplinks which are older
+// than the uplink history of the application of the device, or all uplinks
+// when the uplink history has been disabled. It returns the number of
+// deleted uplinks.
+func DeleteExpiredDeviceUplinks(db sqlx.Execer) (int64, error) {
+	res, err := db.Exec(`
+		delete from device_uplink du
+		using device d, application a
+		where
+			du.dev_eui = d.dev_eui
+			and d.application_id = a.id
+			and (
+				a.uplink_history_days = 0
+				or du.received_at < now() - a.uplink_history_days * interval '1 day'
+			)`,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Delete, err, "delete error")
+	}
+
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "get rows affected error")
+	}
+
+	return ra, nil
+}
@@ -0,0 +1,206 @@
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeviceUplink() {
+	assert := require.New(ts.T())
+
+	nsClient := mock.NewClient()
+	networkserver.SetPool(mock.NewPool(nsClient))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	n := NetworkServer{
+		Name:   "test-ns",
+		Server: "test-ns:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	sp := ServiceProfile{
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+		Name:            "test-sp",
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+	spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+	assert.NoError(err)
+
+	dp := DeviceProfile{
+		NetworkServerID: n.ID,
+		OrganizationID:  org.ID,
+		Name:            "test-dp",
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+	assert.NoError(err)
+
+	app := Application{
+		OrganizationID:    org.ID,
+		Name:              "test-app",
+		ServiceProfileID:  spID,
+		UplinkHistoryDays: 1,
+	}
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	d := Device{
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		ApplicationID:   app.ID,
+		DeviceProfileID: dpID,
+		Name:            "test-device",
+	}
+	assert.NoError(CreateDevice(ts.Tx(), &d))
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	uplinks := []DeviceUplink{
+		{
+			DevEUI:     d.DevEUI,
+			ReceivedAt: now.Add(-48 * time.Hour),
+			FCnt:       1,
+			FPort:      10,
+			DR:         5,
+			Data:       []byte{1, 2, 3},
+		},
+		{
+			DevEUI:     d.DevEUI,
+			ReceivedAt: now.Add(-time.Hour),
+			FCnt:       2,
+			FPort:      10,
+			DR:         5,
+			Data:       []byte{4, 5, 6},
+			Object:     json.RawMessage(`{"temperature": 21.5}`),
+			RXInfo:     json.RawMessage(`[{"rssi": -60}]`),
+		},
+		{
+			DevEUI:     d.DevEUI,
+			ReceivedAt: now,
+			FCnt:       3,
+			FPort:      20,
+			DR:         3,
+			Data:       []byte{7, 8, 9},
+		},
+	}
+	for i := range uplinks {
+		assert.NoError(CreateDeviceUplink(ts.Tx(), &uplinks[i]))
+		assert.NotEqual(0, uplinks[i].ID)
+	}
+
+	ts.T().Run("List", func(t *testing.T) {
+		tests := []struct {
+			Name          string
+			Filters       DeviceUplinkFilters
+			ExpectedCount int
+			ExpectedFCnts []uint32
+		}{
+			{
+				Name:          "all",
+				Filters:       DeviceUplinkFilters{DevEUI: d.DevEUI, Limit: 10},
+				ExpectedCount: 3,
+				ExpectedFCnts: []uint32{3, 2, 1},
+			},
+			{
+				Name:          "limit and offset",
+				Filters:       DeviceUplinkFilters{DevEUI: d.DevEUI, Limit: 1, Offset: 1},
+				ExpectedCount: 3,
+				ExpectedFCnts: []uint32{2},
+			},
+			{
+				Name:          "time range",
+				Filters:       DeviceUplinkFilters{DevEUI: d.DevEUI, Start: now.Add(-2 * time.Hour), End: now, Limit: 10},
+				ExpectedCount: 1,
+				ExpectedFCnts: []uint32{2},
+			},
+			{
+				Name:          "other device",
+				Filters:       DeviceUplinkFilters{DevEUI: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, Limit: 10},
+				ExpectedCount: 0,
+			},
+		}
+
+		for _, tst := range tests {
+			t.Run(tst.Name, func(t *testing.T) {
+				assert := require.New(t)
+
+				count, err := GetDeviceUplinkCount(ts.Tx(), tst.Filters)
+				assert.NoError(err)
+				assert.Equal(tst.ExpectedCount, count)
+
+				items, err := GetDeviceUplinks(ts.Tx(), tst.Filters)
+				assert.NoError(err)
+
+				var fCnts []uint32
+				for _, item := range items {
+					fCnts = append(fCnts, item.FCnt)
+				}
+				assert.Equal(tst.ExpectedFCnts, fCnts)
+			})
+		}
+
+		t.Run("Fields", func(t *testing.T) {
+			assert := require.New(t)
+
+			items, err := GetDeviceUplinks(ts.Tx(), DeviceUplinkFilters{DevEUI: d.DevEUI, Limit: 3})
+			assert.NoError(err)
+			assert.Len(items, 3)
+
+			items[1].ReceivedAt = items[1].ReceivedAt.UTC()
+			assert.JSONEq(`{"temperature": 21.5}`, string(items[1].Object))
+			assert.JSONEq(`[{"rssi": -60}]`, string(items[1].RXInfo))
+			items[1].Object = uplinks[1].Object
+			items[1].RXInfo = uplinks[1].RXInfo
+			assert.Equal(uplinks[1], items[1])
+
+			assert.Nil(items[0].Object)
+			assert.JSONEq(`[]`, string(items[0].RXInfo))
+		})
+	})
+
+	ts.T().Run("DeleteExpiredDeviceUplinks", func(t *testing.T) {
+		assert := require.New(t)
+
+		count, err := DeleteExpiredDeviceUplinks(ts.Tx())
+		assert.NoError(err)
+		assert.EqualValues(1, count)
+
+		items, err := GetDeviceUplinks(ts.Tx(), DeviceUplinkFilters{DevEUI: d.DevEUI, Limit: 10})
+		assert.NoError(err)
+		assert.Len(items, 2)
+
+		// disabling the history removes all uplinks
+		app.UplinkHistoryDays = 0
+		assert.NoError(UpdateApplication(ts.Tx(), app))
+
+		count, err = DeleteExpiredDeviceUplinks(ts.Tx())
+		assert.NoError(err)
+		assert.EqualValues(2, count)
+	})
+
+	ts.T().Run("Empty payload", func(t *testing.T) {
+		assert := require.New(t)
+
+		u := DeviceUplink{
+			DevEUI:     d.DevEUI,
+			ReceivedAt: now,
+			FCnt:       4,
+		}
+		assert.NoError(CreateDeviceUplink(ts.Tx(), &u))
+
+		items, err := GetDeviceUplinks(ts.Tx(), DeviceUplinkFilters{DevEUI: d.DevEUI, Limit: 10})
+		assert.NoError(err)
+		assert.Len(items, 1)
+		assert.Len(items[0].Data, 0)
+	})
+}
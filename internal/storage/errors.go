@@ -36,6 +36,17 @@ var (
 	ErrInvalidEmail                    = errors.New("invalid e-mail")
 	ErrInvalidGatewayDiscoveryInterval = errors.New("invalid gateway-discovery interval, it must be greater than 0")
 	ErrDeviceProfileInvalidName        = errors.New("invalid device-profile name")
+	ErrDeviceProfileInvalidFPorts      = errors.New("invalid device-profile payload codec fPort ranges, fPorts must be between 1 and 255 and ranges must not overlap")
+	ErrDeviceProfileInvalidProtobuf    = errors.New("invalid device-profile protobuf descriptor set")
+	ErrDeviceProfileInvalidCodec       = errors.New("invalid device-profile codec, the codec must be available to the organization of the device-profile")
+	ErrDeviceProfileNoCodecVersion     = errors.New("invalid device-profile codec version, the codec version does not exist or the codec has no published version")
+	ErrDeviceProfileInvalidJSONSchema  = errors.New("invalid device-profile payload json schema")
+	ErrDeviceProfileInvalidWASM        = errors.New("invalid device-profile wasm module")
+	ErrCodecInvalidName                = errors.New("invalid codec name")
+	ErrCodecInvalidBinarySchema        = errors.New("invalid binary schema")
+	ErrCodecVersionInvalidCodec        = errors.New("invalid codec version, a payload codec must be set")
+	ErrDeviceCodecStateConflict        = errors.New("device codec state has been modified concurrently")
+	ErrInvalidAggregationInterval      = errors.New("invalid aggregation interval")
 )
 
 func handlePSQLError(action Action, err error, description string) error {
@@ -0,0 +1,10 @@
+-- +migrate Up
+alter table device_profile
+    add column payload_codec_fports jsonb not null default '[]';
+
+alter table device_profile
+    alter column payload_codec_fports drop default;
+
+-- +migrate Down
+alter table device_profile
+    drop column payload_codec_fports;
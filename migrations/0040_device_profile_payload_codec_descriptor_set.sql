@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table device_profile
+    add column payload_codec_descriptor_set bytea;
+
+-- +migrate Down
+alter table device_profile
+    drop column payload_codec_descriptor_set;
@@ -0,0 +1,46 @@
+-- +migrate Up
+create table codec (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    organization_id bigint references organization on delete cascade,
+    name varchar(100) not null,
+    description text not null
+);
+
+create index idx_codec_organization_id on codec(organization_id);
+create unique index idx_codec_organization_id_name on codec(coalesce(organization_id, 0), name);
+
+create table codec_version (
+    codec_id bigint references codec on delete cascade,
+    version integer not null,
+    created_at timestamp with time zone not null,
+    description text not null,
+    payload_codec text not null,
+    payload_encoder_script text not null,
+    payload_decoder_script text not null,
+    descriptor_set bytea,
+
+    primary key(codec_id, version)
+);
+
+alter table device_profile
+    add column codec_id bigint references codec,
+    add column codec_version integer,
+    add foreign key (codec_id, codec_version) references codec_version;
+
+create index idx_device_profile_codec_id on device_profile(codec_id);
+
+-- +migrate Down
+drop index idx_device_profile_codec_id;
+
+alter table device_profile
+    drop column codec_version,
+    drop column codec_id;
+
+drop table codec_version;
+
+drop index idx_codec_organization_id_name;
+drop index idx_codec_organization_id;
+
+drop table codec;
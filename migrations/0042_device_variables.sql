@@ -0,0 +1,10 @@
+-- +migrate Up
+alter table device
+    add column variables jsonb not null default '{}';
+
+alter table device
+    alter column variables drop default;
+
+-- +migrate Down
+alter table device
+    drop column variables;
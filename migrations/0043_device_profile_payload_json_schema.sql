@@ -0,0 +1,13 @@
+-- +migrate Up
+alter table device_profile
+    add column payload_json_schema text not null default '',
+    add column payload_json_schema_drop_invalid boolean not null default false;
+
+alter table device_profile
+    alter column payload_json_schema drop default,
+    alter column payload_json_schema_drop_invalid drop default;
+
+-- +migrate Down
+alter table device_profile
+    drop column payload_json_schema_drop_invalid,
+    drop column payload_json_schema;
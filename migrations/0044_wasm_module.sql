@@ -0,0 +1,13 @@
+-- +migrate Up
+alter table device_profile
+    add column payload_codec_wasm_module bytea;
+
+alter table codec_version
+    add column wasm_module bytea;
+
+-- +migrate Down
+alter table codec_version
+    drop column wasm_module;
+
+alter table device_profile
+    drop column payload_codec_wasm_module;
@@ -0,0 +1,30 @@
+-- +migrate Up
+alter table application
+    add column uplink_history_days integer not null default 0;
+
+alter table application
+    alter column uplink_history_days drop default;
+
+create table device_uplink (
+    id bigserial primary key,
+    dev_eui bytea not null references device on delete cascade,
+    received_at timestamp with time zone not null,
+    f_cnt bigint not null,
+    f_port smallint not null,
+    dr smallint not null,
+    data bytea not null,
+    object jsonb,
+    rx_info jsonb not null
+);
+
+create index idx_device_uplink_dev_eui_received_at on device_uplink(dev_eui, received_at);
+create index idx_device_uplink_received_at on device_uplink(received_at);
+
+-- +migrate Down
+drop index idx_device_uplink_received_at;
+drop index idx_device_uplink_dev_eui_received_at;
+
+drop table device_uplink;
+
+alter table application
+    drop column uplink_history_days;
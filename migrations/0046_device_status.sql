@@ -0,0 +1,16 @@
+-- +migrate Up
+create table device_status (
+    id bigserial primary key,
+    dev_eui bytea not null references device on delete cascade,
+    created_at timestamp with time zone not null,
+    margin integer not null,
+    battery_level real,
+    external_power_source boolean not null
+);
+
+create index idx_device_status_dev_eui_created_at on device_status(dev_eui, created_at);
+
+-- +migrate Down
+drop index idx_device_status_dev_eui_created_at;
+
+drop table device_status;
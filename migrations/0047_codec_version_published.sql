@@ -0,0 +1,13 @@
+-- +migrate Up
+alter table codec_version
+    add column published boolean not null default false;
+
+-- existing versions are in use already
+update codec_version set published = true;
+
+alter table codec_version
+    alter column published drop default;
+
+-- +migrate Down
+alter table codec_version
+    drop column published;
@@ -123,6 +123,17 @@ class HTTPIntegrationForm extends FormComponent {
     this.props.onChange(object);
   }
 
+  getMarshalerOptions(search, callbackFunc) {
+    const marshalerOptions = [
+      {value: "JSON", label: "JSON"},
+      {value: "PROTOBUF", label: "Protocol Buffers"},
+      {value: "CLOUDEVENTS", label: "CloudEvents (structured)"},
+      {value: "CLOUDEVENTS_BINARY", label: "CloudEvents (binary)"},
+    ];
+
+    callbackFunc(marshalerOptions);
+  }
+
   render() {
     if (this.state.object === undefined) {
       return(<div></div>);
@@ -140,6 +151,19 @@ class HTTPIntegrationForm extends FormComponent {
           {headers}
         </FormControl>
         <Button variant="outlined" onClick={this.addHeader}>Add header</Button>
+        <FormControl fullWidth margin="normal">
+          <FormLabel className={this.props.classes.formLabel}>Payload marshaler</FormLabel>
+          <AutocompleteSelect
+            id="marshaler"
+            label="Select payload marshaler"
+            value={this.state.object.marshaler || "JSON"}
+            onChange={this.onChange}
+            getOptions={this.getMarshalerOptions}
+          />
+          <FormHelperText>
+            This defines how the payloads are encoded. The CloudEvents binary mode sets the event attributes as HTTP headers.
+          </FormHelperText>
+        </FormControl>
         <FormControl fullWidth margin="normal">
           <FormLabel>Endpoints</FormLabel>
           <TextField
@@ -203,6 +227,9 @@ class HTTPIntegrationForm extends FormComponent {
 }
 
 
+HTTPIntegrationForm = withStyles(styles)(HTTPIntegrationForm);
+
+
 class InfluxDBIntegrationForm extends FormComponent {
   onChange(e) {
     super.onChange(e);